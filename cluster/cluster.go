@@ -18,6 +18,7 @@ package cluster
 
 import (
 	operator "github.com/minio/minio-operator/pkg/client/clientset/versioned"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	certutil "k8s.io/client-go/util/cert"
@@ -37,6 +38,7 @@ func GetK8sConfig(token string) *rest.Config {
 		TLSClientConfig: tlsClientConfig,
 		APIPath:         "/",
 		BearerToken:     token,
+		WrapTransport:   instrumentRoundTripper,
 	}
 	return config
 }
@@ -50,3 +52,9 @@ func OperatorClient(token string) (*operator.Clientset, error) {
 func K8sClient(token string) (*kubernetes.Clientset, error) {
 	return kubernetes.NewForConfig(GetK8sConfig(token))
 }
+
+// DynamicClient returns a dynamic kubernetes client using GetK8sConfig for its config, it's used
+// for the resources whose typed client is not available on the client-go version used by m3
+func DynamicClient(token string) (dynamic.Interface, error) {
+	return dynamic.NewForConfig(GetK8sConfig(token))
+}
@@ -24,8 +24,10 @@ import (
 	"net/http"
 	"regexp"
 	"strings"
+	"sync"
 	"time"
 
+	"github.com/minio/m3/pkg/logger"
 	"github.com/minio/minio/pkg/env"
 )
 
@@ -64,6 +66,17 @@ func GetNsFromFile() string {
 	return string(dat)
 }
 
+// GetServiceAccountToken assumes m3 is running inside a k8s pod and returns the token of its own service
+// account, it's used for the requests m3 does on its own behalf (metrics, health checks) instead of the
+// token of an user, when running outside k8s an empty token is returned and kubectl proxy takes care of auth
+func GetServiceAccountToken() string {
+	dat, err := ioutil.ReadFile("/var/run/secrets/kubernetes.io/serviceaccount/token")
+	if err != nil {
+		return ""
+	}
+	return string(dat)
+}
+
 // This operation will run only once at mkube startup
 var namespace = GetNsFromFile()
 
@@ -72,6 +85,20 @@ func GetNs() string {
 	return env.Get(M3Namespace, namespace)
 }
 
+// releaseCatalogUpdated keeps the last time the MinIO release catalog was read successfully
+var releaseCatalogUpdated struct {
+	sync.RWMutex
+	t time.Time
+}
+
+// MinIOReleaseCatalogUpdated returns the last time the MinIO release catalog was read successfully,
+// a zero time means the catalog has never been loaded
+func MinIOReleaseCatalogUpdated() time.Time {
+	releaseCatalogUpdated.RLock()
+	defer releaseCatalogUpdated.RUnlock()
+	return releaseCatalogUpdated.t
+}
+
 // getLatestMinIOImage returns the latest docker image for MinIO if found on the internet
 func getLatestMinIOImage(client HTTPClientI) (*string, error) {
 	resp, err := client.Get("https://dl.min.io/server/minio/release/linux-amd64/")
@@ -90,6 +117,9 @@ func getLatestMinIOImage(client HTTPClientI) (*string, error) {
 	for i := range matches {
 		release := matches[i][1]
 		dockerImage := fmt.Sprintf("minio/minio:%s", release)
+		releaseCatalogUpdated.Lock()
+		releaseCatalogUpdated.t = time.Now()
+		releaseCatalogUpdated.Unlock()
 		return &dockerImage, nil
 	}
 	return nil, errCantDetermineMinIOImage
@@ -102,6 +132,15 @@ var latestMinIOImage, errLatestMinIOImage = getLatestMinIOImage(
 		},
 	})
 
+func init() {
+	if errLatestMinIOImage != nil {
+		logger.Log.WithError(errLatestMinIOImage).Warn("unable to load the MinIO release catalog")
+	}
+	if errLatestMCImage != nil {
+		logger.Log.WithError(errLatestMCImage).Warn("unable to load the MC release catalog")
+	}
+}
+
 // GetMinioImage returns the image URL to be used when deploying a MinIO instance, if there is
 // a preferred image to be used (configured via ENVIRONMENT VARIABLES) GetMinioImage will return that
 // if not, GetMinioImage will try to obtain the image URL for the latest version of MinIO and return that
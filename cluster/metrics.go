@@ -0,0 +1,169 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cluster
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/pkg/tracing"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+var (
+	k8sRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "m3",
+		Subsystem: "k8s_client",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of the requests sent to the kubernetes api server by verb and resource.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"verb", "resource"})
+
+	k8sRequestErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "m3",
+		Subsystem: "k8s_client",
+		Name:      "errors_total",
+		Help:      "Number of requests sent to the kubernetes api server that failed by verb and resource.",
+	}, []string{"verb", "resource"})
+
+	releaseCatalogAge = prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace: "m3",
+		Name:      "minio_release_catalog_age_seconds",
+		Help:      "Seconds since the MinIO release catalog was last loaded, -1 if it was never loaded.",
+	}, func() float64 {
+		updated := MinIOReleaseCatalogUpdated()
+		if updated.IsZero() {
+			return -1
+		}
+		return time.Since(updated).Seconds()
+	})
+)
+
+func init() {
+	prometheus.MustRegister(k8sRequestDuration, k8sRequestErrors, releaseCatalogAge)
+}
+
+// instrumentedRoundTripper records latency and errors of every request sent to the kubernetes api server,
+// every request is also traced as a child of the span found on the request context
+type instrumentedRoundTripper struct {
+	rt http.RoundTripper
+}
+
+// instrumentRoundTripper is used as the WrapTransport function of the rest configs built by m3
+func instrumentRoundTripper(rt http.RoundTripper) http.RoundTripper {
+	return &instrumentedRoundTripper{rt: rt}
+}
+
+// RoundTrip implements http.RoundTripper
+func (i *instrumentedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	verb, resource := k8sRequestInfo(req)
+	ctx, span := tracing.StartClient(req.Context(), "k8s "+verb+" "+resource,
+		semconv.HTTPMethodKey.String(req.Method),
+		semconv.HTTPTargetKey.String(req.URL.Path),
+		attribute.String("k8s.verb", verb),
+		attribute.String("k8s.resource", resource),
+	)
+	req = req.WithContext(ctx)
+	start := time.Now()
+	resp, err := i.rt.RoundTrip(req)
+	elapsed := time.Since(start)
+	if err == nil {
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(resp.StatusCode))
+		span.SetStatus(semconv.SpanStatusFromHTTPStatusCode(resp.StatusCode))
+	}
+	tracing.End(span, err)
+	k8sRequestDuration.WithLabelValues(verb, resource).Observe(elapsed.Seconds())
+	entry := logger.FromContext(req.Context()).WithFields(logrus.Fields{
+		"verb":     verb,
+		"resource": resource,
+		"duration": elapsed.String(),
+	})
+	if err != nil || resp.StatusCode >= http.StatusBadRequest {
+		k8sRequestErrors.WithLabelValues(verb, resource).Inc()
+	}
+	if err != nil {
+		entry.WithError(err).Debug("kubernetes api request failed")
+	} else {
+		entry.WithField("status", resp.StatusCode).Debug("kubernetes api request")
+	}
+	return resp, err
+}
+
+// k8sRequestInfo returns the kubernetes verb and the resource targeted by a request sent to
+// the api server, ie: GET /apis/operator.min.io/v1/namespaces/default/minioinstances/tenant-1
+// returns `get` and `minioinstances`
+func k8sRequestInfo(req *http.Request) (verb, resource string) {
+	parts := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	// skip the api prefix: /api/{version} for the core group and /apis/{group}/{version} for the rest
+	prefix := len(parts)
+	switch parts[0] {
+	case "api":
+		prefix = 2
+	case "apis":
+		prefix = 3
+	}
+	if prefix > len(parts) {
+		prefix = len(parts)
+	}
+	parts = parts[prefix:]
+	// namespaced resources, /namespaces/{namespace}/{resource}
+	if len(parts) > 2 && parts[0] == "namespaces" {
+		parts = parts[2:]
+	}
+
+	resource = "unknown"
+	hasName := false
+	if len(parts) > 0 {
+		resource = parts[0]
+	}
+	if len(parts) > 1 {
+		hasName = true
+	}
+	if len(parts) > 2 {
+		resource = resource + "/" + parts[2]
+	}
+
+	switch req.Method {
+	case http.MethodGet:
+		verb = "get"
+		if !hasName {
+			verb = "list"
+			if req.URL.Query().Get("watch") == "true" || req.URL.Query().Get("watch") == "1" {
+				verb = "watch"
+			}
+		}
+	case http.MethodPost:
+		verb = "create"
+	case http.MethodPut:
+		verb = "update"
+	case http.MethodPatch:
+		verb = "patch"
+	case http.MethodDelete:
+		verb = "delete"
+		if !hasName {
+			verb = "deletecollection"
+		}
+	default:
+		verb = strings.ToLower(req.Method)
+	}
+	return verb, resource
+}
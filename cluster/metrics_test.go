@@ -0,0 +1,148 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package cluster
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_k8sRequestInfo(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		target       string
+		wantVerb     string
+		wantResource string
+	}{
+		{
+			name:         "Get of a namespaced resource",
+			method:       http.MethodGet,
+			target:       "/apis/operator.min.io/v1/namespaces/default/minioinstances/tenant-1",
+			wantVerb:     "get",
+			wantResource: "minioinstances",
+		},
+		{
+			name:         "List of a namespaced resource",
+			method:       http.MethodGet,
+			target:       "/api/v1/namespaces/default/secrets",
+			wantVerb:     "list",
+			wantResource: "secrets",
+		},
+		{
+			name:         "List across namespaces",
+			method:       http.MethodGet,
+			target:       "/apis/operator.min.io/v1/minioinstances",
+			wantVerb:     "list",
+			wantResource: "minioinstances",
+		},
+		{
+			name:         "Watch",
+			method:       http.MethodGet,
+			target:       "/api/v1/namespaces/default/pods?watch=true",
+			wantVerb:     "watch",
+			wantResource: "pods",
+		},
+		{
+			name:         "Watch with the numeric flag",
+			method:       http.MethodGet,
+			target:       "/api/v1/pods?watch=1&resourceVersion=10",
+			wantVerb:     "watch",
+			wantResource: "pods",
+		},
+		{
+			name:         "Get of a subresource",
+			method:       http.MethodGet,
+			target:       "/api/v1/namespaces/default/pods/tenant-1-zone-0-0/log",
+			wantVerb:     "get",
+			wantResource: "pods/log",
+		},
+		{
+			name:         "Update of a subresource",
+			method:       http.MethodPut,
+			target:       "/apis/operator.min.io/v1/namespaces/default/minioinstances/tenant-1/status",
+			wantVerb:     "update",
+			wantResource: "minioinstances/status",
+		},
+		{
+			name:         "Get of a cluster resource",
+			method:       http.MethodGet,
+			target:       "/api/v1/nodes/node-1",
+			wantVerb:     "get",
+			wantResource: "nodes",
+		},
+		{
+			name:         "Get of a namespace",
+			method:       http.MethodGet,
+			target:       "/api/v1/namespaces/default",
+			wantVerb:     "get",
+			wantResource: "namespaces",
+		},
+		{
+			name:         "Create",
+			method:       http.MethodPost,
+			target:       "/api/v1/namespaces/default/secrets",
+			wantVerb:     "create",
+			wantResource: "secrets",
+		},
+		{
+			name:         "Patch",
+			method:       http.MethodPatch,
+			target:       "/apis/operator.min.io/v1/namespaces/default/minioinstances/tenant-1",
+			wantVerb:     "patch",
+			wantResource: "minioinstances",
+		},
+		{
+			name:         "Delete",
+			method:       http.MethodDelete,
+			target:       "/api/v1/namespaces/default/secrets/tenant-1-secret",
+			wantVerb:     "delete",
+			wantResource: "secrets",
+		},
+		{
+			name:         "Delete of a collection",
+			method:       http.MethodDelete,
+			target:       "/api/v1/namespaces/default/secrets?labelSelector=m3.min.io%2Ftenant%3Dtenant-1",
+			wantVerb:     "deletecollection",
+			wantResource: "secrets",
+		},
+		{
+			name:         "Server version",
+			method:       http.MethodGet,
+			target:       "/version",
+			wantVerb:     "list",
+			wantResource: "unknown",
+		},
+		{
+			name:         "Group discovery",
+			method:       http.MethodGet,
+			target:       "/apis/operator.min.io/v1",
+			wantVerb:     "list",
+			wantResource: "unknown",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "https://kubernetes.default.svc"+tt.target, nil)
+			verb, resource := k8sRequestInfo(req)
+			if verb != tt.wantVerb || resource != tt.wantResource {
+				t.Errorf("k8sRequestInfo() = %s %s, want %s %s", verb, resource, tt.wantVerb, tt.wantResource)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ module github.com/minio/m3
 go 1.14
 
 require (
+	github.com/cespare/xxhash v1.1.0 // indirect
 	github.com/fatih/color v1.7.0
 	github.com/go-openapi/errors v0.19.6
 	github.com/go-openapi/loads v0.19.5
@@ -11,11 +12,19 @@ require (
 	github.com/go-openapi/strfmt v0.19.5
 	github.com/go-openapi/swag v0.19.9
 	github.com/go-openapi/validate v0.19.10
+	github.com/google/uuid v1.1.2
 	github.com/jessevdk/go-flags v1.4.0
 	github.com/minio/cli v1.22.0
 	github.com/minio/minio v0.0.0-20200501124117-09571d03a531
+	github.com/minio/minio-go/v6 v6.0.55-0.20200424204115-7506d2996b22
 	github.com/minio/minio-operator v0.0.0-20200520220606-60eca6e7beab
-	golang.org/x/net v0.0.0-20200602114024-627f9648deb9
+	github.com/prometheus/client_golang v1.7.1
+	github.com/sirupsen/logrus v1.5.0
+	go.opentelemetry.io/otel v1.0.1
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp v1.0.1
+	go.opentelemetry.io/otel/sdk v1.0.1
+	go.opentelemetry.io/otel/trace v1.0.1
+	golang.org/x/net v0.0.0-20200822124328-c89045814202
 	k8s.io/api v0.18.0
 	k8s.io/apimachinery v0.18.0
 	k8s.io/client-go v0.18.0
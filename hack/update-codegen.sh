@@ -0,0 +1,59 @@
+#!/usr/bin/env bash
+# This file is part of MinIO Kubernetes Cloud
+# Copyright (c) 2020 MinIO, Inc.
+#
+# This program is free software: you can redistribute it and/or modify
+# it under the terms of the GNU Affero General Public License as published by
+# the Free Software Foundation, either version 3 of the License, or
+# (at your option) any later version.
+#
+# This program is distributed in the hope that it will be useful,
+# but WITHOUT ANY WARRANTY; without even the implied warranty of
+# MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+# GNU Affero General Public License for more details.
+#
+# You should have received a copy of the GNU Affero General Public License
+# along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+# Regenerates the deepcopy functions of pkg/apis and the typed clientset, listers and informers of
+# pkg/clientgen for the third party APIs m3 works with (networking.gke.io, cert-manager.io and
+# gateway.networking.k8s.io).
+# Requires the k8s.io/code-generator binaries matching the client-go version in go.mod on the PATH:
+#   go install k8s.io/code-generator/cmd/{client-gen,lister-gen,informer-gen,deepcopy-gen}
+# the generators expect a GOPATH layout, m3 and its vendored dependencies are copied to a temporary GOPATH
+# and the output copied back.
+
+set -o errexit
+set -o nounset
+set -o pipefail
+
+ROOT=$(cd "$(dirname "${BASH_SOURCE[0]}")/.." && pwd)
+PKG=github.com/minio/m3
+APIS="${PKG}/pkg/apis/networking.gke.io/v1beta2,${PKG}/pkg/apis/certmanager/v1,${PKG}/pkg/apis/gateway/v1"
+BOILERPLATE="${ROOT}/hack/boilerplate.go.txt"
+
+GOPATH_TMP=$(mktemp -d)
+trap 'rm -rf "${GOPATH_TMP}"' EXIT
+mkdir -p "${GOPATH_TMP}/src/github.com/minio"
+cp -r "${ROOT}" "${GOPATH_TMP}/src/${PKG}"
+cd "${GOPATH_TMP}/src/${PKG}"
+go mod vendor
+rm -rf pkg/clientgen
+
+export GOPATH="${GOPATH_TMP}" GO111MODULE=off
+
+deepcopy-gen --input-dirs "${APIS}" -O zz_generated.deepcopy --bounding-dirs "${PKG}/pkg/apis" \
+  --go-header-file "${BOILERPLATE}"
+client-gen --clientset-name versioned --input-base "" --input "${APIS}" \
+  --output-package "${PKG}/pkg/clientgen/clientset" --go-header-file "${BOILERPLATE}"
+lister-gen --input-dirs "${APIS}" --output-package "${PKG}/pkg/clientgen/listers" \
+  --go-header-file "${BOILERPLATE}"
+informer-gen --input-dirs "${APIS}" --versioned-clientset-package "${PKG}/pkg/clientgen/clientset/versioned" \
+  --listers-package "${PKG}/pkg/clientgen/listers" --output-package "${PKG}/pkg/clientgen/informers" \
+  --go-header-file "${BOILERPLATE}"
+
+rm -rf "${ROOT}/pkg/clientgen"
+cp -r pkg/clientgen "${ROOT}/pkg/clientgen"
+for api in networking.gke.io/v1beta2 certmanager/v1 gateway/v1; do
+  cp "pkg/apis/${api}/zz_generated.deepcopy.go" "${ROOT}/pkg/apis/${api}/"
+done
@@ -0,0 +1,122 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Bucket bucket
+//
+// swagger:model bucket
+type Bucket struct {
+
+	// creation date
+	// Format: date-time
+	CreationDate strfmt.DateTime `json:"creation_date,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// object lock
+	ObjectLock bool `json:"object_lock,omitempty"`
+
+	// retention
+	Retention *BucketRetention `json:"retention,omitempty"`
+
+	// Enabled or Suspended, empty if versioning was never enabled
+	Versioning string `json:"versioning,omitempty"`
+
+	// set when the bucket is created with a quota and the quotas of the buckets exceed the usable capacity of the tenant
+	Warnings []string `json:"warnings"`
+}
+
+// Validate validates this bucket
+func (m *Bucket) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreationDate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRetention(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Bucket) validateCreationDate(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreationDate) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("creation_date", "body", "date-time", m.CreationDate.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Bucket) validateRetention(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Retention) { // not required
+		return nil
+	}
+
+	if m.Retention != nil {
+		if err := m.Retention.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("retention")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Bucket) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Bucket) UnmarshalBinary(b []byte) error {
+	var res Bucket
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BucketReplicationRequest bucket replication request
+//
+// swagger:model bucketReplicationRequest
+type BucketReplicationRequest struct {
+
+	// replicate delete markers and the deletion of versions
+	ReplicateDeletes bool `json:"replicate_deletes,omitempty"`
+
+	// bucket of the target tenant, the name of the source bucket when it's not set, it's created when it doesn't exist
+	TargetBucket string `json:"target_bucket,omitempty"`
+
+	// target namespace
+	// Required: true
+	TargetNamespace *string `json:"target_namespace"`
+
+	// target tenant
+	// Required: true
+	TargetTenant *string `json:"target_tenant"`
+}
+
+// Validate validates this bucket replication request
+func (m *BucketReplicationRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateTargetNamespace(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTargetTenant(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *BucketReplicationRequest) validateTargetNamespace(formats strfmt.Registry) error {
+
+	if err := validate.Required("target_namespace", "body", m.TargetNamespace); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BucketReplicationRequest) validateTargetTenant(formats strfmt.Registry) error {
+
+	if err := validate.Required("target_tenant", "body", m.TargetTenant); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BucketReplicationRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BucketReplicationRequest) UnmarshalBinary(b []byte) error {
+	var res BucketReplicationRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
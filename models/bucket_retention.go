@@ -0,0 +1,189 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// BucketRetention default retention of the objects of a bucket with object lock, empty to clear it
+//
+// swagger:model bucketRetention
+type BucketRetention struct {
+
+	// mode
+	// Enum: [GOVERNANCE COMPLIANCE]
+	Mode string `json:"mode,omitempty"`
+
+	// unit
+	// Enum: [DAYS YEARS]
+	Unit string `json:"unit,omitempty"`
+
+	// validity
+	// Minimum: 1
+	Validity int32 `json:"validity,omitempty"`
+}
+
+// Validate validates this bucket retention
+func (m *BucketRetention) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMode(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUnit(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateValidity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var bucketRetentionTypeModePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["GOVERNANCE","COMPLIANCE"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		bucketRetentionTypeModePropEnum = append(bucketRetentionTypeModePropEnum, v)
+	}
+}
+
+const (
+
+	// BucketRetentionModeGOVERNANCE captures enum value "GOVERNANCE"
+	BucketRetentionModeGOVERNANCE string = "GOVERNANCE"
+
+	// BucketRetentionModeCOMPLIANCE captures enum value "COMPLIANCE"
+	BucketRetentionModeCOMPLIANCE string = "COMPLIANCE"
+)
+
+// prop value enum
+func (m *BucketRetention) validateModeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, bucketRetentionTypeModePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BucketRetention) validateMode(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Mode) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateModeEnum("mode", "body", m.Mode); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var bucketRetentionTypeUnitPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["DAYS","YEARS"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		bucketRetentionTypeUnitPropEnum = append(bucketRetentionTypeUnitPropEnum, v)
+	}
+}
+
+const (
+
+	// BucketRetentionUnitDAYS captures enum value "DAYS"
+	BucketRetentionUnitDAYS string = "DAYS"
+
+	// BucketRetentionUnitYEARS captures enum value "YEARS"
+	BucketRetentionUnitYEARS string = "YEARS"
+)
+
+// prop value enum
+func (m *BucketRetention) validateUnitEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, bucketRetentionTypeUnitPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *BucketRetention) validateUnit(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Unit) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateUnitEnum("unit", "body", m.Unit); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *BucketRetention) validateValidity(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Validity) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("validity", "body", int64(m.Validity), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BucketRetention) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BucketRetention) UnmarshalBinary(b []byte) error {
+	var res BucketRetention
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,179 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CertificateStatus certificate status
+//
+// swagger:model certificateStatus
+type CertificateStatus struct {
+
+	// dns names
+	DNSNames []string `json:"dns_names"`
+
+	// domains
+	Domains []*DomainCertificateStatus `json:"domains"`
+
+	// issuer
+	Issuer string `json:"issuer,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// not after
+	// Format: date-time
+	NotAfter strfmt.DateTime `json:"not_after,omitempty"`
+
+	// not before
+	// Format: date-time
+	NotBefore strfmt.DateTime `json:"not_before,omitempty"`
+
+	// ready
+	Ready bool `json:"ready,omitempty"`
+
+	// renewal time
+	// Format: date-time
+	RenewalTime strfmt.DateTime `json:"renewal_time,omitempty"`
+
+	// secret
+	Secret string `json:"secret,omitempty"`
+
+	// status
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this certificate status
+func (m *CertificateStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDomains(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNotAfter(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNotBefore(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRenewalTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CertificateStatus) validateDomains(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Domains) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Domains); i++ {
+		if swag.IsZero(m.Domains[i]) { // not required
+			continue
+		}
+
+		if m.Domains[i] != nil {
+			if err := m.Domains[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("domains" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *CertificateStatus) validateNotAfter(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NotAfter) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("not_after", "body", "date-time", m.NotAfter.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CertificateStatus) validateNotBefore(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NotBefore) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("not_before", "body", "date-time", m.NotBefore.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CertificateStatus) validateRenewalTime(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.RenewalTime) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("renewal_time", "body", "date-time", m.RenewalTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CertificateStatus) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CertificateStatus) UnmarshalBinary(b []byte) error {
+	var res CertificateStatus
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
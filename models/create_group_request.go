@@ -0,0 +1,108 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CreateGroupRequest create group request
+//
+// swagger:model createGroupRequest
+type CreateGroupRequest struct {
+
+	// members
+	// Required: true
+	// Min Items: 1
+	Members []string `json:"members"`
+
+	// name
+	// Required: true
+	Name *string `json:"name"`
+
+	// policy
+	Policy string `json:"policy,omitempty"`
+}
+
+// Validate validates this create group request
+func (m *CreateGroupRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMembers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CreateGroupRequest) validateMembers(formats strfmt.Registry) error {
+
+	if err := validate.Required("members", "body", m.Members); err != nil {
+		return err
+	}
+
+	iMembersSize := int64(len(m.Members))
+
+	if err := validate.MinItems("members", "body", iMembersSize, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CreateGroupRequest) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CreateGroupRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CreateGroupRequest) UnmarshalBinary(b []byte) error {
+	var res CreateGroupRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
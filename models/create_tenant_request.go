@@ -23,6 +23,7 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"encoding/json"
 	"strconv"
 
 	"github.com/go-openapi/errors"
@@ -42,12 +43,19 @@ type CreateTenantRequest struct {
 	// annotations
 	Annotations map[string]string `json:"annotations,omitempty"`
 
+	// default hard quota of the buckets created through m3, ie 100Gi
+	DefaultBucketQuota string `json:"default_bucket_quota,omitempty"`
+
 	// enable mcs
 	EnableMcs *bool `json:"enable_mcs,omitempty"`
 
 	// enable ssl
 	EnableSsl *bool `json:"enable_ssl,omitempty"`
 
+	// how the tenant is exposed, default leaves it to the integrations enabled on m3, gateway requires the gateway integration
+	// Enum: [default load-balancer gateway]
+	Exposure *string `json:"exposure,omitempty"`
+
 	// image
 	Image string `json:"image,omitempty"`
 
@@ -84,6 +92,10 @@ type CreateTenantRequest struct {
 func (m *CreateTenantRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateExposure(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
@@ -106,6 +118,52 @@ func (m *CreateTenantRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var createTenantRequestTypeExposurePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["default","load-balancer","gateway"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		createTenantRequestTypeExposurePropEnum = append(createTenantRequestTypeExposurePropEnum, v)
+	}
+}
+
+const (
+
+	// CreateTenantRequestExposureDefault captures enum value "default"
+	CreateTenantRequestExposureDefault string = "default"
+
+	// CreateTenantRequestExposureLoadBalancer captures enum value "load-balancer"
+	CreateTenantRequestExposureLoadBalancer string = "load-balancer"
+
+	// CreateTenantRequestExposureGateway captures enum value "gateway"
+	CreateTenantRequestExposureGateway string = "gateway"
+)
+
+// prop value enum
+func (m *CreateTenantRequest) validateExposureEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, createTenantRequestTypeExposurePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *CreateTenantRequest) validateExposure(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Exposure) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateExposureEnum("exposure", "body", *m.Exposure); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *CreateTenantRequest) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
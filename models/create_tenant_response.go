@@ -35,8 +35,17 @@ type CreateTenantResponse struct {
 	// access key
 	AccessKey string `json:"access_key,omitempty"`
 
+	// console host
+	ConsoleHost string `json:"console_host,omitempty"`
+
+	// s3 host
+	S3Host string `json:"s3_host,omitempty"`
+
 	// secret key
 	SecretKey string `json:"secret_key,omitempty"`
+
+	// set when the tenant was created but an integration failed on it
+	Warnings []string `json:"warnings"`
 }
 
 // Validate validates this create tenant response
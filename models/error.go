@@ -23,6 +23,8 @@ package models
 // Editing this file might prove futile when you re-run the swagger generate command
 
 import (
+	"strconv"
+
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -34,18 +36,34 @@ import (
 // swagger:model error
 type Error struct {
 
+	// causes
+	Causes []*ErrorCause `json:"causes"`
+
 	// code
 	Code int64 `json:"code,omitempty"`
 
+	// detailed message
+	DetailedMessage string `json:"detailed_message,omitempty"`
+
 	// message
 	// Required: true
 	Message *string `json:"message"`
+
+	// reason
+	Reason string `json:"reason,omitempty"`
+
+	// request id
+	RequestID string `json:"request_id,omitempty"`
 }
 
 // Validate validates this error
 func (m *Error) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCauses(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMessage(formats); err != nil {
 		res = append(res, err)
 	}
@@ -56,6 +74,31 @@ func (m *Error) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Error) validateCauses(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Causes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Causes); i++ {
+		if swag.IsZero(m.Causes[i]) { // not required
+			continue
+		}
+
+		if m.Causes[i] != nil {
+			if err := m.Causes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("causes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Error) validateMessage(formats strfmt.Registry) error {
 
 	if err := validate.Required("message", "body", m.Message); err != nil {
@@ -0,0 +1,109 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LifecycleExpiration only one of days, date or expired_object_delete_marker can be set
+//
+// swagger:model lifecycleExpiration
+type LifecycleExpiration struct {
+
+	// date
+	// Format: date
+	Date strfmt.Date `json:"date,omitempty"`
+
+	// days
+	// Minimum: 1
+	Days int32 `json:"days,omitempty"`
+
+	// expired object delete marker
+	ExpiredObjectDeleteMarker bool `json:"expired_object_delete_marker,omitempty"`
+}
+
+// Validate validates this lifecycle expiration
+func (m *LifecycleExpiration) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDays(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LifecycleExpiration) validateDate(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Date) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("date", "body", "date", m.Date.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LifecycleExpiration) validateDays(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Days) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("days", "body", int64(m.Days), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LifecycleExpiration) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LifecycleExpiration) UnmarshalBinary(b []byte) error {
+	var res LifecycleExpiration
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
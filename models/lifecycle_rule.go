@@ -0,0 +1,317 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// LifecycleRule lifecycle rule
+//
+// swagger:model lifecycleRule
+type LifecycleRule struct {
+
+	// abort incomplete multipart upload
+	AbortIncompleteMultipartUpload *LifecycleRuleAbortIncompleteMultipartUpload `json:"abort_incomplete_multipart_upload,omitempty"`
+
+	// enabled
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// expiration
+	Expiration *LifecycleExpiration `json:"expiration,omitempty"`
+
+	// id
+	// Required: true
+	// Max Length: 255
+	ID *string `json:"id"`
+
+	// noncurrent version expiration
+	NoncurrentVersionExpiration *LifecycleRuleNoncurrentVersionExpiration `json:"noncurrent_version_expiration,omitempty"`
+
+	// prefix
+	Prefix string `json:"prefix,omitempty"`
+
+	// tags
+	Tags []*LifecycleTag `json:"tags"`
+
+	// actions of the rule that can't be managed by m3 (ie transitions), they're kept when the rule is updated
+	// Read Only: true
+	UnmanagedActions []string `json:"unmanaged_actions"`
+}
+
+// Validate validates this lifecycle rule
+func (m *LifecycleRule) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAbortIncompleteMultipartUpload(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiration(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNoncurrentVersionExpiration(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTags(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LifecycleRule) validateAbortIncompleteMultipartUpload(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.AbortIncompleteMultipartUpload) { // not required
+		return nil
+	}
+
+	if m.AbortIncompleteMultipartUpload != nil {
+		if err := m.AbortIncompleteMultipartUpload.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("abort_incomplete_multipart_upload")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *LifecycleRule) validateExpiration(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Expiration) { // not required
+		return nil
+	}
+
+	if m.Expiration != nil {
+		if err := m.Expiration.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("expiration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *LifecycleRule) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("id", "body", string(*m.ID), 255); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *LifecycleRule) validateNoncurrentVersionExpiration(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.NoncurrentVersionExpiration) { // not required
+		return nil
+	}
+
+	if m.NoncurrentVersionExpiration != nil {
+		if err := m.NoncurrentVersionExpiration.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("noncurrent_version_expiration")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *LifecycleRule) validateTags(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Tags) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Tags); i++ {
+		if swag.IsZero(m.Tags[i]) { // not required
+			continue
+		}
+
+		if m.Tags[i] != nil {
+			if err := m.Tags[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("tags" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LifecycleRule) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LifecycleRule) UnmarshalBinary(b []byte) error {
+	var res LifecycleRule
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// LifecycleRuleAbortIncompleteMultipartUpload lifecycle rule abort incomplete multipart upload
+//
+// swagger:model LifecycleRuleAbortIncompleteMultipartUpload
+type LifecycleRuleAbortIncompleteMultipartUpload struct {
+
+	// days after initiation
+	// Required: true
+	// Minimum: 1
+	DaysAfterInitiation *int32 `json:"days_after_initiation"`
+}
+
+// Validate validates this lifecycle rule abort incomplete multipart upload
+func (m *LifecycleRuleAbortIncompleteMultipartUpload) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDaysAfterInitiation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LifecycleRuleAbortIncompleteMultipartUpload) validateDaysAfterInitiation(formats strfmt.Registry) error {
+
+	if err := validate.Required("abort_incomplete_multipart_upload"+"."+"days_after_initiation", "body", m.DaysAfterInitiation); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("abort_incomplete_multipart_upload"+"."+"days_after_initiation", "body", int64(*m.DaysAfterInitiation), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LifecycleRuleAbortIncompleteMultipartUpload) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LifecycleRuleAbortIncompleteMultipartUpload) UnmarshalBinary(b []byte) error {
+	var res LifecycleRuleAbortIncompleteMultipartUpload
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// LifecycleRuleNoncurrentVersionExpiration lifecycle rule noncurrent version expiration
+//
+// swagger:model LifecycleRuleNoncurrentVersionExpiration
+type LifecycleRuleNoncurrentVersionExpiration struct {
+
+	// noncurrent days
+	// Required: true
+	// Minimum: 1
+	NoncurrentDays *int32 `json:"noncurrent_days"`
+}
+
+// Validate validates this lifecycle rule noncurrent version expiration
+func (m *LifecycleRuleNoncurrentVersionExpiration) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateNoncurrentDays(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *LifecycleRuleNoncurrentVersionExpiration) validateNoncurrentDays(formats strfmt.Registry) error {
+
+	if err := validate.Required("noncurrent_version_expiration"+"."+"noncurrent_days", "body", m.NoncurrentDays); err != nil {
+		return err
+	}
+
+	if err := validate.MinimumInt("noncurrent_version_expiration"+"."+"noncurrent_days", "body", int64(*m.NoncurrentDays), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *LifecycleRuleNoncurrentVersionExpiration) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *LifecycleRuleNoncurrentVersionExpiration) UnmarshalBinary(b []byte) error {
+	var res LifecycleRuleNoncurrentVersionExpiration
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,97 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ListWebhookDeliveriesResponse list webhook deliveries response
+//
+// swagger:model listWebhookDeliveriesResponse
+type ListWebhookDeliveriesResponse struct {
+
+	// deliveries
+	Deliveries []*WebhookDelivery `json:"deliveries"`
+}
+
+// Validate validates this list webhook deliveries response
+func (m *ListWebhookDeliveriesResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDeliveries(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ListWebhookDeliveriesResponse) validateDeliveries(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Deliveries) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Deliveries); i++ {
+		if swag.IsZero(m.Deliveries[i]) { // not required
+			continue
+		}
+
+		if m.Deliveries[i] != nil {
+			if err := m.Deliveries[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("deliveries" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ListWebhookDeliveriesResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ListWebhookDeliveriesResponse) UnmarshalBinary(b []byte) error {
+	var res ListWebhookDeliveriesResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
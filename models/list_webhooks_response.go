@@ -0,0 +1,97 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ListWebhooksResponse list webhooks response
+//
+// swagger:model listWebhooksResponse
+type ListWebhooksResponse struct {
+
+	// webhooks
+	Webhooks []*Webhook `json:"webhooks"`
+}
+
+// Validate validates this list webhooks response
+func (m *ListWebhooksResponse) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateWebhooks(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ListWebhooksResponse) validateWebhooks(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Webhooks) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Webhooks); i++ {
+		if swag.IsZero(m.Webhooks[i]) { // not required
+			continue
+		}
+
+		if m.Webhooks[i] != nil {
+			if err := m.Webhooks[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("webhooks" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ListWebhooksResponse) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ListWebhooksResponse) UnmarshalBinary(b []byte) error {
+	var res ListWebhooksResponse
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
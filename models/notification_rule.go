@@ -0,0 +1,145 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NotificationRule notification rule
+//
+// swagger:model notificationRule
+type NotificationRule struct {
+
+	// events
+	// Required: true
+	// Min Items: 1
+	Events []string `json:"events"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// prefix
+	Prefix string `json:"prefix,omitempty"`
+
+	// suffix
+	Suffix string `json:"suffix,omitempty"`
+
+	// target arn
+	// Required: true
+	TargetArn *string `json:"target_arn"`
+}
+
+// Validate validates this notification rule
+func (m *NotificationRule) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEvents(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTargetArn(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var notificationRuleEventsItemsEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["s3:ObjectCreated:*","s3:ObjectCreated:Put","s3:ObjectCreated:Post","s3:ObjectCreated:Copy","s3:ObjectCreated:CompleteMultipartUpload","s3:ObjectAccessed:*","s3:ObjectAccessed:Get","s3:ObjectAccessed:Head","s3:ObjectRemoved:*","s3:ObjectRemoved:Delete","s3:ObjectRemoved:DeleteMarkerCreated"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		notificationRuleEventsItemsEnum = append(notificationRuleEventsItemsEnum, v)
+	}
+}
+
+func (m *NotificationRule) validateEventsItemsEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, notificationRuleEventsItemsEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *NotificationRule) validateEvents(formats strfmt.Registry) error {
+
+	if err := validate.Required("events", "body", m.Events); err != nil {
+		return err
+	}
+
+	iEventsSize := int64(len(m.Events))
+
+	if err := validate.MinItems("events", "body", iEventsSize, 1); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Events); i++ {
+
+		// value enum
+		if err := m.validateEventsItemsEnum("events"+"."+strconv.Itoa(i), "body", m.Events[i]); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *NotificationRule) validateTargetArn(formats strfmt.Registry) error {
+
+	if err := validate.Required("target_arn", "body", m.TargetArn); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NotificationRule) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NotificationRule) UnmarshalBinary(b []byte) error {
+	var res NotificationRule
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
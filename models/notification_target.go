@@ -0,0 +1,517 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NotificationTarget the credentials of the targets (auth_token, sasl_password, password, token and the password of the amqp url) are returned masked as xxxxx, they're kept when a target is updated with the mask and cleared when it's updated with them empty
+//
+// swagger:model notificationTarget
+type NotificationTarget struct {
+
+	// amqp
+	Amqp *NotificationTargetAmqp `json:"amqp,omitempty"`
+
+	// arn
+	// Read Only: true
+	Arn string `json:"arn,omitempty"`
+
+	// id
+	// Required: true
+	// Max Length: 64
+	// Pattern: ^[A-Za-z0-9_-]+$
+	ID *string `json:"id"`
+
+	// kafka
+	Kafka *NotificationTargetKafka `json:"kafka,omitempty"`
+
+	// nats
+	Nats *NotificationTargetNats `json:"nats,omitempty"`
+
+	// type
+	// Required: true
+	// Enum: [webhook kafka nats amqp]
+	Type *string `json:"type"`
+
+	// webhook
+	Webhook *NotificationTargetWebhook `json:"webhook,omitempty"`
+}
+
+// Validate validates this notification target
+func (m *NotificationTarget) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAmqp(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateKafka(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNats(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateWebhook(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NotificationTarget) validateAmqp(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Amqp) { // not required
+		return nil
+	}
+
+	if m.Amqp != nil {
+		if err := m.Amqp.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("amqp")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NotificationTarget) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	if err := validate.MaxLength("id", "body", string(*m.ID), 64); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("id", "body", string(*m.ID), `^[A-Za-z0-9_-]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationTarget) validateKafka(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Kafka) { // not required
+		return nil
+	}
+
+	if m.Kafka != nil {
+		if err := m.Kafka.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("kafka")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *NotificationTarget) validateNats(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Nats) { // not required
+		return nil
+	}
+
+	if m.Nats != nil {
+		if err := m.Nats.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("nats")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var notificationTargetTypeTypePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["webhook","kafka","nats","amqp"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		notificationTargetTypeTypePropEnum = append(notificationTargetTypeTypePropEnum, v)
+	}
+}
+
+const (
+
+	// NotificationTargetTypeWebhook captures enum value "webhook"
+	NotificationTargetTypeWebhook string = "webhook"
+
+	// NotificationTargetTypeKafka captures enum value "kafka"
+	NotificationTargetTypeKafka string = "kafka"
+
+	// NotificationTargetTypeNats captures enum value "nats"
+	NotificationTargetTypeNats string = "nats"
+
+	// NotificationTargetTypeAmqp captures enum value "amqp"
+	NotificationTargetTypeAmqp string = "amqp"
+)
+
+// prop value enum
+func (m *NotificationTarget) validateTypeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, notificationTargetTypeTypePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *NotificationTarget) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateTypeEnum("type", "body", *m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationTarget) validateWebhook(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Webhook) { // not required
+		return nil
+	}
+
+	if m.Webhook != nil {
+		if err := m.Webhook.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("webhook")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NotificationTarget) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NotificationTarget) UnmarshalBinary(b []byte) error {
+	var res NotificationTarget
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// NotificationTargetAmqp notification target amqp
+//
+// swagger:model NotificationTargetAmqp
+type NotificationTargetAmqp struct {
+
+	// durable
+	Durable bool `json:"durable,omitempty"`
+
+	// exchange
+	Exchange string `json:"exchange,omitempty"`
+
+	// exchange type
+	ExchangeType string `json:"exchange_type,omitempty"`
+
+	// routing key
+	RoutingKey string `json:"routing_key,omitempty"`
+
+	// url
+	URL string `json:"url,omitempty"`
+}
+
+// Validate validates this notification target amqp
+func (m *NotificationTargetAmqp) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NotificationTargetAmqp) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NotificationTargetAmqp) UnmarshalBinary(b []byte) error {
+	var res NotificationTargetAmqp
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// NotificationTargetKafka notification target kafka
+//
+// swagger:model NotificationTargetKafka
+type NotificationTargetKafka struct {
+
+	// brokers
+	// Required: true
+	// Min Items: 1
+	Brokers []string `json:"brokers"`
+
+	// sasl password
+	SaslPassword string `json:"sasl_password,omitempty"`
+
+	// sasl username
+	SaslUsername string `json:"sasl_username,omitempty"`
+
+	// tls
+	TLS bool `json:"tls,omitempty"`
+
+	// topic
+	// Required: true
+	Topic *string `json:"topic"`
+}
+
+// Validate validates this notification target kafka
+func (m *NotificationTargetKafka) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBrokers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTopic(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NotificationTargetKafka) validateBrokers(formats strfmt.Registry) error {
+
+	if err := validate.Required("kafka"+"."+"brokers", "body", m.Brokers); err != nil {
+		return err
+	}
+
+	iBrokersSize := int64(len(m.Brokers))
+
+	if err := validate.MinItems("kafka"+"."+"brokers", "body", iBrokersSize, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationTargetKafka) validateTopic(formats strfmt.Registry) error {
+
+	if err := validate.Required("kafka"+"."+"topic", "body", m.Topic); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NotificationTargetKafka) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NotificationTargetKafka) UnmarshalBinary(b []byte) error {
+	var res NotificationTargetKafka
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// NotificationTargetNats notification target nats
+//
+// swagger:model NotificationTargetNats
+type NotificationTargetNats struct {
+
+	// address
+	// Required: true
+	Address *string `json:"address"`
+
+	// password
+	Password string `json:"password,omitempty"`
+
+	// subject
+	// Required: true
+	Subject *string `json:"subject"`
+
+	// tls
+	TLS bool `json:"tls,omitempty"`
+
+	// token
+	Token string `json:"token,omitempty"`
+
+	// username
+	Username string `json:"username,omitempty"`
+}
+
+// Validate validates this notification target nats
+func (m *NotificationTargetNats) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAddress(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSubject(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NotificationTargetNats) validateAddress(formats strfmt.Registry) error {
+
+	if err := validate.Required("nats"+"."+"address", "body", m.Address); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NotificationTargetNats) validateSubject(formats strfmt.Registry) error {
+
+	if err := validate.Required("nats"+"."+"subject", "body", m.Subject); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NotificationTargetNats) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NotificationTargetNats) UnmarshalBinary(b []byte) error {
+	var res NotificationTargetNats
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// NotificationTargetWebhook notification target webhook
+//
+// swagger:model NotificationTargetWebhook
+type NotificationTargetWebhook struct {
+
+	// auth token
+	AuthToken string `json:"auth_token,omitempty"`
+
+	// endpoint
+	// Required: true
+	Endpoint *string `json:"endpoint"`
+}
+
+// Validate validates this notification target webhook
+func (m *NotificationTargetWebhook) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEndpoint(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NotificationTargetWebhook) validateEndpoint(formats strfmt.Registry) error {
+
+	if err := validate.Required("webhook"+"."+"endpoint", "body", m.Endpoint); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NotificationTargetWebhook) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NotificationTargetWebhook) UnmarshalBinary(b []byte) error {
+	var res NotificationTargetWebhook
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
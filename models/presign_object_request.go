@@ -0,0 +1,161 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PresignObjectRequest presign object request
+//
+// swagger:model presignObjectRequest
+type PresignObjectRequest struct {
+
+	// seconds the url is valid, capped by M3_PRESIGN_MAX_EXPIRY
+	// Minimum: 1
+	Expires int64 `json:"expires,omitempty"`
+
+	// method
+	// Required: true
+	// Enum: [GET PUT]
+	Method *string `json:"method"`
+
+	// object
+	// Required: true
+	// Min Length: 1
+	Object *string `json:"object"`
+}
+
+// Validate validates this presign object request
+func (m *PresignObjectRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateExpires(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMethod(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateObject(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PresignObjectRequest) validateExpires(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Expires) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("expires", "body", int64(m.Expires), 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var presignObjectRequestTypeMethodPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["GET","PUT"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		presignObjectRequestTypeMethodPropEnum = append(presignObjectRequestTypeMethodPropEnum, v)
+	}
+}
+
+const (
+
+	// PresignObjectRequestMethodGET captures enum value "GET"
+	PresignObjectRequestMethodGET string = "GET"
+
+	// PresignObjectRequestMethodPUT captures enum value "PUT"
+	PresignObjectRequestMethodPUT string = "PUT"
+)
+
+// prop value enum
+func (m *PresignObjectRequest) validateMethodEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, presignObjectRequestTypeMethodPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PresignObjectRequest) validateMethod(formats strfmt.Registry) error {
+
+	if err := validate.Required("method", "body", m.Method); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateMethodEnum("method", "body", *m.Method); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PresignObjectRequest) validateObject(formats strfmt.Registry) error {
+
+	if err := validate.Required("object", "body", m.Object); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("object", "body", string(*m.Object), 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PresignObjectRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PresignObjectRequest) UnmarshalBinary(b []byte) error {
+	var res PresignObjectRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
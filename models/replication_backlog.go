@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ReplicationBacklog replication backlog
+//
+// swagger:model replicationBacklog
+type ReplicationBacklog struct {
+
+	// failed count
+	FailedCount int64 `json:"failed_count"`
+
+	// failed size
+	FailedSize int64 `json:"failed_size"`
+
+	// pending count
+	PendingCount int64 `json:"pending_count"`
+
+	// pending size
+	PendingSize int64 `json:"pending_size"`
+
+	// replicated size
+	ReplicatedSize int64 `json:"replicated_size"`
+}
+
+// Validate validates this replication backlog
+func (m *ReplicationBacklog) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReplicationBacklog) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReplicationBacklog) UnmarshalBinary(b []byte) error {
+	var res ReplicationBacklog
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// ReplicationTarget replication target
+//
+// swagger:model replicationTarget
+type ReplicationTarget struct {
+
+	// arn
+	Arn string `json:"arn,omitempty"`
+
+	// backlog
+	Backlog *ReplicationBacklog `json:"backlog,omitempty"`
+
+	// bucket
+	Bucket string `json:"bucket,omitempty"`
+
+	// endpoint
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// namespace of the target tenant, only set for the targets created by m3
+	Namespace string `json:"namespace,omitempty"`
+
+	// not reported by older MinIO releases
+	Online *bool `json:"online,omitempty"`
+
+	// replicate deletes
+	ReplicateDeletes bool `json:"replicate_deletes,omitempty"`
+
+	// rule id
+	RuleID string `json:"rule_id,omitempty"`
+
+	// status of the replication rule of the target, empty when no rule replicates to the target
+	Status string `json:"status,omitempty"`
+
+	// name of the target tenant, only set for the targets created by m3
+	Tenant string `json:"tenant,omitempty"`
+}
+
+// Validate validates this replication target
+func (m *ReplicationTarget) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBacklog(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReplicationTarget) validateBacklog(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Backlog) { // not required
+		return nil
+	}
+
+	if m.Backlog != nil {
+		if err := m.Backlog.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("backlog")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReplicationTarget) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReplicationTarget) UnmarshalBinary(b []byte) error {
+	var res ReplicationTarget
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
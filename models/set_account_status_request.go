@@ -0,0 +1,118 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SetAccountStatusRequest set account status request
+//
+// swagger:model setAccountStatusRequest
+type SetAccountStatusRequest struct {
+
+	// status
+	// Required: true
+	// Enum: [enabled disabled]
+	Status *string `json:"status"`
+}
+
+// Validate validates this set account status request
+func (m *SetAccountStatusRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var setAccountStatusRequestTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["enabled","disabled"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		setAccountStatusRequestTypeStatusPropEnum = append(setAccountStatusRequestTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// SetAccountStatusRequestStatusEnabled captures enum value "enabled"
+	SetAccountStatusRequestStatusEnabled string = "enabled"
+
+	// SetAccountStatusRequestStatusDisabled captures enum value "disabled"
+	SetAccountStatusRequestStatusDisabled string = "disabled"
+)
+
+// prop value enum
+func (m *SetAccountStatusRequest) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, setAccountStatusRequestTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *SetAccountStatusRequest) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SetAccountStatusRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SetAccountStatusRequest) UnmarshalBinary(b []byte) error {
+	var res SetAccountStatusRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
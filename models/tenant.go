@@ -41,9 +41,15 @@ type Tenant struct {
 	// current state
 	CurrentState string `json:"currentState,omitempty"`
 
+	// health
+	Health *TenantHealth `json:"health,omitempty"`
+
 	// instance count
 	InstanceCount int64 `json:"instance_count,omitempty"`
 
+	// integrations
+	Integrations []*IntegrationStatus `json:"integrations"`
+
 	// name
 	Name string `json:"name,omitempty"`
 
@@ -70,6 +76,14 @@ type Tenant struct {
 func (m *Tenant) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateHealth(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIntegrations(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateZones(formats); err != nil {
 		res = append(res, err)
 	}
@@ -80,6 +94,49 @@ func (m *Tenant) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Tenant) validateHealth(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Health) { // not required
+		return nil
+	}
+
+	if m.Health != nil {
+		if err := m.Health.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("health")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Tenant) validateIntegrations(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Integrations) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Integrations); i++ {
+		if swag.IsZero(m.Integrations[i]) { // not required
+			continue
+		}
+
+		if m.Integrations[i] != nil {
+			if err := m.Integrations[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("integrations" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Tenant) validateZones(formats strfmt.Registry) error {
 
 	if swag.IsZero(m.Zones) { // not required
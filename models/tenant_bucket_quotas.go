@@ -0,0 +1,109 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// TenantBucketQuotas tenant bucket quotas
+//
+// swagger:model tenantBucketQuotas
+type TenantBucketQuotas struct {
+
+	// default quota of the new buckets, from the tenant or from its namespace
+	DefaultQuota string `json:"default_quota,omitempty"`
+
+	// quotas
+	Quotas []*BucketQuota `json:"quotas"`
+
+	// total quota
+	TotalQuota int64 `json:"total_quota,omitempty"`
+
+	// capacity of the tenant left after erasure coding parity
+	UsableCapacity int64 `json:"usable_capacity,omitempty"`
+
+	// warnings
+	Warnings []string `json:"warnings"`
+}
+
+// Validate validates this tenant bucket quotas
+func (m *TenantBucketQuotas) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateQuotas(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TenantBucketQuotas) validateQuotas(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Quotas) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Quotas); i++ {
+		if swag.IsZero(m.Quotas[i]) { // not required
+			continue
+		}
+
+		if m.Quotas[i] != nil {
+			if err := m.Quotas[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("quotas" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TenantBucketQuotas) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TenantBucketQuotas) UnmarshalBinary(b []byte) error {
+	var res TenantBucketQuotas
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
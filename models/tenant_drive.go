@@ -0,0 +1,141 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TenantDrive tenant drive
+//
+// swagger:model tenantDrive
+type TenantDrive struct {
+
+	// index of the erasure set of the drive, -1 when it's unknown
+	ErasureSet int32 `json:"erasure_set"`
+
+	// path
+	Path string `json:"path,omitempty"`
+
+	// state of the drive as reported by MinIO
+	ReportedState string `json:"reported_state,omitempty"`
+
+	// position of the drive within its erasure set
+	SetIndex int32 `json:"set_index"`
+
+	// state
+	// Enum: [online offline healing]
+	State string `json:"state,omitempty"`
+
+	// total space
+	TotalSpace int64 `json:"total_space,omitempty"`
+
+	// used space
+	UsedSpace int64 `json:"used_space,omitempty"`
+
+	// uuid
+	UUID string `json:"uuid,omitempty"`
+}
+
+// Validate validates this tenant drive
+func (m *TenantDrive) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var tenantDriveTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["online","offline","healing"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		tenantDriveTypeStatePropEnum = append(tenantDriveTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// TenantDriveStateOnline captures enum value "online"
+	TenantDriveStateOnline string = "online"
+
+	// TenantDriveStateOffline captures enum value "offline"
+	TenantDriveStateOffline string = "offline"
+
+	// TenantDriveStateHealing captures enum value "healing"
+	TenantDriveStateHealing string = "healing"
+)
+
+// prop value enum
+func (m *TenantDrive) validateStateEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, tenantDriveTypeStatePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TenantDrive) validateState(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.State) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TenantDrive) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TenantDrive) UnmarshalBinary(b []byte) error {
+	var res TenantDrive
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
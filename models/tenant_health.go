@@ -0,0 +1,231 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TenantHealth tenant health
+//
+// swagger:model tenantHealth
+type TenantHealth struct {
+
+	// checked at
+	// Format: date-time
+	CheckedAt strfmt.DateTime `json:"checked_at,omitempty"`
+
+	// cluster
+	Cluster *TenantClusterHealth `json:"cluster,omitempty"`
+
+	// grade
+	// Enum: [green yellow red]
+	Grade string `json:"grade,omitempty"`
+
+	// history
+	History []*TenantHealthHistory `json:"history"`
+
+	// nodes
+	Nodes []*TenantNodeHealth `json:"nodes"`
+}
+
+// Validate validates this tenant health
+func (m *TenantHealth) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCheckedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCluster(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGrade(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateHistory(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNodes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TenantHealth) validateCheckedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CheckedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("checked_at", "body", "date-time", m.CheckedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TenantHealth) validateCluster(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Cluster) { // not required
+		return nil
+	}
+
+	if m.Cluster != nil {
+		if err := m.Cluster.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("cluster")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+var tenantHealthTypeGradePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["green","yellow","red"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		tenantHealthTypeGradePropEnum = append(tenantHealthTypeGradePropEnum, v)
+	}
+}
+
+const (
+
+	// TenantHealthGradeGreen captures enum value "green"
+	TenantHealthGradeGreen string = "green"
+
+	// TenantHealthGradeYellow captures enum value "yellow"
+	TenantHealthGradeYellow string = "yellow"
+
+	// TenantHealthGradeRed captures enum value "red"
+	TenantHealthGradeRed string = "red"
+)
+
+// prop value enum
+func (m *TenantHealth) validateGradeEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, tenantHealthTypeGradePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TenantHealth) validateGrade(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Grade) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateGradeEnum("grade", "body", m.Grade); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TenantHealth) validateHistory(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.History) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.History); i++ {
+		if swag.IsZero(m.History[i]) { // not required
+			continue
+		}
+
+		if m.History[i] != nil {
+			if err := m.History[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("history" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TenantHealth) validateNodes(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Nodes) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Nodes); i++ {
+		if swag.IsZero(m.Nodes[i]) { // not required
+			continue
+		}
+
+		if m.Nodes[i] != nil {
+			if err := m.Nodes[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("nodes" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TenantHealth) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TenantHealth) UnmarshalBinary(b []byte) error {
+	var res TenantHealth
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
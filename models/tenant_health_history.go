@@ -0,0 +1,94 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TenantHealthHistory tenant health history
+//
+// swagger:model tenantHealthHistory
+type TenantHealthHistory struct {
+
+	// checked at
+	// Format: date-time
+	CheckedAt strfmt.DateTime `json:"checked_at,omitempty"`
+
+	// grade
+	Grade string `json:"grade,omitempty"`
+
+	// online nodes
+	OnlineNodes int64 `json:"online_nodes,omitempty"`
+
+	// total nodes
+	TotalNodes int64 `json:"total_nodes,omitempty"`
+}
+
+// Validate validates this tenant health history
+func (m *TenantHealthHistory) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCheckedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TenantHealthHistory) validateCheckedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CheckedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("checked_at", "body", "date-time", m.CheckedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TenantHealthHistory) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TenantHealthHistory) UnmarshalBinary(b []byte) error {
+	var res TenantHealthHistory
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
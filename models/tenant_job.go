@@ -0,0 +1,147 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TenantJob tenant job
+//
+// swagger:model tenantJob
+type TenantJob struct {
+
+	// action
+	Action string `json:"action,omitempty"`
+
+	// command
+	Command []string `json:"command"`
+
+	// completed
+	Completed string `json:"completed,omitempty"`
+
+	// created
+	Created string `json:"created,omitempty"`
+
+	// exit code
+	ExitCode *int32 `json:"exit_code,omitempty"`
+
+	// message
+	Message string `json:"message,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// started
+	Started string `json:"started,omitempty"`
+
+	// status
+	// Enum: [pending running succeeded failed]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this tenant job
+func (m *TenantJob) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var tenantJobTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","running","succeeded","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		tenantJobTypeStatusPropEnum = append(tenantJobTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// TenantJobStatusPending captures enum value "pending"
+	TenantJobStatusPending string = "pending"
+
+	// TenantJobStatusRunning captures enum value "running"
+	TenantJobStatusRunning string = "running"
+
+	// TenantJobStatusSucceeded captures enum value "succeeded"
+	TenantJobStatusSucceeded string = "succeeded"
+
+	// TenantJobStatusFailed captures enum value "failed"
+	TenantJobStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *TenantJob) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, tenantJobTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TenantJob) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TenantJob) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TenantJob) UnmarshalBinary(b []byte) error {
+	var res TenantJob
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
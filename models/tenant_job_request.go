@@ -0,0 +1,145 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TenantJobRequest tenant job request
+//
+// swagger:model tenantJobRequest
+type TenantJobRequest struct {
+
+	// action
+	// Required: true
+	// Enum: [mirror heal admin-info]
+	Action *string `json:"action"`
+
+	// bucket mirrored or healed, every bucket is healed when empty
+	Bucket string `json:"bucket,omitempty"`
+
+	// only report what would be healed
+	DryRun bool `json:"dry_run,omitempty"`
+
+	// overwrite the objects that differ on the target bucket
+	Overwrite bool `json:"overwrite,omitempty"`
+
+	// prefix of the objects healed
+	Prefix string `json:"prefix,omitempty"`
+
+	// heal every object under the bucket or prefix
+	Recursive bool `json:"recursive,omitempty"`
+
+	// remove the objects of the target bucket that don't exist on the mirrored bucket
+	Remove bool `json:"remove,omitempty"`
+
+	// bucket the bucket is mirrored to
+	TargetBucket string `json:"target_bucket,omitempty"`
+
+	// tenant of the namespace the bucket is mirrored to, the same tenant when empty
+	TargetTenant string `json:"target_tenant,omitempty"`
+}
+
+// Validate validates this tenant job request
+func (m *TenantJobRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var tenantJobRequestTypeActionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["mirror","heal","admin-info"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		tenantJobRequestTypeActionPropEnum = append(tenantJobRequestTypeActionPropEnum, v)
+	}
+}
+
+const (
+
+	// TenantJobRequestActionMirror captures enum value "mirror"
+	TenantJobRequestActionMirror string = "mirror"
+
+	// TenantJobRequestActionHeal captures enum value "heal"
+	TenantJobRequestActionHeal string = "heal"
+
+	// TenantJobRequestActionAdminInfo captures enum value "admin-info"
+	TenantJobRequestActionAdminInfo string = "admin-info"
+)
+
+// prop value enum
+func (m *TenantJobRequest) validateActionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, tenantJobRequestTypeActionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TenantJobRequest) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateActionEnum("action", "body", *m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TenantJobRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TenantJobRequest) UnmarshalBinary(b []byte) error {
+	var res TenantJobRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,129 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TenantNodeHealth tenant node health
+//
+// swagger:model tenantNodeHealth
+type TenantNodeHealth struct {
+
+	// endpoint
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// status
+	// Enum: [online initializing offline]
+	Status string `json:"status,omitempty"`
+}
+
+// Validate validates this tenant node health
+func (m *TenantNodeHealth) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var tenantNodeHealthTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["online","initializing","offline"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		tenantNodeHealthTypeStatusPropEnum = append(tenantNodeHealthTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// TenantNodeHealthStatusOnline captures enum value "online"
+	TenantNodeHealthStatusOnline string = "online"
+
+	// TenantNodeHealthStatusInitializing captures enum value "initializing"
+	TenantNodeHealthStatusInitializing string = "initializing"
+
+	// TenantNodeHealthStatusOffline captures enum value "offline"
+	TenantNodeHealthStatusOffline string = "offline"
+)
+
+// prop value enum
+func (m *TenantNodeHealth) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, tenantNodeHealthTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *TenantNodeHealth) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TenantNodeHealth) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TenantNodeHealth) UnmarshalBinary(b []byte) error {
+	var res TenantNodeHealth
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// TenantServerInfo tenant server info
+//
+// swagger:model tenantServerInfo
+type TenantServerInfo struct {
+
+	// deployment id
+	DeploymentID string `json:"deployment_id,omitempty"`
+
+	// erasure sets
+	ErasureSets int64 `json:"erasure_sets,omitempty"`
+
+	// healing drives
+	HealingDrives int64 `json:"healing_drives,omitempty"`
+
+	// mode
+	Mode string `json:"mode,omitempty"`
+
+	// offline drives
+	OfflineDrives int64 `json:"offline_drives,omitempty"`
+
+	// online drives
+	OnlineDrives int64 `json:"online_drives,omitempty"`
+
+	// rrsc parity
+	RrscParity int64 `json:"rrsc_parity,omitempty"`
+
+	// servers
+	Servers []*TenantServer `json:"servers"`
+
+	// standard sc parity
+	StandardScParity int64 `json:"standard_sc_parity,omitempty"`
+
+	// total space
+	TotalSpace int64 `json:"total_space,omitempty"`
+
+	// used space
+	UsedSpace int64 `json:"used_space,omitempty"`
+
+	// version skew
+	VersionSkew bool `json:"version_skew,omitempty"`
+
+	// distinct versions of MinIO running on the servers
+	Versions []string `json:"versions"`
+}
+
+// Validate validates this tenant server info
+func (m *TenantServerInfo) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateServers(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TenantServerInfo) validateServers(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Servers) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Servers); i++ {
+		if swag.IsZero(m.Servers[i]) { // not required
+			continue
+		}
+
+		if m.Servers[i] != nil {
+			if err := m.Servers[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("servers" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TenantServerInfo) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TenantServerInfo) UnmarshalBinary(b []byte) error {
+	var res TenantServerInfo
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,140 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TenantUsage tenant usage
+//
+// swagger:model tenantUsage
+type TenantUsage struct {
+
+	// buckets
+	Buckets []*BucketUsage `json:"buckets"`
+
+	// buckets count
+	BucketsCount int64 `json:"buckets_count,omitempty"`
+
+	// set in the cluster usage when the usage of the tenant couldn't be read
+	Error string `json:"error,omitempty"`
+
+	// time of the last data usage scan of the tenant
+	// Format: date-time
+	LastUpdate strfmt.DateTime `json:"last_update,omitempty"`
+
+	// namespace
+	Namespace string `json:"namespace,omitempty"`
+
+	// objects
+	Objects int64 `json:"objects,omitempty"`
+
+	// size
+	Size int64 `json:"size,omitempty"`
+
+	// tenant
+	Tenant string `json:"tenant,omitempty"`
+
+	// versions
+	Versions int64 `json:"versions,omitempty"`
+}
+
+// Validate validates this tenant usage
+func (m *TenantUsage) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateBuckets(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastUpdate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TenantUsage) validateBuckets(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Buckets) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Buckets); i++ {
+		if swag.IsZero(m.Buckets[i]) { // not required
+			continue
+		}
+
+		if m.Buckets[i] != nil {
+			if err := m.Buckets[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("buckets" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *TenantUsage) validateLastUpdate(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LastUpdate) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_update", "body", "date-time", m.LastUpdate.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TenantUsage) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TenantUsage) UnmarshalBinary(b []byte) error {
+	var res TenantUsage
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
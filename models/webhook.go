@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Webhook webhook
+//
+// swagger:model webhook
+type Webhook struct {
+
+	// events delivered to the webhook, all of them when empty
+	Events []string `json:"events"`
+
+	// name
+	// Required: true
+	// Pattern: ^[a-z0-9]([-a-z0-9]*[a-z0-9])?$
+	Name *string `json:"name"`
+
+	// key of the HMAC-SHA256 signature of the deliveries, generated when empty
+	Secret string `json:"secret,omitempty"`
+
+	// url
+	// Required: true
+	URL *string `json:"url"`
+}
+
+// Validate validates this webhook
+func (m *Webhook) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEvents(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var webhookEventsItemsEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["tenant.created","tenant.ready","tenant.upgraded","tenant.deleted","tenant.failed","quota.threshold"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		webhookEventsItemsEnum = append(webhookEventsItemsEnum, v)
+	}
+}
+
+func (m *Webhook) validateEventsItemsEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, webhookEventsItemsEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Webhook) validateEvents(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Events) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Events); i++ {
+
+		// value enum
+		if err := m.validateEventsItemsEnum("events"+"."+strconv.Itoa(i), "body", m.Events[i]); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Webhook) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.Pattern("name", "body", string(*m.Name), `^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Webhook) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("url", "body", m.URL); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Webhook) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Webhook) UnmarshalBinary(b []byte) error {
+	var res Webhook
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
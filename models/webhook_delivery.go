@@ -0,0 +1,186 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// WebhookDelivery webhook delivery
+//
+// swagger:model webhookDelivery
+type WebhookDelivery struct {
+
+	// attempts
+	Attempts int64 `json:"attempts,omitempty"`
+
+	// created at
+	// Format: date-time
+	CreatedAt strfmt.DateTime `json:"created_at,omitempty"`
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// event
+	Event string `json:"event,omitempty"`
+
+	// event id
+	EventID string `json:"event_id,omitempty"`
+
+	// id
+	ID string `json:"id,omitempty"`
+
+	// last attempt at
+	// Format: date-time
+	LastAttemptAt strfmt.DateTime `json:"last_attempt_at,omitempty"`
+
+	// response code
+	ResponseCode int64 `json:"response_code,omitempty"`
+
+	// status
+	// Enum: [pending delivered failed]
+	Status string `json:"status,omitempty"`
+
+	// url
+	URL string `json:"url,omitempty"`
+
+	// webhook
+	Webhook string `json:"webhook,omitempty"`
+}
+
+// Validate validates this webhook delivery
+func (m *WebhookDelivery) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastAttemptAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) validateCreatedAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("created_at", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *WebhookDelivery) validateLastAttemptAt(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.LastAttemptAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("last_attempt_at", "body", "date-time", m.LastAttemptAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var webhookDeliveryTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","delivered","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		webhookDeliveryTypeStatusPropEnum = append(webhookDeliveryTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// WebhookDeliveryStatusPending captures enum value "pending"
+	WebhookDeliveryStatusPending string = "pending"
+
+	// WebhookDeliveryStatusDelivered captures enum value "delivered"
+	WebhookDeliveryStatusDelivered string = "delivered"
+
+	// WebhookDeliveryStatusFailed captures enum value "failed"
+	WebhookDeliveryStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *WebhookDelivery) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, webhookDeliveryTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *WebhookDelivery) validateStatus(formats strfmt.Registry) error {
+
+	if swag.IsZero(m.Status) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *WebhookDelivery) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *WebhookDelivery) UnmarshalBinary(b []byte) error {
+	var res WebhookDelivery
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
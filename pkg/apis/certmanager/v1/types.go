@@ -0,0 +1,141 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateList is a list of Certificate objects.
+type CertificateList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Certificate `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Certificate is a request to cert-manager to issue a certificate for a set of DNS names, the signed
+// certificate and its private key are stored on the Secret named by the spec.
+type Certificate struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec CertificateSpec `json:"spec"`
+
+	// +optional
+	Status CertificateStatus `json:"status,omitempty"`
+}
+
+// CertificateSpec is the desired state of a Certificate.
+type CertificateSpec struct {
+	// CommonName of the certificate, it's optional as long as DNSNames is set.
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+
+	// Duration of the certificate, cert-manager defaults it to 90 days.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// RenewBefore is how long before the expiry the certificate is renewed.
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+
+	// DNSNames is the list of subject alt names of the certificate.
+	// +optional
+	DNSNames []string `json:"dnsNames,omitempty"`
+
+	// SecretName is the name of the kubernetes.io/tls Secret where the certificate and its key are stored.
+	SecretName string `json:"secretName"`
+
+	// IssuerRef is the Issuer or ClusterIssuer signing the certificate.
+	IssuerRef IssuerReference `json:"issuerRef"`
+}
+
+// IssuerReference is a reference to the issuer of a certificate.
+type IssuerReference struct {
+	Name string `json:"name"`
+	// Kind of the issuer, Issuer or ClusterIssuer for the issuers provided by cert-manager.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Group of the issuer, empty for the issuers provided by cert-manager.
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
+// CertificateStatus is the observed state of a Certificate.
+type CertificateStatus struct {
+	// +optional
+	Conditions []CertificateCondition `json:"conditions,omitempty"`
+
+	// LastFailureTime is the time the last issuance attempt failed.
+	// +optional
+	LastFailureTime *metav1.Time `json:"lastFailureTime,omitempty"`
+
+	// NotBefore is the time the certificate stored on the Secret becomes valid.
+	// +optional
+	NotBefore *metav1.Time `json:"notBefore,omitempty"`
+
+	// NotAfter is the expiry time of the certificate stored on the Secret.
+	// +optional
+	NotAfter *metav1.Time `json:"notAfter,omitempty"`
+
+	// RenewalTime is the time cert-manager will renew the certificate.
+	// +optional
+	RenewalTime *metav1.Time `json:"renewalTime,omitempty"`
+}
+
+// CertificateConditionType is the type of a CertificateCondition.
+type CertificateConditionType string
+
+const (
+	// CertificateConditionReady is True once the certificate is issued and up to date.
+	CertificateConditionReady CertificateConditionType = "Ready"
+	// CertificateConditionIssuing is True while a new certificate is being issued.
+	CertificateConditionIssuing CertificateConditionType = "Issuing"
+)
+
+// ConditionStatus is the status of a condition, one of True, False or Unknown.
+type ConditionStatus string
+
+const (
+	ConditionTrue    ConditionStatus = "True"
+	ConditionFalse   ConditionStatus = "False"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// CertificateCondition is a condition of a Certificate.
+type CertificateCondition struct {
+	Type   CertificateConditionType `json:"type"`
+	Status ConditionStatus          `json:"status"`
+
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Reason is a machine readable explanation of the last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human readable explanation of the last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -0,0 +1,22 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+// +k8s:deepcopy-gen=package,register
+
+// Package v1 is the subset of the gateway.networking.k8s.io/v1 API used by m3 to expose the tenants through
+// HTTPRoutes attached to a Gateway.
+// +groupName=gateway.networking.k8s.io
+// +groupGoName=Gateway
+package v1
@@ -0,0 +1,202 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HTTPRouteList is a list of HTTPRoute objects.
+type HTTPRouteList struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ListMeta `json:"metadata"`
+
+	Items []HTTPRoute `json:"items"`
+}
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// HTTPRoute routes the HTTP requests received by the Gateways it's attached to for a set of hostnames to
+// the backends of its rules.
+type HTTPRoute struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec HTTPRouteSpec `json:"spec"`
+
+	// +optional
+	Status HTTPRouteStatus `json:"status,omitempty"`
+}
+
+// HTTPRouteSpec is the desired state of an HTTPRoute.
+type HTTPRouteSpec struct {
+	// ParentRefs are the Gateways the route is attached to.
+	// +optional
+	ParentRefs []ParentReference `json:"parentRefs,omitempty"`
+
+	// Hostnames matched against the Host header of the requests.
+	// +optional
+	Hostnames []Hostname `json:"hostnames,omitempty"`
+
+	// +optional
+	Rules []HTTPRouteRule `json:"rules,omitempty"`
+}
+
+// Hostname is the fully qualified domain name of a network host.
+type Hostname string
+
+// ParentReference identifies the Gateway, and optionally one of its listeners, a route is attached to.
+type ParentReference struct {
+	// Group of the parent, gateway.networking.k8s.io when empty.
+	// +optional
+	Group *string `json:"group,omitempty"`
+
+	// Kind of the parent, Gateway when empty.
+	// +optional
+	Kind *string `json:"kind,omitempty"`
+
+	// Namespace of the parent, the namespace of the route when empty.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	Name string `json:"name"`
+
+	// SectionName is the name of the listener of the Gateway, every listener when empty.
+	// +optional
+	SectionName *string `json:"sectionName,omitempty"`
+}
+
+// HTTPRouteRule routes the requests matching any of its matches to its backends.
+type HTTPRouteRule struct {
+	// +optional
+	Matches []HTTPRouteMatch `json:"matches,omitempty"`
+
+	// +optional
+	BackendRefs []HTTPBackendRef `json:"backendRefs,omitempty"`
+}
+
+// HTTPRouteMatch matches the requests by path.
+type HTTPRouteMatch struct {
+	// +optional
+	Path *HTTPPathMatch `json:"path,omitempty"`
+}
+
+// PathMatchType is how a path is matched, ie: PathPrefix.
+type PathMatchType string
+
+const (
+	// PathMatchPathPrefix matches the requests whose path starts with the value.
+	PathMatchPathPrefix PathMatchType = "PathPrefix"
+	// PathMatchExact matches the requests whose path is the value.
+	PathMatchExact PathMatchType = "Exact"
+)
+
+// HTTPPathMatch matches the path of the requests.
+type HTTPPathMatch struct {
+	// +optional
+	Type *PathMatchType `json:"type,omitempty"`
+
+	// +optional
+	Value *string `json:"value,omitempty"`
+}
+
+// HTTPBackendRef is the backend the matching requests are sent to, the fields of the upstream BackendRef
+// and BackendObjectReference used by m3 are inlined.
+type HTTPBackendRef struct {
+	// Group of the backend, the core group when empty.
+	// +optional
+	Group *string `json:"group,omitempty"`
+
+	// Kind of the backend, Service when empty.
+	// +optional
+	Kind *string `json:"kind,omitempty"`
+
+	Name string `json:"name"`
+
+	// Namespace of the backend, the namespace of the route when empty.
+	// +optional
+	Namespace *string `json:"namespace,omitempty"`
+
+	// Port of the Service backend.
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+
+	// +optional
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// HTTPRouteStatus is the observed state of an HTTPRoute, it's reported by the controllers of the Gateways the
+// route is attached to.
+type HTTPRouteStatus struct {
+	// +optional
+	Parents []RouteParentStatus `json:"parents,omitempty"`
+}
+
+// RouteParentStatus is the status of the route on one of its parents.
+type RouteParentStatus struct {
+	ParentRef ParentReference `json:"parentRef"`
+
+	// ControllerName of the controller of the Gateway writing the status.
+	ControllerName string `json:"controllerName"`
+
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// RouteConditionType is the type of a condition of a route.
+type RouteConditionType string
+
+const (
+	// RouteConditionAccepted is True once the Gateway accepted the route.
+	RouteConditionAccepted RouteConditionType = "Accepted"
+	// RouteConditionResolvedRefs is True once every backend of the route was resolved.
+	RouteConditionResolvedRefs RouteConditionType = "ResolvedRefs"
+)
+
+// ConditionStatus is the status of a condition, one of True, False or Unknown.
+type ConditionStatus string
+
+const (
+	ConditionTrue    ConditionStatus = "True"
+	ConditionFalse   ConditionStatus = "False"
+	ConditionUnknown ConditionStatus = "Unknown"
+)
+
+// Condition mirrors the metav1.Condition used by the Gateway API, it's not available on the apimachinery
+// version used by m3.
+type Condition struct {
+	Type   string          `json:"type"`
+	Status ConditionStatus `json:"status"`
+
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Reason is a machine readable explanation of the last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human readable explanation of the last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -21,6 +21,8 @@ package versioned
 import (
 	"fmt"
 
+	certmanagerv1 "github.com/minio/m3/pkg/clientgen/clientset/versioned/typed/certmanager/v1"
+	gatewayv1 "github.com/minio/m3/pkg/clientgen/clientset/versioned/typed/gateway/v1"
 	networkingv1beta2 "github.com/minio/m3/pkg/clientgen/clientset/versioned/typed/networking.gke.io/v1beta2"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
@@ -29,6 +31,8 @@ import (
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
+	CertmanagerV1() certmanagerv1.CertmanagerV1Interface
+	GatewayV1() gatewayv1.GatewayV1Interface
 	NetworkingV1beta2() networkingv1beta2.NetworkingV1beta2Interface
 }
 
@@ -36,9 +40,21 @@ type Interface interface {
 // version included in a Clientset.
 type Clientset struct {
 	*discovery.DiscoveryClient
+	certmanagerV1     *certmanagerv1.CertmanagerV1Client
+	gatewayV1         *gatewayv1.GatewayV1Client
 	networkingV1beta2 *networkingv1beta2.NetworkingV1beta2Client
 }
 
+// CertmanagerV1 retrieves the CertmanagerV1Client
+func (c *Clientset) CertmanagerV1() certmanagerv1.CertmanagerV1Interface {
+	return c.certmanagerV1
+}
+
+// GatewayV1 retrieves the GatewayV1Client
+func (c *Clientset) GatewayV1() gatewayv1.GatewayV1Interface {
+	return c.gatewayV1
+}
+
 // NetworkingV1beta2 retrieves the NetworkingV1beta2Client
 func (c *Clientset) NetworkingV1beta2() networkingv1beta2.NetworkingV1beta2Interface {
 	return c.networkingV1beta2
@@ -65,6 +81,14 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 	}
 	var cs Clientset
 	var err error
+	cs.certmanagerV1, err = certmanagerv1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
+	cs.gatewayV1, err = gatewayv1.NewForConfig(&configShallowCopy)
+	if err != nil {
+		return nil, err
+	}
 	cs.networkingV1beta2, err = networkingv1beta2.NewForConfig(&configShallowCopy)
 	if err != nil {
 		return nil, err
@@ -81,6 +105,8 @@ func NewForConfig(c *rest.Config) (*Clientset, error) {
 // panics if there is an error in the config.
 func NewForConfigOrDie(c *rest.Config) *Clientset {
 	var cs Clientset
+	cs.certmanagerV1 = certmanagerv1.NewForConfigOrDie(c)
+	cs.gatewayV1 = gatewayv1.NewForConfigOrDie(c)
 	cs.networkingV1beta2 = networkingv1beta2.NewForConfigOrDie(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClientForConfigOrDie(c)
@@ -90,6 +116,8 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 // New creates a new Clientset for the given RESTClient.
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
+	cs.certmanagerV1 = certmanagerv1.New(c)
+	cs.gatewayV1 = gatewayv1.New(c)
 	cs.networkingV1beta2 = networkingv1beta2.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
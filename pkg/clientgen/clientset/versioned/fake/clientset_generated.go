@@ -20,6 +20,10 @@ package fake
 
 import (
 	clientset "github.com/minio/m3/pkg/clientgen/clientset/versioned"
+	certmanagerv1 "github.com/minio/m3/pkg/clientgen/clientset/versioned/typed/certmanager/v1"
+	fakecertmanagerv1 "github.com/minio/m3/pkg/clientgen/clientset/versioned/typed/certmanager/v1/fake"
+	gatewayv1 "github.com/minio/m3/pkg/clientgen/clientset/versioned/typed/gateway/v1"
+	fakegatewayv1 "github.com/minio/m3/pkg/clientgen/clientset/versioned/typed/gateway/v1/fake"
 	networkingv1beta2 "github.com/minio/m3/pkg/clientgen/clientset/versioned/typed/networking.gke.io/v1beta2"
 	fakenetworkingv1beta2 "github.com/minio/m3/pkg/clientgen/clientset/versioned/typed/networking.gke.io/v1beta2/fake"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -76,6 +80,16 @@ func (c *Clientset) Tracker() testing.ObjectTracker {
 
 var _ clientset.Interface = &Clientset{}
 
+// CertmanagerV1 retrieves the CertmanagerV1Client
+func (c *Clientset) CertmanagerV1() certmanagerv1.CertmanagerV1Interface {
+	return &fakecertmanagerv1.FakeCertmanagerV1{Fake: &c.Fake}
+}
+
+// GatewayV1 retrieves the GatewayV1Client
+func (c *Clientset) GatewayV1() gatewayv1.GatewayV1Interface {
+	return &fakegatewayv1.FakeGatewayV1{Fake: &c.Fake}
+}
+
 // NetworkingV1beta2 retrieves the NetworkingV1beta2Client
 func (c *Clientset) NetworkingV1beta2() networkingv1beta2.NetworkingV1beta2Interface {
 	return &fakenetworkingv1beta2.FakeNetworkingV1beta2{Fake: &c.Fake}
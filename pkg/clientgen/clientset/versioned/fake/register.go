@@ -19,6 +19,8 @@ limitations under the License.
 package fake
 
 import (
+	certmanagerv1 "github.com/minio/m3/pkg/apis/certmanager/v1"
+	gatewayv1 "github.com/minio/m3/pkg/apis/gateway/v1"
 	networkingv1beta2 "github.com/minio/m3/pkg/apis/networking.gke.io/v1beta2"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -31,6 +33,8 @@ var scheme = runtime.NewScheme()
 var codecs = serializer.NewCodecFactory(scheme)
 var parameterCodec = runtime.NewParameterCodec(scheme)
 var localSchemeBuilder = runtime.SchemeBuilder{
+	certmanagerv1.AddToScheme,
+	gatewayv1.AddToScheme,
 	networkingv1beta2.AddToScheme,
 }
 
@@ -24,6 +24,8 @@ import (
 	time "time"
 
 	versioned "github.com/minio/m3/pkg/clientgen/clientset/versioned"
+	certmanager "github.com/minio/m3/pkg/clientgen/informers/externalversions/certmanager"
+	gateway "github.com/minio/m3/pkg/clientgen/informers/externalversions/gateway"
 	internalinterfaces "github.com/minio/m3/pkg/clientgen/informers/externalversions/internalinterfaces"
 	networkinggkeio "github.com/minio/m3/pkg/clientgen/informers/externalversions/networking.gke.io"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -172,9 +174,19 @@ type SharedInformerFactory interface {
 	ForResource(resource schema.GroupVersionResource) (GenericInformer, error)
 	WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool
 
+	Certmanager() certmanager.Interface
+	Gateway() gateway.Interface
 	Networking() networkinggkeio.Interface
 }
 
+func (f *sharedInformerFactory) Certmanager() certmanager.Interface {
+	return certmanager.New(f, f.namespace, f.tweakListOptions)
+}
+
+func (f *sharedInformerFactory) Gateway() gateway.Interface {
+	return gateway.New(f, f.namespace, f.tweakListOptions)
+}
+
 func (f *sharedInformerFactory) Networking() networkinggkeio.Interface {
 	return networkinggkeio.New(f, f.namespace, f.tweakListOptions)
 }
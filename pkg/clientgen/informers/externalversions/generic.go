@@ -21,6 +21,8 @@ package externalversions
 import (
 	"fmt"
 
+	v1 "github.com/minio/m3/pkg/apis/certmanager/v1"
+	gatewayv1 "github.com/minio/m3/pkg/apis/gateway/v1"
 	v1beta2 "github.com/minio/m3/pkg/apis/networking.gke.io/v1beta2"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
@@ -52,7 +54,15 @@ func (f *genericInformer) Lister() cache.GenericLister {
 // TODO extend this to unknown resources with a client pool
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
-	// Group=networking.gke.io, Version=v1beta2
+	// Group=cert-manager.io, Version=v1
+	case v1.SchemeGroupVersion.WithResource("certificates"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Certmanager().V1().Certificates().Informer()}, nil
+
+		// Group=gateway.networking.k8s.io, Version=v1
+	case gatewayv1.SchemeGroupVersion.WithResource("httproutes"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Gateway().V1().HTTPRoutes().Informer()}, nil
+
+		// Group=networking.gke.io, Version=v1beta2
 	case v1beta2.SchemeGroupVersion.WithResource("managedcertificates"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Networking().V1beta2().ManagedCertificates().Informer()}, nil
 
@@ -0,0 +1,499 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/minio/m3/pkg/tracing"
+	minio "github.com/minio/minio-go/v6"
+	"github.com/minio/minio-go/v6/pkg/signer"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	"github.com/minio/minio/pkg/auth"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// tenantAdminTimeout is the timeout of the requests sent to the admin api of the tenants
+const tenantAdminTimeout = 30 * time.Second
+
+// MinioAdmin interface with all functions to be implemented
+// by mock when testing, it should include all the madmin api calls
+// done against the tenants that are used within this project.
+type MinioAdmin interface {
+	listUsers(ctx context.Context) (map[string]madmin.UserInfo, error)
+	getUserInfo(ctx context.Context, accessKey string) (madmin.UserInfo, error)
+	addUser(ctx context.Context, accessKey, secretKey string) error
+	setUserStatus(ctx context.Context, accessKey string, status madmin.AccountStatus) error
+	removeUser(ctx context.Context, accessKey string) error
+	listGroups(ctx context.Context) ([]string, error)
+	getGroupDescription(ctx context.Context, group string) (*madmin.GroupDesc, error)
+	updateGroupMembers(ctx context.Context, update madmin.GroupAddRemove) error
+	setGroupStatus(ctx context.Context, group string, status madmin.GroupStatus) error
+	listPolicies(ctx context.Context) (map[string]*iampolicy.Policy, error)
+	addPolicy(ctx context.Context, name string, policy *iampolicy.Policy) error
+	removePolicy(ctx context.Context, name string) error
+	setPolicy(ctx context.Context, policyName, entityName string, isGroup bool) error
+	addServiceAccount(ctx context.Context, policy *iampolicy.Policy) (auth.Credentials, error)
+	listServiceAccounts(ctx context.Context) ([]string, error)
+	deleteServiceAccount(ctx context.Context, accessKey string) error
+	serverInfo(ctx context.Context) (madmin.InfoMessage, error)
+	storageInfo(ctx context.Context) (madmin.StorageInfo, error)
+	dataUsageInfo(ctx context.Context) (*tenantDataUsage, error)
+	getBucketQuota(ctx context.Context, bucket string) (madmin.BucketQuota, error)
+	setBucketQuota(ctx context.Context, bucket string, quota uint64, quotaType madmin.QuotaType) error
+	removeBucketQuota(ctx context.Context, bucket string) error
+	addCannedPolicy(ctx context.Context, name string, policy []byte) error
+	setRemoteTarget(ctx context.Context, bucket string, target *bucketTarget) (string, error)
+	listRemoteTargets(ctx context.Context, bucket string) ([]bucketTarget, error)
+	removeRemoteTarget(ctx context.Context, bucket, arn string) error
+	getBucketReplication(ctx context.Context, bucket string) (*replicationConfiguration, error)
+	setBucketReplication(ctx context.Context, bucket string, config *replicationConfiguration) error
+	removeBucketReplication(ctx context.Context, bucket string) error
+	getBucketReplicationMetrics(ctx context.Context, bucket string) (*replicationMetrics, error)
+	setConfigKV(ctx context.Context, kv string) error
+	delConfigKV(ctx context.Context, key string) error
+	serviceRestart(ctx context.Context) error
+}
+
+// Interface implementation
+//
+// Define the structure of a minio admin client and define the functions that are actually used
+// from madmin.
+type adminClient struct {
+	client *madmin.AdminClient
+	// the data usage and the replication are managed without madmin since it drops the usage of the buckets
+	// reported by newer servers and it doesn't support replication yet
+	endpointURL string
+	creds       *tenantCredentials
+	httpClient  *http.Client
+}
+
+func (ac *adminClient) listUsers(ctx context.Context) (_ map[string]madmin.UserInfo, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.listUsers")
+	defer func() { tracing.End(span, err) }()
+	return ac.client.ListUsers(ctx)
+}
+
+func (ac *adminClient) getUserInfo(ctx context.Context, accessKey string) (_ madmin.UserInfo, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.getUserInfo", attribute.String("user", accessKey))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.GetUserInfo(ctx, accessKey)
+}
+
+func (ac *adminClient) addUser(ctx context.Context, accessKey, secretKey string) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.addUser", attribute.String("user", accessKey))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.AddUser(ctx, accessKey, secretKey)
+}
+
+func (ac *adminClient) setUserStatus(ctx context.Context, accessKey string, status madmin.AccountStatus) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.setUserStatus", attribute.String("user", accessKey), attribute.String("status", string(status)))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.SetUserStatus(ctx, accessKey, status)
+}
+
+func (ac *adminClient) removeUser(ctx context.Context, accessKey string) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.removeUser", attribute.String("user", accessKey))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.RemoveUser(ctx, accessKey)
+}
+
+func (ac *adminClient) listGroups(ctx context.Context) (_ []string, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.listGroups")
+	defer func() { tracing.End(span, err) }()
+	return ac.client.ListGroups(ctx)
+}
+
+func (ac *adminClient) getGroupDescription(ctx context.Context, group string) (_ *madmin.GroupDesc, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.getGroupDescription", attribute.String("group", group))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.GetGroupDescription(ctx, group)
+}
+
+func (ac *adminClient) updateGroupMembers(ctx context.Context, update madmin.GroupAddRemove) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.updateGroupMembers", attribute.String("group", update.Group), attribute.Bool("remove", update.IsRemove))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.UpdateGroupMembers(ctx, update)
+}
+
+func (ac *adminClient) setGroupStatus(ctx context.Context, group string, status madmin.GroupStatus) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.setGroupStatus", attribute.String("group", group), attribute.String("status", string(status)))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.SetGroupStatus(ctx, group, status)
+}
+
+func (ac *adminClient) listPolicies(ctx context.Context) (_ map[string]*iampolicy.Policy, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.listPolicies")
+	defer func() { tracing.End(span, err) }()
+	return ac.client.ListCannedPolicies(ctx)
+}
+
+func (ac *adminClient) addPolicy(ctx context.Context, name string, policy *iampolicy.Policy) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.addPolicy", attribute.String("policy", name))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.AddCannedPolicy(ctx, name, policy)
+}
+
+func (ac *adminClient) removePolicy(ctx context.Context, name string) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.removePolicy", attribute.String("policy", name))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.RemoveCannedPolicy(ctx, name)
+}
+
+func (ac *adminClient) setPolicy(ctx context.Context, policyName, entityName string, isGroup bool) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.setPolicy", attribute.String("policy", policyName), attribute.String("entity", entityName), attribute.Bool("group", isGroup))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.SetPolicy(ctx, policyName, entityName, isGroup)
+}
+
+func (ac *adminClient) addServiceAccount(ctx context.Context, policy *iampolicy.Policy) (_ auth.Credentials, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.addServiceAccount")
+	defer func() { tracing.End(span, err) }()
+	return ac.client.AddServiceAccount(ctx, policy)
+}
+
+func (ac *adminClient) listServiceAccounts(ctx context.Context) (_ []string, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.listServiceAccounts")
+	defer func() { tracing.End(span, err) }()
+	resp, err := ac.client.ListServiceAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Accounts, nil
+}
+
+func (ac *adminClient) deleteServiceAccount(ctx context.Context, accessKey string) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.deleteServiceAccount", attribute.String("service_account", accessKey))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.DeleteServiceAccount(ctx, accessKey)
+}
+
+func (ac *adminClient) serverInfo(ctx context.Context) (_ madmin.InfoMessage, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.serverInfo")
+	defer func() { tracing.End(span, err) }()
+	return ac.client.ServerInfo(ctx)
+}
+
+func (ac *adminClient) storageInfo(ctx context.Context) (_ madmin.StorageInfo, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.storageInfo")
+	defer func() { tracing.End(span, err) }()
+	return ac.client.StorageInfo(ctx)
+}
+
+func (ac *adminClient) dataUsageInfo(ctx context.Context) (_ *tenantDataUsage, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.dataUsageInfo")
+	defer func() { tracing.End(span, err) }()
+	resp, err := ac.do(ctx, http.MethodGet, adminAPIPath("datausageinfo"), nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, adminResponseError(resp)
+	}
+	usage := &tenantDataUsage{}
+	if err := json.NewDecoder(resp.Body).Decode(usage); err != nil {
+		return nil, err
+	}
+	return usage, nil
+}
+
+func (ac *adminClient) getBucketQuota(ctx context.Context, bucket string) (_ madmin.BucketQuota, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.getBucketQuota", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.GetBucketQuota(ctx, bucket)
+}
+
+func (ac *adminClient) setBucketQuota(ctx context.Context, bucket string, quota uint64, quotaType madmin.QuotaType) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.setBucketQuota", attribute.String("bucket", bucket), attribute.Int64("quota", int64(quota)))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.SetBucketQuota(ctx, bucket, quota, quotaType)
+}
+
+func (ac *adminClient) removeBucketQuota(ctx context.Context, bucket string) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.removeBucketQuota", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.RemoveBucketQuota(ctx, bucket)
+}
+
+func (ac *adminClient) setConfigKV(ctx context.Context, kv string) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.setConfigKV")
+	defer func() { tracing.End(span, err) }()
+	return ac.client.SetConfigKV(ctx, kv)
+}
+
+func (ac *adminClient) delConfigKV(ctx context.Context, key string) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.delConfigKV", attribute.String("key", key))
+	defer func() { tracing.End(span, err) }()
+	return ac.client.DelConfigKV(ctx, key)
+}
+
+func (ac *adminClient) serviceRestart(ctx context.Context) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.serviceRestart")
+	defer func() { tracing.End(span, err) }()
+	return ac.client.ServiceRestart(ctx)
+}
+
+// addCannedPolicy adds a policy without parsing it, the vendored policy package doesn't know the replication actions
+func (ac *adminClient) addCannedPolicy(ctx context.Context, name string, policy []byte) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.addCannedPolicy", attribute.String("policy", name))
+	defer func() { tracing.End(span, err) }()
+	resp, err := ac.do(ctx, http.MethodPut, adminAPIPath("add-canned-policy"), url.Values{"name": {name}}, policy)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return adminResponseError(resp)
+	}
+	return nil
+}
+
+// setRemoteTarget adds a remote target to the bucket and returns its arn, the target is encrypted with the
+// secret key since it carries the credentials of the remote bucket
+func (ac *adminClient) setRemoteTarget(ctx context.Context, bucket string, target *bucketTarget) (_ string, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.setRemoteTarget", attribute.String("bucket", bucket), attribute.String("endpoint", target.Endpoint))
+	defer func() { tracing.End(span, err) }()
+	data, err := json.Marshal(target)
+	if err != nil {
+		return "", err
+	}
+	encrypted, err := madmin.EncryptData(ac.creds.secretKey, data)
+	if err != nil {
+		return "", err
+	}
+	resp, err := ac.do(ctx, http.MethodPut, adminAPIPath("set-remote-target"), url.Values{"bucket": {bucket}}, encrypted)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", adminResponseError(resp)
+	}
+	var arn string
+	if err := json.NewDecoder(resp.Body).Decode(&arn); err != nil {
+		return "", err
+	}
+	return arn, nil
+}
+
+func (ac *adminClient) listRemoteTargets(ctx context.Context, bucket string) (_ []bucketTarget, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.listRemoteTargets", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	resp, err := ac.do(ctx, http.MethodGet, adminAPIPath("list-remote-targets"), url.Values{"bucket": {bucket}, "type": {replicationTargetType}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, adminResponseError(resp)
+	}
+	var targets []bucketTarget
+	if err := json.NewDecoder(resp.Body).Decode(&targets); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+func (ac *adminClient) removeRemoteTarget(ctx context.Context, bucket, arn string) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.removeRemoteTarget", attribute.String("bucket", bucket), attribute.String("arn", arn))
+	defer func() { tracing.End(span, err) }()
+	resp, err := ac.do(ctx, http.MethodDelete, adminAPIPath("remove-remote-target"), url.Values{"bucket": {bucket}, "arn": {arn}}, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return adminResponseError(resp)
+	}
+	return nil
+}
+
+// getBucketReplication returns the replication configuration of the bucket, nil when it has no configuration
+func (ac *adminClient) getBucketReplication(ctx context.Context, bucket string) (_ *replicationConfiguration, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.getBucketReplication", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	resp, err := ac.do(ctx, http.MethodGet, "/"+bucket, url.Values{"replication": {""}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		err := s3ResponseError(resp, bucket)
+		if minio.ToErrorResponse(err).Code == replicationNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	config := &replicationConfiguration{}
+	if err := xml.NewDecoder(resp.Body).Decode(config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+func (ac *adminClient) setBucketReplication(ctx context.Context, bucket string, config *replicationConfiguration) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.setBucketReplication", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	data, err := xml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	resp, err := ac.do(ctx, http.MethodPut, "/"+bucket, url.Values{"replication": {""}}, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return s3ResponseError(resp, bucket)
+	}
+	return nil
+}
+
+func (ac *adminClient) removeBucketReplication(ctx context.Context, bucket string) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.removeBucketReplication", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	resp, err := ac.do(ctx, http.MethodDelete, "/"+bucket, url.Values{"replication": {""}}, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent {
+		return s3ResponseError(resp, bucket)
+	}
+	return nil
+}
+
+// getBucketReplicationMetrics returns the replication metrics of the bucket, they are a MinIO extension of s3
+func (ac *adminClient) getBucketReplicationMetrics(ctx context.Context, bucket string) (_ *replicationMetrics, err error) {
+	ctx, span := tracing.Start(ctx, "MinioAdmin.getBucketReplicationMetrics", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	resp, err := ac.do(ctx, http.MethodGet, "/"+bucket, url.Values{"replication-metrics": {""}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, s3ResponseError(resp, bucket)
+	}
+	metrics := &replicationMetrics{}
+	if err := json.NewDecoder(resp.Body).Decode(metrics); err != nil {
+		return nil, err
+	}
+	return metrics, nil
+}
+
+// do sends a request to the tenant signed with its root credentials
+func (ac *adminClient) do(ctx context.Context, method, path string, query url.Values, body []byte) (*http.Response, error) {
+	endpoint := ac.endpointURL + path
+	if len(query) > 0 {
+		endpoint += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(body)
+	req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(sum[:]))
+	req = signer.SignV4(*req, ac.creds.accessKey, ac.creds.secretKey, "", "")
+	return ac.httpClient.Do(req)
+}
+
+// adminAPIPath returns the path of an admin api call
+func adminAPIPath(call string) string {
+	return "/minio/admin/" + madmin.AdminAPIVersion + "/" + call
+}
+
+// adminResponseError returns the error of a failed admin api call, the admin api reports errors as json
+func adminResponseError(resp *http.Response) error {
+	var errResp madmin.ErrorResponse
+	if err := json.NewDecoder(resp.Body).Decode(&errResp); err != nil || errResp.Code == "" {
+		return madmin.ErrorResponse{Code: resp.Status, Message: "Failed to parse server response."}
+	}
+	return errResp
+}
+
+// s3ResponseError returns the error of a failed s3 api call, the s3 api reports errors as xml
+func s3ResponseError(resp *http.Response, bucket string) error {
+	errResp := minio.ErrorResponse{StatusCode: resp.StatusCode, BucketName: bucket}
+	if err := xml.NewDecoder(resp.Body).Decode(&errResp); err != nil || errResp.Code == "" {
+		errResp.Code = resp.Status
+		errResp.Message = "Failed to parse server response."
+	}
+	return errResp
+}
+
+// newAdminClient returns an admin client of the endpoint, the certificates are verified with tlsConfig as in newMinioClient
+func newAdminClient(endpoint string, secure bool, creds *tenantCredentials, tlsConfig *tls.Config) (*adminClient, error) {
+	admClient, err := madmin.New(endpoint, creds.accessKey, creds.secretKey, secure)
+	if err != nil {
+		return nil, err
+	}
+	transport := newTenantTransport(tlsConfig)
+	admClient.SetCustomTransport(transport)
+	scheme := "http"
+	if secure {
+		scheme = "https"
+	}
+	return &adminClient{
+		client:      admClient,
+		endpointURL: fmt.Sprintf("%s://%s", scheme, endpoint),
+		creds:       creds,
+		httpClient:  &http.Client{Transport: transport},
+	}, nil
+}
+
+// tenantAdminClient returns an admin client of the tenant service authenticated with the root credentials of the
+// tenant, the secret of the tenant is read with the client of the caller so only the users allowed to get it can
+// manage the tenant
+func tenantAdminClient(ctx context.Context, client K8sClient, tenant *operator.MinIOInstance) (MinioAdmin, error) {
+	clients, err := tenantClientsFor(ctx, client, tenant)
+	if err != nil {
+		return nil, err
+	}
+	return clients.admin, nil
+}
+
+// getTenantAdminClient returns the admin client of a tenant, the tenant is read with the token of the caller
+func getTenantAdminClient(ctx context.Context, opClient OperatorClient, client K8sClient, namespace, tenantName string) (MinioAdmin, error) {
+	clients, err := getTenantClients(ctx, opClient, client, namespace, tenantName)
+	if err != nil {
+		return nil, err
+	}
+	return clients.admin, nil
+}
+
+// newTenantAdminClient returns the admin client of the tenant for the caller authenticated by token
+func newTenantAdminClient(ctx context.Context, token, namespace, tenantName string) (MinioAdmin, error) {
+	clients, err := newTenantClients(ctx, token, namespace, tenantName)
+	if err != nil {
+		return nil, err
+	}
+	return clients.admin, nil
+}
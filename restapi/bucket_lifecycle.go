@@ -0,0 +1,554 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/restapi/operations"
+	"github.com/minio/m3/restapi/operations/admin_api"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// lifecycleRuleResource is the resource reported by the errors of the lifecycle rules
+var lifecycleRuleResource = schema.GroupResource{Resource: "lifecyclerules"}
+
+func registerBucketLifecycleHandlers(api *operations.M3API) {
+	// Get Bucket Lifecycle
+	api.AdminAPIGetTenantBucketLifecycleHandler = admin_api.GetTenantBucketLifecycleHandlerFunc(func(params admin_api.GetTenantBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getGetTenantBucketLifecycleResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewGetTenantBucketLifecycleDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewGetTenantBucketLifecycleOK().WithPayload(resp)
+	})
+	// Set Bucket Lifecycle
+	api.AdminAPISetTenantBucketLifecycleHandler = admin_api.SetTenantBucketLifecycleHandlerFunc(func(params admin_api.SetTenantBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getSetTenantBucketLifecycleResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewSetTenantBucketLifecycleDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewSetTenantBucketLifecycleOK().WithPayload(resp)
+	})
+	// Delete Bucket Lifecycle
+	api.AdminAPIDeleteTenantBucketLifecycleHandler = admin_api.DeleteTenantBucketLifecycleHandlerFunc(func(params admin_api.DeleteTenantBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		if err := getDeleteTenantBucketLifecycleResponse(ctx, string(*principal), params); err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewDeleteTenantBucketLifecycleDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewDeleteTenantBucketLifecycleNoContent()
+	})
+	// Add Bucket Lifecycle Rule
+	api.AdminAPIAddTenantBucketLifecycleRuleHandler = admin_api.AddTenantBucketLifecycleRuleHandlerFunc(func(params admin_api.AddTenantBucketLifecycleRuleParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getAddTenantBucketLifecycleRuleResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewAddTenantBucketLifecycleRuleDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewAddTenantBucketLifecycleRuleCreated().WithPayload(resp)
+	})
+	// Update Bucket Lifecycle Rule
+	api.AdminAPIUpdateTenantBucketLifecycleRuleHandler = admin_api.UpdateTenantBucketLifecycleRuleHandlerFunc(func(params admin_api.UpdateTenantBucketLifecycleRuleParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getUpdateTenantBucketLifecycleRuleResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewUpdateTenantBucketLifecycleRuleDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewUpdateTenantBucketLifecycleRuleOK().WithPayload(resp)
+	})
+	// Delete Bucket Lifecycle Rule
+	api.AdminAPIDeleteTenantBucketLifecycleRuleHandler = admin_api.DeleteTenantBucketLifecycleRuleHandlerFunc(func(params admin_api.DeleteTenantBucketLifecycleRuleParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		if err := getDeleteTenantBucketLifecycleRuleResponse(ctx, string(*principal), params); err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewDeleteTenantBucketLifecycleRuleDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewDeleteTenantBucketLifecycleRuleNoContent()
+	})
+}
+
+// lifecycleConfiguration is the s3 lifecycle configuration of a bucket, minio-go only exchanges it as xml
+type lifecycleConfiguration struct {
+	XMLName xml.Name           `xml:"LifecycleConfiguration"`
+	Rules   []lifecycleRuleXML `xml:"Rule"`
+}
+
+type lifecycleRuleXML struct {
+	ID     string              `xml:"ID"`
+	Status string              `xml:"Status"`
+	Filter *lifecycleFilterXML `xml:"Filter,omitempty"`
+	// Prefix is only set by the rules written before filters were introduced
+	Prefix                         *string                      `xml:"Prefix,omitempty"`
+	Expiration                     *lifecycleExpirationXML      `xml:"Expiration,omitempty"`
+	NoncurrentVersionExpiration    *lifecycleNoncurrentXML      `xml:"NoncurrentVersionExpiration,omitempty"`
+	AbortIncompleteMultipartUpload *lifecycleAbortMultipartXML  `xml:"AbortIncompleteMultipartUpload,omitempty"`
+	Transition                     *lifecycleUnmanagedActionXML `xml:"Transition,omitempty"`
+	NoncurrentVersionTransition    *lifecycleUnmanagedActionXML `xml:"NoncurrentVersionTransition,omitempty"`
+}
+
+type lifecycleFilterXML struct {
+	Prefix *string          `xml:"Prefix,omitempty"`
+	Tag    *lifecycleTagXML `xml:"Tag,omitempty"`
+	And    *lifecycleAndXML `xml:"And,omitempty"`
+}
+
+type lifecycleAndXML struct {
+	Prefix string            `xml:"Prefix,omitempty"`
+	Tags   []lifecycleTagXML `xml:"Tag"`
+}
+
+type lifecycleTagXML struct {
+	Key   string `xml:"Key"`
+	Value string `xml:"Value"`
+}
+
+type lifecycleExpirationXML struct {
+	Days                      int    `xml:"Days,omitempty"`
+	Date                      string `xml:"Date,omitempty"`
+	ExpiredObjectDeleteMarker bool   `xml:"ExpiredObjectDeleteMarker,omitempty"`
+}
+
+type lifecycleNoncurrentXML struct {
+	NoncurrentDays int `xml:"NoncurrentDays"`
+}
+
+type lifecycleAbortMultipartXML struct {
+	DaysAfterInitiation int `xml:"DaysAfterInitiation"`
+}
+
+// lifecycleUnmanagedActionXML keeps the actions that can't be managed through m3 (ie: transitions) untouched
+type lifecycleUnmanagedActionXML struct {
+	Inner string `xml:",innerxml"`
+}
+
+// lifecycleDateLayout is the layout of the expiration dates, s3 only accepts dates at midnight UTC
+const lifecycleDateLayout = "2006-01-02T00:00:00Z"
+
+// rule returns the rule with the id, nil when the configuration doesn't have it
+func (c *lifecycleConfiguration) rule(id string) *lifecycleRuleXML {
+	for i := range c.Rules {
+		if c.Rules[i].ID == id {
+			return &c.Rules[i]
+		}
+	}
+	return nil
+}
+
+// unmanagedActions returns the actions of the rule that m3 keeps but can't manage
+func (r *lifecycleRuleXML) unmanagedActions() []string {
+	actions := []string{}
+	if r.Transition != nil {
+		actions = append(actions, "Transition")
+	}
+	if r.NoncurrentVersionTransition != nil {
+		actions = append(actions, "NoncurrentVersionTransition")
+	}
+	return actions
+}
+
+// lifecycleRuleFromXML translates a rule of the configuration of the bucket into its model
+func lifecycleRuleFromXML(rule *lifecycleRuleXML) *models.LifecycleRule {
+	m := &models.LifecycleRule{
+		ID:               swag.String(rule.ID),
+		Enabled:          swag.Bool(rule.Status == "Enabled"),
+		Tags:             []*models.LifecycleTag{},
+		UnmanagedActions: rule.unmanagedActions(),
+	}
+	switch {
+	case rule.Filter == nil:
+		m.Prefix = swag.StringValue(rule.Prefix)
+	case rule.Filter.And != nil:
+		m.Prefix = rule.Filter.And.Prefix
+		for _, tag := range rule.Filter.And.Tags {
+			m.Tags = append(m.Tags, &models.LifecycleTag{Key: swag.String(tag.Key), Value: tag.Value})
+		}
+	case rule.Filter.Tag != nil:
+		m.Tags = append(m.Tags, &models.LifecycleTag{Key: swag.String(rule.Filter.Tag.Key), Value: rule.Filter.Tag.Value})
+	default:
+		m.Prefix = swag.StringValue(rule.Filter.Prefix)
+	}
+	if rule.Expiration != nil {
+		m.Expiration = &models.LifecycleExpiration{
+			Days:                      int32(rule.Expiration.Days),
+			ExpiredObjectDeleteMarker: rule.Expiration.ExpiredObjectDeleteMarker,
+		}
+		if date, err := time.Parse(time.RFC3339, rule.Expiration.Date); err == nil {
+			m.Expiration.Date = strfmt.Date(date)
+		}
+	}
+	if rule.NoncurrentVersionExpiration != nil {
+		m.NoncurrentVersionExpiration = &models.LifecycleRuleNoncurrentVersionExpiration{
+			NoncurrentDays: swag.Int32(int32(rule.NoncurrentVersionExpiration.NoncurrentDays)),
+		}
+	}
+	if rule.AbortIncompleteMultipartUpload != nil {
+		m.AbortIncompleteMultipartUpload = &models.LifecycleRuleAbortIncompleteMultipartUpload{
+			DaysAfterInitiation: swag.Int32(int32(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation)),
+		}
+	}
+	return m
+}
+
+// lifecycleRuleToXML translates the model of a rule into the rule of the configuration of the bucket, the rules
+// without tags are filtered by prefix (an empty prefix matches every object)
+func lifecycleRuleToXML(rule *models.LifecycleRule) lifecycleRuleXML {
+	r := lifecycleRuleXML{ID: swag.StringValue(rule.ID), Status: "Enabled", Filter: &lifecycleFilterXML{}}
+	if rule.Enabled != nil && !*rule.Enabled {
+		r.Status = "Disabled"
+	}
+	switch {
+	case len(rule.Tags) == 0:
+		r.Filter.Prefix = swag.String(rule.Prefix)
+	case len(rule.Tags) == 1 && rule.Prefix == "":
+		r.Filter.Tag = &lifecycleTagXML{Key: swag.StringValue(rule.Tags[0].Key), Value: rule.Tags[0].Value}
+	default:
+		r.Filter.And = &lifecycleAndXML{Prefix: rule.Prefix}
+		for _, tag := range rule.Tags {
+			r.Filter.And.Tags = append(r.Filter.And.Tags, lifecycleTagXML{Key: swag.StringValue(tag.Key), Value: tag.Value})
+		}
+	}
+	if rule.Expiration != nil {
+		r.Expiration = &lifecycleExpirationXML{
+			Days:                      int(rule.Expiration.Days),
+			ExpiredObjectDeleteMarker: rule.Expiration.ExpiredObjectDeleteMarker,
+		}
+		if date := time.Time(rule.Expiration.Date); !date.IsZero() {
+			r.Expiration.Date = date.UTC().Format(lifecycleDateLayout)
+		}
+	}
+	if rule.NoncurrentVersionExpiration != nil {
+		r.NoncurrentVersionExpiration = &lifecycleNoncurrentXML{NoncurrentDays: int(swag.Int32Value(rule.NoncurrentVersionExpiration.NoncurrentDays))}
+	}
+	if rule.AbortIncompleteMultipartUpload != nil {
+		r.AbortIncompleteMultipartUpload = &lifecycleAbortMultipartXML{DaysAfterInitiation: int(swag.Int32Value(rule.AbortIncompleteMultipartUpload.DaysAfterInitiation))}
+	}
+	return r
+}
+
+// validateLifecycleRules checks the rules with the restrictions of s3 that can't be described by the swagger spec,
+// so the tenant doesn't reject the configuration without telling which rule is wrong
+func validateLifecycleRules(bucket string, rules []*models.LifecycleRule) error {
+	var errs field.ErrorList
+	ids := map[string]bool{}
+	for i, rule := range rules {
+		path := field.NewPath("rules").Index(i)
+		id := swag.StringValue(rule.ID)
+		switch {
+		case id == "":
+			errs = append(errs, field.Required(path.Child("id"), ""))
+		case len(id) > 255:
+			errs = append(errs, field.TooLong(path.Child("id"), id, 255))
+		case ids[id]:
+			errs = append(errs, field.Duplicate(path.Child("id"), id))
+		}
+		ids[id] = true
+
+		tags := map[string]bool{}
+		for j, tag := range rule.Tags {
+			key := swag.StringValue(tag.Key)
+			switch {
+			case key == "":
+				errs = append(errs, field.Required(path.Child("tags").Index(j).Child("key"), ""))
+			case tags[key]:
+				errs = append(errs, field.Duplicate(path.Child("tags").Index(j).Child("key"), key))
+			}
+			tags[key] = true
+		}
+
+		if rule.Expiration == nil && rule.NoncurrentVersionExpiration == nil && rule.AbortIncompleteMultipartUpload == nil && len(rule.UnmanagedActions) == 0 {
+			errs = append(errs, field.Required(path, "at least one of expiration, noncurrent_version_expiration or abort_incomplete_multipart_upload is required"))
+		}
+		if expiration := rule.Expiration; expiration != nil {
+			set := 0
+			if expiration.Days != 0 {
+				set++
+			}
+			if !time.Time(expiration.Date).IsZero() {
+				set++
+			}
+			if expiration.ExpiredObjectDeleteMarker {
+				set++
+				if len(rule.Tags) > 0 {
+					errs = append(errs, field.Forbidden(path.Child("expiration", "expired_object_delete_marker"), "can't be used by rules filtered by tags"))
+				}
+			}
+			switch {
+			case expiration.Days < 0:
+				errs = append(errs, field.Invalid(path.Child("expiration", "days"), expiration.Days, "must be greater than zero"))
+			case set != 1:
+				errs = append(errs, field.Invalid(path.Child("expiration"), "", "exactly one of days, date or expired_object_delete_marker is required"))
+			}
+		}
+		if nve := rule.NoncurrentVersionExpiration; nve != nil && swag.Int32Value(nve.NoncurrentDays) <= 0 {
+			errs = append(errs, field.Invalid(path.Child("noncurrent_version_expiration", "noncurrent_days"), swag.Int32Value(nve.NoncurrentDays), "must be greater than zero"))
+		}
+		if abort := rule.AbortIncompleteMultipartUpload; abort != nil {
+			if swag.Int32Value(abort.DaysAfterInitiation) <= 0 {
+				errs = append(errs, field.Invalid(path.Child("abort_incomplete_multipart_upload", "days_after_initiation"), swag.Int32Value(abort.DaysAfterInitiation), "must be greater than zero"))
+			}
+			if len(rule.Tags) > 0 {
+				errs = append(errs, field.Forbidden(path.Child("abort_incomplete_multipart_upload"), "can't be used by rules filtered by tags"))
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return apierrors.NewInvalid(schema.GroupKind{Kind: "BucketLifecycle"}, bucket, errs)
+	}
+	return nil
+}
+
+// getLifecycleConfiguration reads the lifecycle configuration of the bucket, buckets without configuration
+// have no rules
+func getLifecycleConfiguration(ctx context.Context, client MinioClient, bucket string) (*lifecycleConfiguration, error) {
+	raw, err := client.getBucketLifecycle(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	config := &lifecycleConfiguration{}
+	if raw == "" {
+		return config, nil
+	}
+	if err := xml.Unmarshal([]byte(raw), config); err != nil {
+		return nil, fmt.Errorf("invalid lifecycle configuration of bucket %s: %v", bucket, err)
+	}
+	return config, nil
+}
+
+// lifecycleModel returns the model of the lifecycle configuration
+func lifecycleModel(config *lifecycleConfiguration) *models.BucketLifecycle {
+	lifecycle := &models.BucketLifecycle{Rules: []*models.LifecycleRule{}}
+	for i := range config.Rules {
+		lifecycle.Rules = append(lifecycle.Rules, lifecycleRuleFromXML(&config.Rules[i]))
+	}
+	return lifecycle
+}
+
+// getBucketLifecycle returns the lifecycle rules of the bucket
+func getBucketLifecycle(ctx context.Context, client MinioClient, bucket string) (*models.BucketLifecycle, error) {
+	config, err := getLifecycleConfiguration(ctx, client, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return lifecycleModel(config), nil
+}
+
+// setLifecycleRules validates the rules and replaces the lifecycle configuration of the bucket with them, the
+// unmanaged actions of the current rules are kept, no rules removes the configuration
+func setLifecycleRules(ctx context.Context, client MinioClient, bucket string, current *lifecycleConfiguration, rules []*models.LifecycleRule) (*models.BucketLifecycle, error) {
+	for _, rule := range rules {
+		rule.UnmanagedActions = []string{}
+		if existing := current.rule(swag.StringValue(rule.ID)); existing != nil {
+			rule.UnmanagedActions = existing.unmanagedActions()
+		}
+	}
+	if err := validateLifecycleRules(bucket, rules); err != nil {
+		return nil, err
+	}
+	config := &lifecycleConfiguration{}
+	for _, rule := range rules {
+		r := lifecycleRuleToXML(rule)
+		if existing := current.rule(r.ID); existing != nil {
+			r.Transition = existing.Transition
+			r.NoncurrentVersionTransition = existing.NoncurrentVersionTransition
+		}
+		config.Rules = append(config.Rules, r)
+	}
+	if len(config.Rules) == 0 {
+		if err := client.setBucketLifecycle(ctx, bucket, ""); err != nil {
+			return nil, err
+		}
+		return lifecycleModel(config), nil
+	}
+	raw, err := xml.Marshal(config)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.setBucketLifecycle(ctx, bucket, string(raw)); err != nil {
+		return nil, err
+	}
+	return getBucketLifecycle(ctx, client, bucket)
+}
+
+// setBucketLifecycle replaces all the lifecycle rules of the bucket
+func setBucketLifecycle(ctx context.Context, client MinioClient, bucket string, lifecycle *models.BucketLifecycle) (*models.BucketLifecycle, error) {
+	current, err := getLifecycleConfiguration(ctx, client, bucket)
+	if err != nil {
+		return nil, err
+	}
+	return setLifecycleRules(ctx, client, bucket, current, lifecycle.Rules)
+}
+
+// addBucketLifecycleRule adds a rule to the lifecycle configuration of the bucket, the configuration is read and
+// written back so concurrent changes of the rules of the same bucket may be lost
+func addBucketLifecycleRule(ctx context.Context, client MinioClient, bucket string, rule *models.LifecycleRule) (*models.BucketLifecycle, error) {
+	current, err := getLifecycleConfiguration(ctx, client, bucket)
+	if err != nil {
+		return nil, err
+	}
+	if current.rule(swag.StringValue(rule.ID)) != nil {
+		return nil, apierrors.NewAlreadyExists(lifecycleRuleResource, swag.StringValue(rule.ID))
+	}
+	rules := lifecycleModel(current).Rules
+	return setLifecycleRules(ctx, client, bucket, current, append(rules, rule))
+}
+
+// updateBucketLifecycleRule replaces a rule of the lifecycle configuration of the bucket, the rule is renamed when
+// the id of the new rule is different
+func updateBucketLifecycleRule(ctx context.Context, client MinioClient, bucket, id string, rule *models.LifecycleRule) (*models.BucketLifecycle, error) {
+	current, err := getLifecycleConfiguration(ctx, client, bucket)
+	if err != nil {
+		return nil, err
+	}
+	existing := current.rule(id)
+	if existing == nil {
+		return nil, apierrors.NewNotFound(lifecycleRuleResource, id)
+	}
+	newID := swag.StringValue(rule.ID)
+	if newID != id {
+		if current.rule(newID) != nil {
+			return nil, apierrors.NewAlreadyExists(lifecycleRuleResource, newID)
+		}
+		// the unmanaged actions are kept by id
+		existing.ID = newID
+	}
+	rules := lifecycleModel(current).Rules
+	for i := range rules {
+		if swag.StringValue(rules[i].ID) == newID {
+			rules[i] = rule
+		}
+	}
+	return setLifecycleRules(ctx, client, bucket, current, rules)
+}
+
+// deleteBucketLifecycleRule removes a rule of the lifecycle configuration of the bucket
+func deleteBucketLifecycleRule(ctx context.Context, client MinioClient, bucket, id string) error {
+	current, err := getLifecycleConfiguration(ctx, client, bucket)
+	if err != nil {
+		return err
+	}
+	if current.rule(id) == nil {
+		return apierrors.NewNotFound(lifecycleRuleResource, id)
+	}
+	rules := []*models.LifecycleRule{}
+	for _, rule := range lifecycleModel(current).Rules {
+		if swag.StringValue(rule.ID) != id {
+			rules = append(rules, rule)
+		}
+	}
+	_, err = setLifecycleRules(ctx, client, bucket, current, rules)
+	return err
+}
+
+func getGetTenantBucketLifecycleResponse(ctx context.Context, token string, params admin_api.GetTenantBucketLifecycleParams) (*models.BucketLifecycle, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	client, err := newTenantMinioClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return getBucketLifecycle(ctx, client, params.Bucket)
+}
+
+func getSetTenantBucketLifecycleResponse(ctx context.Context, token string, params admin_api.SetTenantBucketLifecycleParams) (*models.BucketLifecycle, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	client, err := newTenantMinioClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := setBucketLifecycle(ctx, client, params.Bucket, params.Body)
+	if err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).WithField("rules", len(resp.Rules)).Info("bucket lifecycle updated")
+	return resp, nil
+}
+
+func getDeleteTenantBucketLifecycleResponse(ctx context.Context, token string, params admin_api.DeleteTenantBucketLifecycleParams) error {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	client, err := newTenantMinioClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return err
+	}
+	if err := client.setBucketLifecycle(ctx, params.Bucket, ""); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).Info("bucket lifecycle removed")
+	return nil
+}
+
+func getAddTenantBucketLifecycleRuleResponse(ctx context.Context, token string, params admin_api.AddTenantBucketLifecycleRuleParams) (*models.BucketLifecycle, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	client, err := newTenantMinioClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := addBucketLifecycleRule(ctx, client, params.Bucket, params.Body)
+	if err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).WithField("rule", swag.StringValue(params.Body.ID)).Info("bucket lifecycle rule added")
+	return resp, nil
+}
+
+func getUpdateTenantBucketLifecycleRuleResponse(ctx context.Context, token string, params admin_api.UpdateTenantBucketLifecycleRuleParams) (*models.BucketLifecycle, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	client, err := newTenantMinioClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := updateBucketLifecycleRule(ctx, client, params.Bucket, params.Rule, params.Body)
+	if err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).WithField("rule", params.Rule).Info("bucket lifecycle rule updated")
+	return resp, nil
+}
+
+func getDeleteTenantBucketLifecycleRuleResponse(ctx context.Context, token string, params admin_api.DeleteTenantBucketLifecycleRuleParams) error {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	client, err := newTenantMinioClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return err
+	}
+	if err := deleteBucketLifecycleRule(ctx, client, params.Bucket, params.Rule); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).WithField("rule", params.Rule).Info("bucket lifecycle rule removed")
+	return nil
+}
@@ -0,0 +1,211 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+)
+
+func Test_validateLifecycleRules(t *testing.T) {
+	expireDays := func(id string, days int32) *models.LifecycleRule {
+		return &models.LifecycleRule{ID: swag.String(id), Expiration: &models.LifecycleExpiration{Days: days}}
+	}
+	tests := []struct {
+		name       string
+		rules      []*models.LifecycleRule
+		wantFields []string
+	}{
+		{
+			name: "Valid rules",
+			rules: []*models.LifecycleRule{
+				expireDays("expire-logs", 30),
+				{
+					ID:                             swag.String("cleanup"),
+					Prefix:                         "tmp/",
+					NoncurrentVersionExpiration:    &models.LifecycleRuleNoncurrentVersionExpiration{NoncurrentDays: swag.Int32(7)},
+					AbortIncompleteMultipartUpload: &models.LifecycleRuleAbortIncompleteMultipartUpload{DaysAfterInitiation: swag.Int32(1)},
+				},
+				{
+					ID:         swag.String("expire-date"),
+					Tags:       []*models.LifecycleTag{{Key: swag.String("team"), Value: "a"}},
+					Expiration: &models.LifecycleExpiration{Date: strfmt.Date(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))},
+				},
+				{ID: swag.String("transition"), UnmanagedActions: []string{"Transition"}},
+			},
+		},
+		{
+			name:       "Duplicated id",
+			rules:      []*models.LifecycleRule{expireDays("rule", 1), expireDays("rule", 2)},
+			wantFields: []string{"rules[1].id"},
+		},
+		{
+			name:       "Missing id",
+			rules:      []*models.LifecycleRule{expireDays("", 1)},
+			wantFields: []string{"rules[0].id"},
+		},
+		{
+			name:       "Without actions",
+			rules:      []*models.LifecycleRule{{ID: swag.String("rule"), Prefix: "logs/"}},
+			wantFields: []string{"rules[0]"},
+		},
+		{
+			name: "Expiration by days and date",
+			rules: []*models.LifecycleRule{{
+				ID:         swag.String("rule"),
+				Expiration: &models.LifecycleExpiration{Days: 1, Date: strfmt.Date(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))},
+			}},
+			wantFields: []string{"rules[0].expiration"},
+		},
+		{
+			name:       "Empty expiration",
+			rules:      []*models.LifecycleRule{{ID: swag.String("rule"), Expiration: &models.LifecycleExpiration{}}},
+			wantFields: []string{"rules[0].expiration"},
+		},
+		{
+			name: "Tags with abort incomplete multipart upload and expired delete markers",
+			rules: []*models.LifecycleRule{{
+				ID:                             swag.String("rule"),
+				Tags:                           []*models.LifecycleTag{{Key: swag.String("team")}, {Key: swag.String("team")}},
+				Expiration:                     &models.LifecycleExpiration{ExpiredObjectDeleteMarker: true},
+				AbortIncompleteMultipartUpload: &models.LifecycleRuleAbortIncompleteMultipartUpload{DaysAfterInitiation: swag.Int32(0)},
+			}},
+			wantFields: []string{
+				"rules[0].tags[1].key",
+				"rules[0].expiration.expired_object_delete_marker",
+				"rules[0].abort_incomplete_multipart_upload.days_after_initiation",
+				"rules[0].abort_incomplete_multipart_upload",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLifecycleRules("bucket-1", tt.rules)
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Errorf("validateLifecycleRules() error = %v", err)
+				}
+				return
+			}
+			apiErr := prepareError(context.Background(), err)
+			if apiErr == nil || apiErr.Code != http.StatusUnprocessableEntity {
+				t.Fatalf("validateLifecycleRules() error = %v, want an invalid error", err)
+			}
+			var fields []string
+			for _, cause := range apiErr.Causes {
+				fields = append(fields, cause.Field)
+			}
+			if strings.Join(fields, ",") != strings.Join(tt.wantFields, ",") {
+				t.Errorf("validateLifecycleRules() fields = %v, want %v", fields, tt.wantFields)
+			}
+		})
+	}
+}
+
+func Test_bucketLifecycleRules(t *testing.T) {
+	ctx := context.Background()
+	stub, client := newS3Stub(t)
+	if err := client.makeBucket(ctx, "bucket-1", "", false); err != nil {
+		t.Fatal(err)
+	}
+	// rules set by other tools keep the actions m3 can't manage
+	stub.buckets["bucket-1"].lifecycle = []byte(`<LifecycleConfiguration><Rule><ID>archive</ID><Status>Enabled</Status>` +
+		`<Prefix>archive/</Prefix><Transition><Days>30</Days><StorageClass>WARM</StorageClass></Transition></Rule></LifecycleConfiguration>`)
+
+	lifecycle, err := getBucketLifecycle(ctx, client, "bucket-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(lifecycle.Rules) != 1 || lifecycle.Rules[0].Prefix != "archive/" || len(lifecycle.Rules[0].UnmanagedActions) != 1 {
+		t.Fatalf("getBucketLifecycle() = %+v", lifecycle.Rules)
+	}
+
+	rule := &models.LifecycleRule{
+		ID:         swag.String("logs"),
+		Prefix:     "logs/",
+		Tags:       []*models.LifecycleTag{{Key: swag.String("team"), Value: "a"}},
+		Expiration: &models.LifecycleExpiration{Days: 30},
+	}
+	if _, err := addBucketLifecycleRule(ctx, client, "bucket-1", rule); err != nil {
+		t.Fatalf("addBucketLifecycleRule() error = %v", err)
+	}
+	_, err = addBucketLifecycleRule(ctx, client, "bucket-1", rule)
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusConflict {
+		t.Errorf("addBucketLifecycleRule() of an existing rule error = %v", err)
+	}
+	stored := string(stub.buckets["bucket-1"].lifecycle)
+	for _, want := range []string{
+		"<Filter><And><Prefix>logs/</Prefix><Tag><Key>team</Key><Value>a</Value></Tag></And></Filter>",
+		"<Transition><Days>30</Days><StorageClass>WARM</StorageClass></Transition>",
+	} {
+		if !strings.Contains(stored, want) {
+			t.Errorf("stored lifecycle %s doesn't contain %s", stored, want)
+		}
+	}
+
+	// updating the rule with the unmanaged actions keeps them
+	lifecycle, err = updateBucketLifecycleRule(ctx, client, "bucket-1", "archive", &models.LifecycleRule{
+		ID:                             swag.String("archive-v2"),
+		Enabled:                        swag.Bool(false),
+		AbortIncompleteMultipartUpload: &models.LifecycleRuleAbortIncompleteMultipartUpload{DaysAfterInitiation: swag.Int32(2)},
+	})
+	if err != nil {
+		t.Fatalf("updateBucketLifecycleRule() error = %v", err)
+	}
+	got := lifecycle.Rules[0]
+	if *got.ID != "archive-v2" || *got.Enabled || len(got.UnmanagedActions) != 1 || *got.AbortIncompleteMultipartUpload.DaysAfterInitiation != 2 {
+		t.Errorf("updateBucketLifecycleRule() = %+v", got)
+	}
+	_, err = updateBucketLifecycleRule(ctx, client, "bucket-1", "archive", rule)
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusNotFound {
+		t.Errorf("updateBucketLifecycleRule() of a missing rule error = %v", err)
+	}
+	_, err = updateBucketLifecycleRule(ctx, client, "bucket-1", "logs", &models.LifecycleRule{ID: swag.String("logs")})
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusUnprocessableEntity {
+		t.Errorf("updateBucketLifecycleRule() with an invalid rule error = %v", err)
+	}
+
+	for _, id := range []string{"archive-v2", "logs"} {
+		if err := deleteBucketLifecycleRule(ctx, client, "bucket-1", id); err != nil {
+			t.Fatalf("deleteBucketLifecycleRule() error = %v", err)
+		}
+	}
+	// removing the last rule removes the configuration
+	if stub.buckets["bucket-1"].lifecycle != nil {
+		t.Errorf("lifecycle configuration wasn't removed: %s", stub.buckets["bucket-1"].lifecycle)
+	}
+	err = deleteBucketLifecycleRule(ctx, client, "bucket-1", "logs")
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusNotFound {
+		t.Errorf("deleteBucketLifecycleRule() of a missing rule error = %v", err)
+	}
+
+	lifecycle, err = setBucketLifecycle(ctx, client, "bucket-1", &models.BucketLifecycle{Rules: []*models.LifecycleRule{rule}})
+	if err != nil || len(lifecycle.Rules) != 1 || lifecycle.Rules[0].Expiration.Days != 30 || len(lifecycle.Rules[0].Tags) != 1 {
+		t.Errorf("setBucketLifecycle() = %+v, error = %v", lifecycle, err)
+	}
+	_, err = getBucketLifecycle(ctx, client, "bucket-2")
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusNotFound {
+		t.Errorf("getBucketLifecycle() of a missing bucket error = %v", err)
+	}
+}
@@ -0,0 +1,265 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/restapi/operations"
+	"github.com/minio/m3/restapi/operations/admin_api"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	"github.com/minio/minio/pkg/madmin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// m3DefaultBucketQuotaAnnotation is the default hard quota of the buckets created through m3, ie: 100Gi, it's read
+// from the MinIOInstance and then from its namespace
+const m3DefaultBucketQuotaAnnotation = "m3.min.io/default-bucket-quota"
+
+func registerBucketQuotaHandlers(api *operations.M3API) {
+	// Get Bucket Quota
+	api.AdminAPIGetTenantBucketQuotaHandler = admin_api.GetTenantBucketQuotaHandlerFunc(func(params admin_api.GetTenantBucketQuotaParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getGetTenantBucketQuotaResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewGetTenantBucketQuotaDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewGetTenantBucketQuotaOK().WithPayload(resp)
+	})
+	// Set Bucket Quota
+	api.AdminAPISetTenantBucketQuotaHandler = admin_api.SetTenantBucketQuotaHandlerFunc(func(params admin_api.SetTenantBucketQuotaParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getSetTenantBucketQuotaResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewSetTenantBucketQuotaDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewSetTenantBucketQuotaOK().WithPayload(resp)
+	})
+	// Clear Bucket Quota
+	api.AdminAPIClearTenantBucketQuotaHandler = admin_api.ClearTenantBucketQuotaHandlerFunc(func(params admin_api.ClearTenantBucketQuotaParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		if err := getClearTenantBucketQuotaResponse(ctx, string(*principal), params); err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewClearTenantBucketQuotaDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewClearTenantBucketQuotaNoContent()
+	})
+	// List Bucket Quotas
+	api.AdminAPIListTenantBucketQuotasHandler = admin_api.ListTenantBucketQuotasHandlerFunc(func(params admin_api.ListTenantBucketQuotasParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getListTenantBucketQuotasResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewListTenantBucketQuotasDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewListTenantBucketQuotasOK().WithPayload(resp)
+	})
+}
+
+// getBucketQuota returns the quota of the bucket, buckets without quota are reported as not found
+func getBucketQuota(ctx context.Context, client MinioAdmin, bucket string) (*models.BucketQuota, error) {
+	quota, err := client.getBucketQuota(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	if quota.Quota == 0 {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "bucketquotas"}, bucket)
+	}
+	return &models.BucketQuota{
+		Bucket: bucket,
+		Quota:  swag.Int64(int64(quota.Quota)),
+		Type:   swag.String(string(quota.Type)),
+	}, nil
+}
+
+// quotaType returns the type of the quota, hard when it's not set
+func quotaType(quota *models.BucketQuota) madmin.QuotaType {
+	if quota.Type == nil || *quota.Type == "" {
+		return madmin.HardQuota
+	}
+	return madmin.QuotaType(*quota.Type)
+}
+
+// setBucketQuota sets the quota of the bucket and warns when the quotas of the tenant exceed its capacity, the
+// quota is set anyway since the capacity of the tenant can be expanded
+func setBucketQuota(ctx context.Context, s3Client MinioClient, client MinioAdmin, bucket string, quota *models.BucketQuota) (*models.SetBucketQuotaResponse, error) {
+	if err := client.setBucketQuota(ctx, bucket, uint64(swag.Int64Value(quota.Quota)), quotaType(quota)); err != nil {
+		return nil, err
+	}
+	current, err := getBucketQuota(ctx, client, bucket)
+	if err != nil {
+		return nil, err
+	}
+	quotas, err := getTenantBucketQuotas(ctx, s3Client, client)
+	if err != nil {
+		return nil, err
+	}
+	return &models.SetBucketQuotaResponse{Quota: current, Warnings: quotas.Warnings}, nil
+}
+
+// getTenantBucketQuotas returns the quotas of the buckets of the tenant and warns when their sum exceeds the
+// usable capacity of the tenant
+func getTenantBucketQuotas(ctx context.Context, s3Client MinioClient, client MinioAdmin) (*models.TenantBucketQuotas, error) {
+	buckets, err := s3Client.listBuckets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.TenantBucketQuotas{Quotas: []*models.BucketQuota{}, Warnings: []string{}}
+	for _, bucket := range buckets {
+		quota, err := getBucketQuota(ctx, client, bucket.Name)
+		if err != nil {
+			// buckets without quota
+			if prepareError(ctx, err).Code == http.StatusNotFound {
+				continue
+			}
+			return nil, err
+		}
+		resp.Quotas = append(resp.Quotas, quota)
+		resp.TotalQuota += *quota.Quota
+	}
+	sort.Slice(resp.Quotas, func(i, j int) bool {
+		return resp.Quotas[i].Bucket < resp.Quotas[j].Bucket
+	})
+	storage, err := client.storageInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp.UsableCapacity = usableCapacity(storage)
+	if resp.UsableCapacity > 0 && resp.TotalQuota > resp.UsableCapacity {
+		resp.Warnings = append(resp.Warnings, fmt.Sprintf("the quotas of the buckets (%s) exceed the usable capacity of the tenant (%s)",
+			resource.NewQuantity(resp.TotalQuota, resource.BinarySI), resource.NewQuantity(resp.UsableCapacity, resource.BinarySI)))
+	}
+	return resp, nil
+}
+
+// usableCapacity returns the capacity of the drives of the tenant left for data by the standard storage class
+func usableCapacity(storage madmin.StorageInfo) int64 {
+	var total uint64
+	for _, drive := range storage.Total {
+		total += drive
+	}
+	data, parity := storage.Backend.StandardSCData, storage.Backend.StandardSCParity
+	if data <= 0 || parity < 0 {
+		return int64(total)
+	}
+	return int64(total / uint64(data+parity) * uint64(data))
+}
+
+// parseBucketQuota parses the quantity of a default bucket quota annotation
+func parseBucketQuota(value string) (*models.BucketQuota, error) {
+	quantity, err := resource.ParseQuantity(value)
+	if err != nil {
+		return nil, err
+	}
+	if quantity.Value() <= 0 {
+		return nil, fmt.Errorf("quota must be greater than zero")
+	}
+	return &models.BucketQuota{Quota: swag.Int64(quantity.Value()), Type: swag.String(string(madmin.HardQuota))}, nil
+}
+
+// defaultBucketQuota returns the default quota of the new buckets of the tenant, the namespace is only read when
+// the tenant doesn't have a default, namespaces the caller can't read have no default
+func defaultBucketQuota(ctx context.Context, client K8sClient, tenant *operator.MinIOInstance) (string, *models.BucketQuota, error) {
+	value := tenant.Annotations[m3DefaultBucketQuotaAnnotation]
+	source := "tenant"
+	if value == "" {
+		namespace, err := client.getNamespace(ctx, tenant.Namespace, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsForbidden(err) || apierrors.IsNotFound(err) {
+				logger.FromContext(ctx).WithError(err).Debug("namespace not readable, no default bucket quota")
+				return "", nil, nil
+			}
+			return "", nil, err
+		}
+		value = namespace.Annotations[m3DefaultBucketQuotaAnnotation]
+		source = "namespace"
+	}
+	if value == "" {
+		return "", nil, nil
+	}
+	quota, err := parseBucketQuota(value)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid %s annotation of the %s: %v", m3DefaultBucketQuotaAnnotation, source, err)
+	}
+	return value, quota, nil
+}
+
+func getGetTenantBucketQuotaResponse(ctx context.Context, token string, params admin_api.GetTenantBucketQuotaParams) (*models.BucketQuota, error) {
+	ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+	defer cancel()
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return getBucketQuota(ctx, clients.admin, params.Bucket)
+}
+
+func getSetTenantBucketQuotaResponse(ctx context.Context, token string, params admin_api.SetTenantBucketQuotaParams) (*models.SetBucketQuotaResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+	defer cancel()
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := setBucketQuota(ctx, clients.s3, clients.admin, params.Bucket, params.Body)
+	if err != nil {
+		return nil, err
+	}
+	for _, warning := range resp.Warnings {
+		logger.FromContext(ctx).Warn(warning)
+	}
+	return resp, nil
+}
+
+func getClearTenantBucketQuotaResponse(ctx context.Context, token string, params admin_api.ClearTenantBucketQuotaParams) error {
+	ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+	defer cancel()
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return err
+	}
+	return clients.admin.removeBucketQuota(ctx, params.Bucket)
+}
+
+func getListTenantBucketQuotasResponse(ctx context.Context, token string, params admin_api.ListTenantBucketQuotasParams) (*models.TenantBucketQuotas, error) {
+	ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+	defer cancel()
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := getTenantBucketQuotas(ctx, clients.s3, clients.admin)
+	if err != nil {
+		return nil, err
+	}
+	if resp.DefaultQuota, _, err = defaultBucketQuota(ctx, clients.k8s, clients.tenant); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
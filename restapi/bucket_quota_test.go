@@ -0,0 +1,259 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	v1 "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	"github.com/minio/minio/pkg/madmin"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var minioGetBucketQuotaMock func(ctx context.Context, bucket string) (madmin.BucketQuota, error)
+var minioSetBucketQuotaMock func(ctx context.Context, bucket string, quota uint64, quotaType madmin.QuotaType) error
+var minioRemoveBucketQuotaMock func(ctx context.Context, bucket string) error
+var k8sClientGetNamespaceMock func(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.Namespace, error)
+
+func (ac adminClientMock) getBucketQuota(ctx context.Context, bucket string) (madmin.BucketQuota, error) {
+	return minioGetBucketQuotaMock(ctx, bucket)
+}
+
+func (ac adminClientMock) setBucketQuota(ctx context.Context, bucket string, quota uint64, quotaType madmin.QuotaType) error {
+	return minioSetBucketQuotaMock(ctx, bucket, quota, quotaType)
+}
+
+func (ac adminClientMock) removeBucketQuota(ctx context.Context, bucket string) error {
+	return minioRemoveBucketQuotaMock(ctx, bucket)
+}
+
+func (c k8sClientMock) getNamespace(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.Namespace, error) {
+	return k8sClientGetNamespaceMock(ctx, name, opts)
+}
+
+// mockBucketQuotas stores the quotas set through the admin client mock as MinIO does
+func mockBucketQuotas(quotas map[string]madmin.BucketQuota) {
+	minioGetBucketQuotaMock = func(ctx context.Context, bucket string) (madmin.BucketQuota, error) {
+		if quota, ok := quotas[bucket]; ok {
+			return quota, nil
+		}
+		return madmin.BucketQuota{}, madmin.ErrorResponse{Code: "XMinioAdminNoSuchQuotaConfiguration", Message: "The quota configuration does not exist"}
+	}
+	minioSetBucketQuotaMock = func(ctx context.Context, bucket string, quota uint64, quotaType madmin.QuotaType) error {
+		quotas[bucket] = madmin.BucketQuota{Quota: quota, Type: quotaType}
+		return nil
+	}
+}
+
+func Test_usableCapacity(t *testing.T) {
+	tests := []struct {
+		name   string
+		total  []uint64
+		data   int
+		parity int
+		want   int64
+	}{
+		{
+			name:   "Erasure coded",
+			total:  []uint64{1000, 1000, 1000, 1000},
+			data:   2,
+			parity: 2,
+			want:   2000,
+		},
+		{
+			name:   "More data than parity drives",
+			total:  []uint64{100, 100, 100, 100, 100, 100, 100, 100},
+			data:   6,
+			parity: 2,
+			want:   600,
+		},
+		{
+			name:  "Single drive",
+			total: []uint64{1000},
+			want:  1000,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var storage madmin.StorageInfo
+			storage.Total = tt.total
+			storage.Backend.StandardSCData = tt.data
+			storage.Backend.StandardSCParity = tt.parity
+			if got := usableCapacity(storage); got != tt.want {
+				t.Errorf("usableCapacity() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_setBucketQuota(t *testing.T) {
+	ctx := context.Background()
+	_, s3Client := newS3Stub(t)
+	for _, name := range []string{"bucket-1", "bucket-2", "bucket-3"} {
+		if err := s3Client.makeBucket(ctx, name, "", false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	quotas := map[string]madmin.BucketQuota{"bucket-2": {Quota: 1 << 30, Type: madmin.HardQuota}}
+	mockBucketQuotas(quotas)
+	minioStorageInfoMock = func(ctx context.Context) (madmin.StorageInfo, error) {
+		var storage madmin.StorageInfo
+		storage.Total = []uint64{1 << 30, 1 << 30, 1 << 30, 1 << 30}
+		storage.Backend.StandardSCData = 2
+		storage.Backend.StandardSCParity = 2
+		return storage, nil
+	}
+
+	if _, err := getBucketQuota(ctx, adminClientMock{}, "bucket-1"); prepareError(ctx, err).Code != 404 {
+		t.Errorf("getBucketQuota() of a bucket without quota error = %v", err)
+	}
+	// 1Gi of 2Gi usable
+	resp, err := setBucketQuota(ctx, s3Client, adminClientMock{}, "bucket-1", &models.BucketQuota{Quota: swag.Int64(512 << 20)})
+	if err != nil || *resp.Quota.Quota != 512<<20 || *resp.Quota.Type != "hard" || len(resp.Warnings) != 0 {
+		t.Fatalf("setBucketQuota() = %+v, %v", resp, err)
+	}
+	// 3Gi of 2Gi usable
+	resp, err = setBucketQuota(ctx, s3Client, adminClientMock{}, "bucket-3", &models.BucketQuota{Quota: swag.Int64(1536 << 20), Type: swag.String("fifo")})
+	if err != nil || *resp.Quota.Type != "fifo" || len(resp.Warnings) != 1 {
+		t.Fatalf("setBucketQuota() = %+v, %v", resp, err)
+	}
+	if resp.Warnings[0] != "the quotas of the buckets (3Gi) exceed the usable capacity of the tenant (2Gi)" {
+		t.Errorf("setBucketQuota() warning = %v", resp.Warnings[0])
+	}
+
+	list, err := getTenantBucketQuotas(ctx, s3Client, adminClientMock{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Quotas) != 3 || list.Quotas[0].Bucket != "bucket-1" || list.TotalQuota != 3<<30 || list.UsableCapacity != 2<<30 {
+		t.Errorf("getTenantBucketQuotas() = %+v", list)
+	}
+}
+
+func Test_createBucketWithQuota(t *testing.T) {
+	ctx := context.Background()
+	stub, s3Client := newS3Stub(t)
+	quotas := map[string]madmin.BucketQuota{}
+	mockBucketQuotas(quotas)
+	minioStorageInfoMock = func(ctx context.Context) (madmin.StorageInfo, error) {
+		var storage madmin.StorageInfo
+		storage.Total = []uint64{1 << 30, 1 << 30}
+		return storage, nil
+	}
+	req := &models.CreateBucketRequest{Name: swag.String("bucket-1")}
+	bucket, err := createBucket(ctx, s3Client, adminClientMock{}, req, &models.BucketQuota{Quota: swag.Int64(1 << 30)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quotas["bucket-1"].Quota != 1<<30 || quotas["bucket-1"].Type != madmin.HardQuota || len(bucket.Warnings) != 0 {
+		t.Errorf("createBucket() quota = %+v, warnings %v", quotas["bucket-1"], bucket.Warnings)
+	}
+	// 3Gi of 2Gi usable, the bucket is created with a warning
+	req = &models.CreateBucketRequest{Name: swag.String("bucket-3")}
+	bucket, err = createBucket(ctx, s3Client, adminClientMock{}, req, &models.BucketQuota{Quota: swag.Int64(2 << 30)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bucket.Warnings) != 1 || bucket.Warnings[0] != "the quotas of the buckets (3Gi) exceed the usable capacity of the tenant (2Gi)" {
+		t.Errorf("createBucket() warnings = %v", bucket.Warnings)
+	}
+
+	// the bucket is removed when its quota can't be set
+	minioSetBucketQuotaMock = func(ctx context.Context, bucket string, quota uint64, quotaType madmin.QuotaType) error {
+		return madmin.ErrorResponse{Code: "AccessDenied", Message: "Access Denied."}
+	}
+	req = &models.CreateBucketRequest{Name: swag.String("bucket-2")}
+	if _, err := createBucket(ctx, s3Client, adminClientMock{}, req, &models.BucketQuota{Quota: swag.Int64(1 << 30)}); err == nil {
+		t.Fatalf("createBucket() should fail when the quota can't be set")
+	}
+	if _, ok := stub.buckets["bucket-2"]; ok {
+		t.Errorf("createBucket() should remove the bucket when the quota can't be set")
+	}
+}
+
+func Test_defaultBucketQuota(t *testing.T) {
+	ctx := context.Background()
+	tenant := func(annotations map[string]string) *v1.MinIOInstance {
+		return &v1.MinIOInstance{ObjectMeta: metav1.ObjectMeta{Name: "tenant-1", Namespace: "team-a", Annotations: annotations}}
+	}
+	tests := []struct {
+		name         string
+		tenant       *v1.MinIOInstance
+		namespace    map[string]string
+		namespaceErr error
+		want         int64
+		wantErr      bool
+	}{
+		{
+			name:      "Quota of the tenant",
+			tenant:    tenant(map[string]string{m3DefaultBucketQuotaAnnotation: "10Gi"}),
+			namespace: map[string]string{m3DefaultBucketQuotaAnnotation: "1Gi"},
+			want:      10 << 30,
+		},
+		{
+			name:      "Quota of the namespace",
+			tenant:    tenant(nil),
+			namespace: map[string]string{m3DefaultBucketQuotaAnnotation: "1Gi"},
+			want:      1 << 30,
+		},
+		{
+			name:   "No default quota",
+			tenant: tenant(nil),
+		},
+		{
+			name:         "Namespace not readable",
+			tenant:       tenant(nil),
+			namespaceErr: apierrors.NewForbidden(schema.GroupResource{Resource: "namespaces"}, "team-a", errors.New("not allowed")),
+		},
+		{
+			name:         "Namespace error",
+			tenant:       tenant(nil),
+			namespaceErr: errors.New("connection refused"),
+			wantErr:      true,
+		},
+		{
+			name:      "Invalid quota",
+			tenant:    tenant(nil),
+			namespace: map[string]string{m3DefaultBucketQuotaAnnotation: "lots"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClientGetNamespaceMock = func(ctx context.Context, name string, opts metav1.GetOptions) (*corev1.Namespace, error) {
+				if tt.namespaceErr != nil {
+					return nil, tt.namespaceErr
+				}
+				return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Annotations: tt.namespace}}, nil
+			}
+			_, got, err := defaultBucketQuota(ctx, k8sClientMock{}, tt.tenant)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("defaultBucketQuota() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if (got == nil && tt.want != 0) || (got != nil && *got.Quota != tt.want) {
+				t.Errorf("defaultBucketQuota() = %+v, want %v", got, tt.want)
+			}
+		})
+	}
+}
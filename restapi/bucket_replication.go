@@ -0,0 +1,501 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/restapi/operations"
+	"github.com/minio/m3/restapi/operations/admin_api"
+	minio "github.com/minio/minio-go/v6"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// replicationNotFound is the S3 error code returned when the bucket has no replication configuration
+	replicationNotFound = "ReplicationConfigurationNotFoundError"
+	// replicationTargetType is the type of the remote targets used for replication
+	replicationTargetType = "replication"
+	// replicationRulePrefix is the prefix of the ids of the replication rules created by m3
+	replicationRulePrefix = "m3/"
+)
+
+func registerBucketReplicationHandlers(api *operations.M3API) {
+	// Get Bucket Replication
+	api.AdminAPIGetTenantBucketReplicationHandler = admin_api.GetTenantBucketReplicationHandlerFunc(func(params admin_api.GetTenantBucketReplicationParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getGetTenantBucketReplicationResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewGetTenantBucketReplicationDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewGetTenantBucketReplicationOK().WithPayload(resp)
+	})
+	// Set Bucket Replication
+	api.AdminAPISetTenantBucketReplicationHandler = admin_api.SetTenantBucketReplicationHandlerFunc(func(params admin_api.SetTenantBucketReplicationParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getSetTenantBucketReplicationResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewSetTenantBucketReplicationDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewSetTenantBucketReplicationOK().WithPayload(resp)
+	})
+	// Delete Bucket Replication
+	api.AdminAPIDeleteTenantBucketReplicationHandler = admin_api.DeleteTenantBucketReplicationHandlerFunc(func(params admin_api.DeleteTenantBucketReplicationParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		if err := getDeleteTenantBucketReplicationResponse(ctx, string(*principal), params); err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewDeleteTenantBucketReplicationDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewDeleteTenantBucketReplicationNoContent()
+	})
+}
+
+// bucketTarget is a remote target of a bucket as exchanged with the admin api of MinIO
+type bucketTarget struct {
+	SourceBucket string                   `json:"sourcebucket"`
+	Endpoint     string                   `json:"endpoint"`
+	Credentials  *bucketTargetCredentials `json:"credentials"`
+	TargetBucket string                   `json:"targetbucket"`
+	Secure       bool                     `json:"secure"`
+	API          string                   `json:"api,omitempty"`
+	Arn          string                   `json:"arn,omitempty"`
+	Type         string                   `json:"type"`
+	Online       *bool                    `json:"isOnline,omitempty"`
+}
+
+type bucketTargetCredentials struct {
+	AccessKey string `json:"accessKey"`
+	SecretKey string `json:"secretKey"`
+}
+
+// replicationConfiguration is the s3 replication configuration of a bucket
+type replicationConfiguration struct {
+	XMLName xml.Name          `xml:"ReplicationConfiguration"`
+	Role    string            `xml:"Role,omitempty"`
+	Rules   []replicationRule `xml:"Rule"`
+}
+
+type replicationRule struct {
+	ID                      string                 `xml:"ID"`
+	Status                  string                 `xml:"Status"`
+	Priority                int                    `xml:"Priority"`
+	DeleteMarkerReplication *replicationStatus     `xml:"DeleteMarkerReplication,omitempty"`
+	DeleteReplication       *replicationStatus     `xml:"DeleteReplication,omitempty"`
+	Filter                  *replicationFilter     `xml:"Filter,omitempty"`
+	Destination             replicationDestination `xml:"Destination"`
+	// Raw is the rule as read from the tenant, it's written back as it was so the rules created by other tools
+	// keep the settings m3 doesn't know about
+	Raw string `xml:",innerxml"`
+}
+
+type replicationStatus struct {
+	Status string `xml:"Status"`
+}
+
+type replicationFilter struct {
+	Prefix string `xml:"Prefix"`
+}
+
+type replicationDestination struct {
+	Bucket string `xml:"Bucket"`
+}
+
+// MarshalXML writes the rules read from the tenant as they were read
+func (r replicationRule) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	type rule replicationRule
+	if r.Raw != "" {
+		return e.EncodeElement(struct {
+			Raw string `xml:",innerxml"`
+		}{r.Raw}, start)
+	}
+	return e.EncodeElement(rule(r), start)
+}
+
+// replicationMetrics are the replication metrics of a bucket, the totals are reported along the metrics of each
+// target by arn
+type replicationMetrics struct {
+	Stats map[string]replicationTargetMetrics `json:"Stats"`
+	replicationTargetMetrics
+}
+
+type replicationTargetMetrics struct {
+	PendingSize    int64 `json:"pendingReplicationSize"`
+	ReplicatedSize int64 `json:"completedReplicationSize"`
+	FailedSize     int64 `json:"failedReplicationSize"`
+	PendingCount   int64 `json:"pendingReplicationCount"`
+	FailedCount    int64 `json:"failedReplicationCount"`
+}
+
+// replicationRuleID returns the id of the rule that replicates a bucket to the bucket of the target tenant
+func replicationRuleID(target *operator.MinIOInstance, bucket string) string {
+	return fmt.Sprintf("%s%s/%s/%s", replicationRulePrefix, target.Namespace, target.Name, bucket)
+}
+
+// parseReplicationRuleID returns the target tenant and bucket of a rule created by m3
+func parseReplicationRuleID(id string) (namespace, tenant, bucket string, ok bool) {
+	parts := strings.Split(strings.TrimPrefix(id, replicationRulePrefix), "/")
+	if !strings.HasPrefix(id, replicationRulePrefix) || len(parts) != 3 {
+		return "", "", "", false
+	}
+	return parts[0], parts[1], parts[2], true
+}
+
+// replicationAccessKey returns the access key of the user provisioned in the target tenant to replicate a bucket,
+// MinIO access keys are limited to 20 characters
+func replicationAccessKey(source *operator.MinIOInstance, bucket, targetBucket string) string {
+	sum := sha256.Sum256([]byte(strings.Join([]string{source.Namespace, source.Name, bucket, targetBucket}, "/")))
+	return "m3repl" + hex.EncodeToString(sum[:])[:14]
+}
+
+// replicationPolicyName returns the name of the policy of the users that replicate to the bucket
+func replicationPolicyName(bucket string) string {
+	return "m3-replication-" + bucket
+}
+
+// replicationPolicy allows replicating objects to the bucket
+func replicationPolicy(bucket string) []byte {
+	return []byte(fmt.Sprintf(`{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetReplicationConfiguration",
+        "s3:ListBucket",
+        "s3:ListBucketMultipartUploads",
+        "s3:GetBucketLocation",
+        "s3:GetBucketVersioning",
+        "s3:GetBucketObjectLockConfiguration"
+      ],
+      "Resource": ["arn:aws:s3:::%[1]s"]
+    },
+    {
+      "Effect": "Allow",
+      "Action": [
+        "s3:GetReplicationConfiguration",
+        "s3:ReplicateTags",
+        "s3:AbortMultipartUpload",
+        "s3:GetObject",
+        "s3:GetObjectVersion",
+        "s3:GetObjectVersionTagging",
+        "s3:PutObject",
+        "s3:PutObjectRetention",
+        "s3:PutObjectLegalHold",
+        "s3:DeleteObject",
+        "s3:ReplicateObject",
+        "s3:ReplicateDelete"
+      ],
+      "Resource": ["arn:aws:s3:::%[1]s/*"]
+    }
+  ]
+}`, bucket))
+}
+
+// ensureReplicationBucket enables the versioning of the bucket, required by both sides of the replication, the
+// bucket is created when it doesn't exist and create is set
+func ensureReplicationBucket(ctx context.Context, client MinioClient, bucket string, create bool) error {
+	err := client.setBucketVersioning(ctx, bucket, true)
+	if create && minio.ToErrorResponse(err).Code == "NoSuchBucket" {
+		if err := client.makeBucket(ctx, bucket, "", false); err != nil {
+			return err
+		}
+		logger.FromContext(ctx).WithField("target_bucket", bucket).Info("replication target bucket created")
+		err = client.setBucketVersioning(ctx, bucket, true)
+	}
+	return err
+}
+
+// writeReplicationConfiguration replaces the replication configuration of the bucket, no rules removes it
+func writeReplicationConfiguration(ctx context.Context, client MinioAdmin, bucket string, config *replicationConfiguration) error {
+	if len(config.Rules) == 0 {
+		return client.removeBucketReplication(ctx, bucket)
+	}
+	return client.setBucketReplication(ctx, bucket, config)
+}
+
+// setupBucketReplication replicates the bucket of the source tenant to the bucket of the target tenant, a user
+// allowed to replicate to the bucket is provisioned in the target tenant and used by the remote target of the
+// source bucket, setting up the same target again replaces the previous remote target and credentials
+func setupBucketReplication(ctx context.Context, source, target *tenantClients, bucket string, req *models.BucketReplicationRequest) (*models.BucketReplication, error) {
+	targetBucket := req.TargetBucket
+	if targetBucket == "" {
+		targetBucket = bucket
+	}
+	if source.tenant.Namespace == target.tenant.Namespace && source.tenant.Name == target.tenant.Name && bucket == targetBucket {
+		return nil, apierrors.NewBadRequest("a bucket can't be replicated to itself")
+	}
+	if err := ensureReplicationBucket(ctx, source.s3, bucket, false); err != nil {
+		return nil, err
+	}
+	if err := ensureReplicationBucket(ctx, target.s3, targetBucket, true); err != nil {
+		return nil, err
+	}
+
+	// credentials of the source tenant in the target tenant
+	policyName := replicationPolicyName(targetBucket)
+	if err := target.admin.addCannedPolicy(ctx, policyName, replicationPolicy(targetBucket)); err != nil {
+		return nil, err
+	}
+	accessKey, secretKey := replicationAccessKey(source.tenant, bucket, targetBucket), RandomCharString(40)
+	if err := target.admin.addUser(ctx, accessKey, secretKey); err != nil {
+		return nil, err
+	}
+	if err := target.admin.setPolicy(ctx, policyName, accessKey, false); err != nil {
+		return nil, err
+	}
+
+	config, err := source.admin.getBucketReplication(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		config = &replicationConfiguration{}
+	}
+	targets, err := source.admin.listRemoteTargets(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, secure := tenantServiceEndpoint(target.tenant)
+	arn, err := source.admin.setRemoteTarget(ctx, bucket, &bucketTarget{
+		SourceBucket: bucket,
+		Endpoint:     endpoint,
+		Credentials:  &bucketTargetCredentials{AccessKey: accessKey, SecretKey: secretKey},
+		TargetBucket: targetBucket,
+		Secure:       secure,
+		API:          "s3v4",
+		Type:         replicationTargetType,
+	})
+	if err != nil {
+		return nil, err
+	}
+	deletes := "Disabled"
+	if req.ReplicateDeletes {
+		deletes = "Enabled"
+	}
+	rule := replicationRule{
+		ID:                      replicationRuleID(target.tenant, targetBucket),
+		Status:                  "Enabled",
+		DeleteMarkerReplication: &replicationStatus{Status: deletes},
+		DeleteReplication:       &replicationStatus{Status: deletes},
+		Filter:                  &replicationFilter{},
+		Destination:             replicationDestination{Bucket: arn},
+	}
+	// the rule of a previous setup is replaced in place, keeping its priority, so the bucket is replicated by
+	// either the previous rule or the new one
+	rules := make([]replicationRule, 0, len(config.Rules)+1)
+	replaced := false
+	priority := 0
+	for _, current := range config.Rules {
+		if current.Priority > priority {
+			priority = current.Priority
+		}
+		if current.ID == rule.ID {
+			rule.Priority = current.Priority
+			rules = append(rules, rule)
+			replaced = true
+			continue
+		}
+		rules = append(rules, current)
+	}
+	if !replaced {
+		rule.Priority = priority + 1
+		rules = append(rules, rule)
+	}
+	if err := source.admin.setBucketReplication(ctx, bucket, &replicationConfiguration{Role: config.Role, Rules: rules}); err != nil {
+		// the previous configuration is still in place, only the new remote target has to go
+		if removeErr := source.admin.removeRemoteTarget(ctx, bucket, arn); removeErr != nil {
+			logger.FromContext(ctx).WithError(removeErr).Error("error removing the remote target after failing to set the replication")
+		}
+		return nil, err
+	}
+	// the remote targets of a previous setup aren't used by any rule anymore
+	for _, remote := range targets {
+		if remote.Endpoint == endpoint && remote.TargetBucket == targetBucket && remote.Arn != arn {
+			if err := source.admin.removeRemoteTarget(ctx, bucket, remote.Arn); err != nil {
+				logger.FromContext(ctx).WithError(err).WithField("arn", remote.Arn).Warn("error removing the previous remote target")
+			}
+		}
+	}
+	return getBucketReplication(ctx, source.admin, bucket)
+}
+
+// replicationBacklog returns the model of the replication metrics
+func replicationBacklog(metrics replicationTargetMetrics) *models.ReplicationBacklog {
+	return &models.ReplicationBacklog{
+		PendingCount:   metrics.PendingCount,
+		PendingSize:    metrics.PendingSize,
+		FailedCount:    metrics.FailedCount,
+		FailedSize:     metrics.FailedSize,
+		ReplicatedSize: metrics.ReplicatedSize,
+	}
+}
+
+// getBucketReplication returns the replication targets of the bucket with the status of their rules and their
+// backlog, the backlog isn't reported by the tenants that don't support replication metrics
+func getBucketReplication(ctx context.Context, client MinioAdmin, bucket string) (*models.BucketReplication, error) {
+	config, err := client.getBucketReplication(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	if config == nil {
+		config = &replicationConfiguration{}
+	}
+	targets, err := client.listRemoteTargets(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	metrics, err := client.getBucketReplicationMetrics(ctx, bucket)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("unable to read the replication metrics")
+		metrics = nil
+	}
+
+	resp := &models.BucketReplication{Bucket: bucket, Targets: []*models.ReplicationTarget{}}
+	if metrics != nil {
+		resp.Backlog = replicationBacklog(metrics.replicationTargetMetrics)
+	}
+	for _, remote := range targets {
+		target := &models.ReplicationTarget{
+			Arn:      remote.Arn,
+			Endpoint: remote.Endpoint,
+			Bucket:   remote.TargetBucket,
+			Online:   remote.Online,
+		}
+		for _, rule := range config.Rules {
+			if rule.Destination.Bucket != remote.Arn {
+				continue
+			}
+			target.RuleID = rule.ID
+			target.Status = rule.Status
+			target.ReplicateDeletes = rule.DeleteMarkerReplication != nil && rule.DeleteMarkerReplication.Status == "Enabled"
+			target.Namespace, target.Tenant, _, _ = parseReplicationRuleID(rule.ID)
+		}
+		if metrics != nil {
+			if targetMetrics, ok := metrics.Stats[remote.Arn]; ok {
+				target.Backlog = replicationBacklog(targetMetrics)
+			}
+		}
+		resp.Targets = append(resp.Targets, target)
+	}
+	sort.Slice(resp.Targets, func(i, j int) bool {
+		return resp.Targets[i].Arn < resp.Targets[j].Arn
+	})
+	return resp, nil
+}
+
+// removeBucketReplication removes the replication configuration and the remote targets of the bucket, the users
+// provisioned by m3 in the target tenants are removed too, failing to remove them is only logged since the target
+// tenant may not exist anymore
+func removeBucketReplication(ctx context.Context, source *tenantClients, bucket string, targetClients func(namespace, tenant string) (*tenantClients, error)) error {
+	config, err := source.admin.getBucketReplication(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	targets, err := source.admin.listRemoteTargets(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if config != nil {
+		if err := source.admin.removeBucketReplication(ctx, bucket); err != nil {
+			return err
+		}
+	}
+	for _, remote := range targets {
+		if err := source.admin.removeRemoteTarget(ctx, bucket, remote.Arn); err != nil {
+			return err
+		}
+	}
+	if config == nil {
+		return nil
+	}
+	for _, rule := range config.Rules {
+		namespace, tenant, targetBucket, ok := parseReplicationRuleID(rule.ID)
+		if !ok {
+			continue
+		}
+		log := logger.FromContext(ctx).WithField("target_namespace", namespace).WithField("target_tenant", tenant)
+		target, err := targetClients(namespace, tenant)
+		if err == nil {
+			err = target.admin.removeUser(ctx, replicationAccessKey(source.tenant, bucket, targetBucket))
+		}
+		if err != nil && prepareError(ctx, err).Code != http.StatusNotFound {
+			log.WithError(err).Warn("unable to remove the replication user of the target tenant")
+		}
+	}
+	return nil
+}
+
+func getGetTenantBucketReplicationResponse(ctx context.Context, token string, params admin_api.GetTenantBucketReplicationParams) (*models.BucketReplication, error) {
+	ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return getBucketReplication(ctx, client, params.Bucket)
+}
+
+func getSetTenantBucketReplicationResponse(ctx context.Context, token string, params admin_api.SetTenantBucketReplicationParams) (*models.BucketReplication, error) {
+	ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+	defer cancel()
+	source, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	// the caller needs access to the credentials of both tenants
+	target, err := newTenantClients(ctx, token, swag.StringValue(params.Body.TargetNamespace), swag.StringValue(params.Body.TargetTenant))
+	if err != nil {
+		return nil, err
+	}
+	resp, err := setupBucketReplication(ctx, source, target, params.Bucket, params.Body)
+	if err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).WithField("target_namespace", target.tenant.Namespace).WithField("target_tenant", target.tenant.Name).Info("bucket replication set up")
+	return resp, nil
+}
+
+func getDeleteTenantBucketReplicationResponse(ctx context.Context, token string, params admin_api.DeleteTenantBucketReplicationParams) error {
+	ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+	defer cancel()
+	source, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return err
+	}
+	targetClients := func(namespace, tenant string) (*tenantClients, error) {
+		return newTenantClients(ctx, token, namespace, tenant)
+	}
+	if err := removeBucketReplication(ctx, source, params.Bucket, targetClients); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).Info("bucket replication removed")
+	return nil
+}
@@ -0,0 +1,358 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	v1 "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	"github.com/minio/minio/pkg/madmin"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var minioAddCannedPolicyMock func(ctx context.Context, name string, policy []byte) error
+var minioSetRemoteTargetMock func(ctx context.Context, bucket string, target *bucketTarget) (string, error)
+var minioListRemoteTargetsMock func(ctx context.Context, bucket string) ([]bucketTarget, error)
+var minioRemoveRemoteTargetMock func(ctx context.Context, bucket, arn string) error
+var minioGetBucketReplicationMock func(ctx context.Context, bucket string) (*replicationConfiguration, error)
+var minioSetBucketReplicationMock func(ctx context.Context, bucket string, config *replicationConfiguration) error
+var minioRemoveBucketReplicationMock func(ctx context.Context, bucket string) error
+var minioGetBucketReplicationMetricsMock func(ctx context.Context, bucket string) (*replicationMetrics, error)
+
+func (ac adminClientMock) addCannedPolicy(ctx context.Context, name string, policy []byte) error {
+	return minioAddCannedPolicyMock(ctx, name, policy)
+}
+
+func (ac adminClientMock) setRemoteTarget(ctx context.Context, bucket string, target *bucketTarget) (string, error) {
+	return minioSetRemoteTargetMock(ctx, bucket, target)
+}
+
+func (ac adminClientMock) listRemoteTargets(ctx context.Context, bucket string) ([]bucketTarget, error) {
+	return minioListRemoteTargetsMock(ctx, bucket)
+}
+
+func (ac adminClientMock) removeRemoteTarget(ctx context.Context, bucket, arn string) error {
+	return minioRemoveRemoteTargetMock(ctx, bucket, arn)
+}
+
+func (ac adminClientMock) getBucketReplication(ctx context.Context, bucket string) (*replicationConfiguration, error) {
+	return minioGetBucketReplicationMock(ctx, bucket)
+}
+
+func (ac adminClientMock) setBucketReplication(ctx context.Context, bucket string, config *replicationConfiguration) error {
+	return minioSetBucketReplicationMock(ctx, bucket, config)
+}
+
+func (ac adminClientMock) removeBucketReplication(ctx context.Context, bucket string) error {
+	return minioRemoveBucketReplicationMock(ctx, bucket)
+}
+
+func (ac adminClientMock) getBucketReplicationMetrics(ctx context.Context, bucket string) (*replicationMetrics, error) {
+	return minioGetBucketReplicationMetricsMock(ctx, bucket)
+}
+
+// replicationState is the replication state stored through the admin client mock, the source tenant stores the
+// remote targets and the configuration while the target tenant stores the policy and the user
+type replicationState struct {
+	policies map[string]string
+	users    map[string]string
+	grants   map[string]string
+	targets  []bucketTarget
+	config   *replicationConfiguration
+	nextArn  int
+}
+
+func mockReplication(state *replicationState) {
+	minioAddCannedPolicyMock = func(ctx context.Context, name string, policy []byte) error {
+		state.policies[name] = string(policy)
+		return nil
+	}
+	minioAddUserMock = func(ctx context.Context, accessKey, secretKey string) error {
+		state.users[accessKey] = secretKey
+		return nil
+	}
+	minioRemoveUserMock = func(ctx context.Context, accessKey string) error {
+		delete(state.users, accessKey)
+		return nil
+	}
+	minioSetPolicyMock = func(ctx context.Context, policyName, entityName string, isGroup bool) error {
+		state.grants[entityName] = policyName
+		return nil
+	}
+	minioSetRemoteTargetMock = func(ctx context.Context, bucket string, target *bucketTarget) (string, error) {
+		state.nextArn++
+		target.Arn = fmt.Sprintf("arn:minio:replication::%d:%s", state.nextArn, target.TargetBucket)
+		target.Online = swag.Bool(true)
+		state.targets = append(state.targets, *target)
+		return target.Arn, nil
+	}
+	minioListRemoteTargetsMock = func(ctx context.Context, bucket string) ([]bucketTarget, error) {
+		return state.targets, nil
+	}
+	minioRemoveRemoteTargetMock = func(ctx context.Context, bucket, arn string) error {
+		if state.config != nil {
+			for _, rule := range state.config.Rules {
+				if rule.Destination.Bucket == arn {
+					return madmin.ErrorResponse{Code: "XMinioAdminRemoteTargetInUse"}
+				}
+			}
+		}
+		var targets []bucketTarget
+		for _, target := range state.targets {
+			if target.Arn != arn {
+				targets = append(targets, target)
+			}
+		}
+		state.targets = targets
+		return nil
+	}
+	minioGetBucketReplicationMock = func(ctx context.Context, bucket string) (*replicationConfiguration, error) {
+		if state.config == nil {
+			return nil, nil
+		}
+		// the configuration is read back from xml as the tenant returns it
+		data, err := xml.Marshal(state.config)
+		if err != nil {
+			return nil, err
+		}
+		config := &replicationConfiguration{}
+		return config, xml.Unmarshal(data, config)
+	}
+	minioSetBucketReplicationMock = func(ctx context.Context, bucket string, config *replicationConfiguration) error {
+		state.config = config
+		return nil
+	}
+	minioRemoveBucketReplicationMock = func(ctx context.Context, bucket string) error {
+		state.config = nil
+		return nil
+	}
+	minioGetBucketReplicationMetricsMock = func(ctx context.Context, bucket string) (*replicationMetrics, error) {
+		return nil, errors.New("replication metrics not supported")
+	}
+}
+
+func newReplicationState() *replicationState {
+	return &replicationState{policies: map[string]string{}, users: map[string]string{}, grants: map[string]string{}}
+}
+
+func Test_setupBucketReplication(t *testing.T) {
+	ctx := context.Background()
+	sourceStub, sourceS3 := newS3Stub(t)
+	targetStub, targetS3 := newS3Stub(t)
+	if err := sourceS3.makeBucket(ctx, "photos", "", false); err != nil {
+		t.Fatal(err)
+	}
+	state := newReplicationState()
+	mockReplication(state)
+	// rules created by other tools are kept
+	external := &replicationConfiguration{}
+	if err := xml.Unmarshal([]byte(`<ReplicationConfiguration><Rule><ID>external</ID><Status>Enabled</Status><Priority>3</Priority>`+
+		`<Filter><And><Prefix>raw/</Prefix><Tag><Key>k</Key><Value>v</Value></Tag></And></Filter>`+
+		`<Destination><Bucket>arn:minio:replication::external:raw</Bucket></Destination></Rule></ReplicationConfiguration>`), external); err != nil {
+		t.Fatal(err)
+	}
+	state.config = external
+
+	source := &tenantClients{tenant: &v1.MinIOInstance{ObjectMeta: metav1.ObjectMeta{Name: "tenant-1", Namespace: "ns-1"}}, s3: sourceS3, admin: adminClientMock{}}
+	target := &tenantClients{tenant: &v1.MinIOInstance{ObjectMeta: metav1.ObjectMeta{Name: "tenant-2", Namespace: "ns-2"}}, s3: targetS3, admin: adminClientMock{}}
+	req := &models.BucketReplicationRequest{TargetNamespace: swag.String("ns-2"), TargetTenant: swag.String("tenant-2"), ReplicateDeletes: true}
+	for i := 0; i < 2; i++ {
+		resp, err := setupBucketReplication(ctx, source, target, "photos", req)
+		if err != nil {
+			t.Fatalf("setupBucketReplication() error = %v", err)
+		}
+		// setting up the replication again replaces the target
+		if len(resp.Targets) != 1 || resp.Targets[0].Tenant != "tenant-2" || resp.Targets[0].Status != "Enabled" || !resp.Targets[0].ReplicateDeletes {
+			t.Errorf("setupBucketReplication() targets = %+v", resp.Targets)
+		}
+		if resp.Backlog != nil {
+			t.Errorf("setupBucketReplication() backlog = %+v, want none without metrics", resp.Backlog)
+		}
+	}
+
+	if sourceStub.buckets["photos"].versioning != "Enabled" {
+		t.Errorf("source bucket versioning = %q", sourceStub.buckets["photos"].versioning)
+	}
+	if bucket, ok := targetStub.buckets["photos"]; !ok || bucket.versioning != "Enabled" {
+		t.Errorf("target bucket wasn't created with versioning: %+v", bucket)
+	}
+	accessKey := replicationAccessKey(source.tenant, "photos", "photos")
+	endpoint, _ := tenantServiceEndpoint(target.tenant)
+	if len(accessKey) > 20 || state.grants[accessKey] != "m3-replication-photos" || !strings.Contains(state.policies["m3-replication-photos"], "arn:aws:s3:::photos/*") {
+		t.Errorf("replication user %s wasn't provisioned: grants %v", accessKey, state.grants)
+	}
+	if len(state.targets) != 1 || state.targets[0].Credentials.AccessKey != accessKey || state.targets[0].Credentials.SecretKey != state.users[accessKey] ||
+		state.targets[0].Endpoint != endpoint || state.targets[0].Type != replicationTargetType {
+		t.Errorf("remote targets = %+v", state.targets)
+	}
+	data, _ := xml.Marshal(state.config)
+	for _, want := range []string{
+		"<Filter><And><Prefix>raw/</Prefix><Tag><Key>k</Key><Value>v</Value></Tag></And></Filter>",
+		"<ID>m3/ns-2/tenant-2/photos</ID><Status>Enabled</Status><Priority>4</Priority><DeleteMarkerReplication><Status>Enabled</Status></DeleteMarkerReplication>",
+		"<Destination><Bucket>" + state.targets[0].Arn + "</Bucket></Destination>",
+	} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("replication configuration %s doesn't contain %s", data, want)
+		}
+	}
+
+	// a failing setup leaves the previous rule and remote target in place
+	minioSetBucketReplicationMock = func(ctx context.Context, bucket string, config *replicationConfiguration) error {
+		return errors.New("replication configuration rejected")
+	}
+	previousArn := state.targets[0].Arn
+	if _, err := setupBucketReplication(ctx, source, target, "photos", req); err == nil {
+		t.Errorf("setupBucketReplication() error = nil, want the configuration error")
+	}
+	if after, _ := xml.Marshal(state.config); string(after) != string(data) || len(state.targets) != 1 || state.targets[0].Arn != previousArn {
+		t.Errorf("failed setup changed the replication: %s, %+v", after, state.targets)
+	}
+	mockReplication(state)
+
+	_, err := setupBucketReplication(ctx, source, source, "photos", &models.BucketReplicationRequest{})
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusBadRequest {
+		t.Errorf("setupBucketReplication() to itself error = %v", err)
+	}
+	_, err = setupBucketReplication(ctx, source, target, "missing", req)
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusNotFound {
+		t.Errorf("setupBucketReplication() of a missing bucket error = %v", err)
+	}
+
+	targetClients := func(namespace, tenant string) (*tenantClients, error) {
+		if namespace != "ns-2" || tenant != "tenant-2" {
+			return nil, fmt.Errorf("unexpected target tenant %s/%s", namespace, tenant)
+		}
+		return target, nil
+	}
+	if err := removeBucketReplication(ctx, source, "photos", targetClients); err != nil {
+		t.Fatalf("removeBucketReplication() error = %v", err)
+	}
+	if state.config != nil || len(state.targets) != 0 {
+		t.Errorf("replication wasn't removed: %+v, %+v", state.config, state.targets)
+	}
+	if _, ok := state.users[accessKey]; ok {
+		t.Errorf("replication user %s wasn't removed", accessKey)
+	}
+}
+
+func Test_getBucketReplicationBacklog(t *testing.T) {
+	ctx := context.Background()
+	state := newReplicationState()
+	mockReplication(state)
+	state.targets = []bucketTarget{{Arn: "arn-2", Endpoint: "b:9000", TargetBucket: "b"}, {Arn: "arn-1", Endpoint: "a:9000", TargetBucket: "a"}}
+	state.config = &replicationConfiguration{Rules: []replicationRule{
+		{ID: "m3/ns-2/tenant-2/a", Status: "Disabled", Priority: 1, Destination: replicationDestination{Bucket: "arn-1"}},
+	}}
+	minioGetBucketReplicationMetricsMock = func(ctx context.Context, bucket string) (*replicationMetrics, error) {
+		metrics := &replicationMetrics{}
+		err := json.Unmarshal([]byte(`{"Stats":{"arn-1":{"pendingReplicationSize":100,"pendingReplicationCount":2,"completedReplicationSize":900,"failedReplicationCount":1,"failedReplicationSize":10}},`+
+			`"pendingReplicationSize":100,"pendingReplicationCount":2,"completedReplicationSize":900,"failedReplicationCount":1,"failedReplicationSize":10}`), metrics)
+		return metrics, err
+	}
+	resp, err := getBucketReplication(ctx, adminClientMock{}, "photos")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := models.ReplicationBacklog{PendingCount: 2, PendingSize: 100, FailedCount: 1, FailedSize: 10, ReplicatedSize: 900}
+	if resp.Backlog == nil || *resp.Backlog != want {
+		t.Errorf("getBucketReplication() backlog = %+v, want %+v", resp.Backlog, want)
+	}
+	if len(resp.Targets) != 2 || resp.Targets[0].Arn != "arn-1" || resp.Targets[0].Status != "Disabled" || resp.Targets[0].Namespace != "ns-2" ||
+		resp.Targets[0].Backlog == nil || *resp.Targets[0].Backlog != want {
+		t.Errorf("getBucketReplication() targets = %+v", resp.Targets)
+	}
+	// targets without rules have no status
+	if resp.Targets[1].Status != "" || resp.Targets[1].Backlog != nil {
+		t.Errorf("getBucketReplication() unused target = %+v", resp.Targets[1])
+	}
+}
+
+func Test_adminClientReplication(t *testing.T) {
+	ctx := context.Background()
+	var gotTarget bucketTarget
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") == "" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		switch {
+		case r.URL.Path == "/minio/admin/v3/set-remote-target" && r.URL.Query().Get("bucket") == "photos":
+			data, err := madmin.DecryptData("secretkey", r.Body)
+			if err != nil || json.Unmarshal(data, &gotTarget) != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = w.Write([]byte(`"arn:minio:replication::1:backup"`))
+		case r.URL.Path == "/minio/admin/v3/remove-remote-target":
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"Code":"XMinioAdminRemoteTargetNotFoundError","Message":"The remote target does not exist"}`))
+		case r.URL.Path == "/photos" && r.Method == http.MethodGet:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`<Error><Code>ReplicationConfigurationNotFoundError</Code><Message>The replication configuration was not found</Message></Error>`))
+		case r.URL.Path == "/photos" && r.Method == http.MethodPut:
+			body, _ := ioutil.ReadAll(r.Body)
+			if !strings.Contains(string(body), "<Destination><Bucket>arn:minio:replication::1:backup</Bucket></Destination>") {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`<Error><Code>MalformedXML</Code><Message>bad</Message></Error>`))
+			}
+		default:
+			w.WriteHeader(http.StatusNotImplemented)
+		}
+	}))
+	defer server.Close()
+	client, err := newAdminClient(strings.TrimPrefix(server.URL, "http://"), false, &tenantCredentials{accessKey: "accesskey", secretKey: "secretkey"}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	arn, err := client.setRemoteTarget(ctx, "photos", &bucketTarget{Endpoint: "minio.ns-2.svc.cluster.local:9000", TargetBucket: "backup",
+		Credentials: &bucketTargetCredentials{AccessKey: "user", SecretKey: "password"}, Type: replicationTargetType})
+	if err != nil || arn != "arn:minio:replication::1:backup" || gotTarget.Credentials == nil || gotTarget.Credentials.SecretKey != "password" {
+		t.Errorf("setRemoteTarget() = %s, %v, sent %+v", arn, err, gotTarget)
+	}
+	err = client.removeRemoteTarget(ctx, "photos", "arn")
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusNotFound {
+		t.Errorf("removeRemoteTarget() of a missing target error = %v", err)
+	}
+	config, err := client.getBucketReplication(ctx, "photos")
+	if err != nil || config != nil {
+		t.Errorf("getBucketReplication() without configuration = %+v, %v", config, err)
+	}
+	err = client.setBucketReplication(ctx, "photos", &replicationConfiguration{Rules: []replicationRule{{ID: "rule", Destination: replicationDestination{Bucket: "arn:minio:replication::1:backup"}}}})
+	if err != nil {
+		t.Errorf("setBucketReplication() error = %v", err)
+	}
+	err = client.setBucketReplication(ctx, "photos", &replicationConfiguration{})
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusBadRequest || apiErr.Reason != "MalformedXML" {
+		t.Errorf("setBucketReplication() of an invalid configuration error = %v", err)
+	}
+	_, err = client.getBucketReplicationMetrics(ctx, "other")
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusNotImplemented {
+		t.Errorf("getBucketReplicationMetrics() error = %v", err)
+	}
+}
@@ -0,0 +1,329 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/restapi/operations"
+	"github.com/minio/m3/restapi/operations/admin_api"
+	minio "github.com/minio/minio-go/v6"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func registerBucketHandlers(api *operations.M3API) {
+	// List Buckets
+	api.AdminAPIListTenantBucketsHandler = admin_api.ListTenantBucketsHandlerFunc(func(params admin_api.ListTenantBucketsParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, "")
+		resp, err := getListTenantBucketsResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewListTenantBucketsDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewListTenantBucketsOK().WithPayload(resp)
+	})
+	// Create Bucket
+	api.AdminAPICreateTenantBucketHandler = admin_api.CreateTenantBucketHandlerFunc(func(params admin_api.CreateTenantBucketParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, swag.StringValue(params.Body.Name))
+		resp, err := getCreateTenantBucketResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewCreateTenantBucketDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewCreateTenantBucketCreated().WithPayload(resp)
+	})
+	// Bucket Info
+	api.AdminAPITenantBucketInfoHandler = admin_api.TenantBucketInfoHandlerFunc(func(params admin_api.TenantBucketInfoParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getTenantBucketInfoResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewTenantBucketInfoDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewTenantBucketInfoOK().WithPayload(resp)
+	})
+	// Delete Bucket
+	api.AdminAPIDeleteTenantBucketHandler = admin_api.DeleteTenantBucketHandlerFunc(func(params admin_api.DeleteTenantBucketParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		if err := getDeleteTenantBucketResponse(ctx, string(*principal), params); err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewDeleteTenantBucketDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewDeleteTenantBucketNoContent()
+	})
+	// Set Bucket Versioning
+	api.AdminAPISetTenantBucketVersioningHandler = admin_api.SetTenantBucketVersioningHandlerFunc(func(params admin_api.SetTenantBucketVersioningParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getSetTenantBucketVersioningResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewSetTenantBucketVersioningDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewSetTenantBucketVersioningOK().WithPayload(resp)
+	})
+	// Set Bucket Object Lock
+	api.AdminAPISetTenantBucketObjectLockHandler = admin_api.SetTenantBucketObjectLockHandlerFunc(func(params admin_api.SetTenantBucketObjectLockParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getSetTenantBucketObjectLockResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewSetTenantBucketObjectLockDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewSetTenantBucketObjectLockOK().WithPayload(resp)
+	})
+}
+
+// bucketContext adds the tenant and the bucket to the logger of the request
+func bucketContext(ctx context.Context, namespace, tenant, bucket string) context.Context {
+	ctx = tenantRequestContext(ctx, namespace, tenant)
+	if bucket == "" {
+		return ctx
+	}
+	return logger.WithFields(ctx, logrus.Fields{"bucket": bucket})
+}
+
+// listBuckets returns the buckets of the tenant, versioning and object lock are only reported by the bucket info
+func listBuckets(ctx context.Context, client MinioClient) (*models.ListBucketsResponse, error) {
+	buckets, err := client.listBuckets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.ListBucketsResponse{Total: int64(len(buckets))}
+	for _, bucket := range buckets {
+		resp.Buckets = append(resp.Buckets, &models.Bucket{
+			Name:         bucket.Name,
+			CreationDate: strfmt.DateTime(bucket.CreationDate),
+		})
+	}
+	return resp, nil
+}
+
+// getBucketInfo returns the bucket with its versioning status and its object lock configuration
+func getBucketInfo(ctx context.Context, client MinioClient, name string) (*models.Bucket, error) {
+	versioning, err := client.getBucketVersioning(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	objectLock, mode, validity, unit, err := client.getBucketObjectLockConfig(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	bucket := &models.Bucket{
+		Name:       name,
+		Versioning: versioning,
+		ObjectLock: objectLock,
+	}
+	if mode != nil && validity != nil && unit != nil {
+		bucket.Retention = &models.BucketRetention{
+			Mode:     string(*mode),
+			Validity: int32(*validity),
+			Unit:     string(*unit),
+		}
+	}
+	// the creation date is only reported when listing the buckets
+	buckets, err := client.listBuckets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range buckets {
+		if b.Name == name {
+			bucket.CreationDate = strfmt.DateTime(b.CreationDate)
+		}
+	}
+	return bucket, nil
+}
+
+// retentionConfig validates the default retention of a bucket, an empty retention clears it
+func retentionConfig(retention *models.BucketRetention) (*minio.RetentionMode, *uint, *minio.ValidityUnit, error) {
+	if retention == nil || (retention.Mode == "" && retention.Validity == 0 && retention.Unit == "") {
+		return nil, nil, nil, nil
+	}
+	if retention.Mode == "" || retention.Validity <= 0 || retention.Unit == "" {
+		return nil, nil, nil, apierrors.NewBadRequest("mode, validity and unit are required to set the default retention")
+	}
+	mode := minio.RetentionMode(retention.Mode)
+	validity := uint(retention.Validity)
+	unit := minio.ValidityUnit(retention.Unit)
+	return &mode, &validity, &unit, nil
+}
+
+// createBucket creates the bucket and sets its versioning, default retention and quota, object lock can only be
+// enabled on creation and it enables versioning on the bucket
+func createBucket(ctx context.Context, client MinioClient, admin MinioAdmin, req *models.CreateBucketRequest, quota *models.BucketQuota) (*models.Bucket, error) {
+	name := swag.StringValue(req.Name)
+	mode, validity, unit, err := retentionConfig(req.Retention)
+	if err != nil {
+		return nil, err
+	}
+	if mode != nil && !req.ObjectLock {
+		return nil, apierrors.NewBadRequest("a default retention requires object lock")
+	}
+	if err := client.makeBucket(ctx, name, req.Location, req.ObjectLock); err != nil {
+		return nil, err
+	}
+	configure := func() error {
+		if req.Versioning && !req.ObjectLock {
+			if err := client.setBucketVersioning(ctx, name, true); err != nil {
+				return err
+			}
+		}
+		if mode != nil {
+			if err := client.setBucketObjectLockConfig(ctx, name, mode, validity, unit); err != nil {
+				return err
+			}
+		}
+		if quota != nil {
+			return admin.setBucketQuota(ctx, name, uint64(swag.Int64Value(quota.Quota)), quotaType(quota))
+		}
+		return nil
+	}
+	// the bucket is removed so a bucket without the configuration requested, ie: the quota of its plan, isn't left
+	// behind and the creation can be retried
+	if err := configure(); err != nil {
+		if removeErr := client.removeBucket(ctx, name); removeErr != nil {
+			logger.FromContext(ctx).WithError(removeErr).Error("error removing the bucket after failing to configure it")
+		}
+		return nil, err
+	}
+	bucket, err := getBucketInfo(ctx, client, name)
+	if err != nil {
+		return nil, err
+	}
+	if quota != nil {
+		// as in setBucketQuota the bucket keeps its quota when the quotas exceed the capacity of the tenant
+		quotas, err := getTenantBucketQuotas(ctx, client, admin)
+		if err != nil {
+			logger.FromContext(ctx).WithError(err).Warn("error checking the bucket quotas against the capacity of the tenant")
+		} else {
+			bucket.Warnings = quotas.Warnings
+		}
+	}
+	return bucket, nil
+}
+
+// setBucketVersioning enables or suspends the versioning of the bucket, it can't be suspended on buckets
+// with object lock
+func setBucketVersioning(ctx context.Context, client MinioClient, name string, enabled bool) (*models.Bucket, error) {
+	if err := client.setBucketVersioning(ctx, name, enabled); err != nil {
+		return nil, err
+	}
+	return getBucketInfo(ctx, client, name)
+}
+
+// setBucketObjectLock sets or clears the default retention of a bucket created with object lock
+func setBucketObjectLock(ctx context.Context, client MinioClient, name string, retention *models.BucketRetention) (*models.Bucket, error) {
+	mode, validity, unit, err := retentionConfig(retention)
+	if err != nil {
+		return nil, err
+	}
+	if err := client.setBucketObjectLockConfig(ctx, name, mode, validity, unit); err != nil {
+		return nil, err
+	}
+	return getBucketInfo(ctx, client, name)
+}
+
+// bucketsTimeout is the timeout of the requests sent to the tenants to manage their buckets
+const bucketsTimeout = 30 * time.Second
+
+func getListTenantBucketsResponse(ctx context.Context, token string, params admin_api.ListTenantBucketsParams) (*models.ListBucketsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	client, err := newTenantMinioClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return listBuckets(ctx, client)
+}
+
+func getCreateTenantBucketResponse(ctx context.Context, token string, params admin_api.CreateTenantBucketParams) (*models.Bucket, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	quota := params.Body.Quota
+	if quota == nil {
+		if _, quota, err = defaultBucketQuota(ctx, clients.k8s, clients.tenant); err != nil {
+			return nil, err
+		}
+	}
+	bucket, err := createBucket(ctx, clients.s3, clients.admin, params.Body, quota)
+	if err != nil {
+		return nil, err
+	}
+	log := logger.FromContext(ctx).WithField("object_lock", params.Body.ObjectLock)
+	if quota != nil {
+		log = log.WithField("quota", *quota.Quota)
+	}
+	log.Info("bucket created")
+	for _, warning := range bucket.Warnings {
+		logger.FromContext(ctx).Warn(warning)
+	}
+	return bucket, nil
+}
+
+func getTenantBucketInfoResponse(ctx context.Context, token string, params admin_api.TenantBucketInfoParams) (*models.Bucket, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	client, err := newTenantMinioClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return getBucketInfo(ctx, client, params.Bucket)
+}
+
+func getDeleteTenantBucketResponse(ctx context.Context, token string, params admin_api.DeleteTenantBucketParams) error {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	client, err := newTenantMinioClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return err
+	}
+	if err := client.removeBucket(ctx, params.Bucket); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).Info("bucket deleted")
+	return nil
+}
+
+func getSetTenantBucketVersioningResponse(ctx context.Context, token string, params admin_api.SetTenantBucketVersioningParams) (*models.Bucket, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	client, err := newTenantMinioClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return setBucketVersioning(ctx, client, params.Bucket, swag.BoolValue(params.Body.Enabled))
+}
+
+func getSetTenantBucketObjectLockResponse(ctx context.Context, token string, params admin_api.SetTenantBucketObjectLockParams) (*models.Bucket, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	client, err := newTenantMinioClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return setBucketObjectLock(ctx, client, params.Bucket, params.Body)
+}
@@ -0,0 +1,356 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	v1 "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_createBucket(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name           string
+		req            *models.CreateBucketRequest
+		wantVersioning string
+		wantObjectLock bool
+		wantRetention  *models.BucketRetention
+		failPut        string
+		wantErr        bool
+	}{
+		{
+			name: "Plain bucket",
+			req:  &models.CreateBucketRequest{Name: swag.String("bucket-1")},
+		},
+		{
+			name:           "Versioned bucket",
+			req:            &models.CreateBucketRequest{Name: swag.String("bucket-1"), Versioning: true},
+			wantVersioning: "Enabled",
+		},
+		{
+			name: "Object lock with default retention",
+			req: &models.CreateBucketRequest{
+				Name:       swag.String("bucket-1"),
+				ObjectLock: true,
+				Retention:  &models.BucketRetention{Mode: "GOVERNANCE", Validity: 30, Unit: "DAYS"},
+			},
+			wantVersioning: "Enabled",
+			wantObjectLock: true,
+			wantRetention:  &models.BucketRetention{Mode: "GOVERNANCE", Validity: 30, Unit: "DAYS"},
+		},
+		{
+			name: "Retention without object lock",
+			req: &models.CreateBucketRequest{
+				Name:      swag.String("bucket-1"),
+				Retention: &models.BucketRetention{Mode: "GOVERNANCE", Validity: 30, Unit: "DAYS"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Incomplete retention",
+			req: &models.CreateBucketRequest{
+				Name:       swag.String("bucket-1"),
+				ObjectLock: true,
+				Retention:  &models.BucketRetention{Mode: "COMPLIANCE"},
+			},
+			wantErr: true,
+		},
+		{
+			name:    "Versioning rejected",
+			req:     &models.CreateBucketRequest{Name: swag.String("bucket-1"), Versioning: true},
+			failPut: "versioning",
+			wantErr: true,
+		},
+		{
+			name: "Default retention rejected",
+			req: &models.CreateBucketRequest{
+				Name:       swag.String("bucket-1"),
+				ObjectLock: true,
+				Retention:  &models.BucketRetention{Mode: "GOVERNANCE", Validity: 30, Unit: "DAYS"},
+			},
+			failPut: "object-lock",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub, client := newS3Stub(t)
+			stub.failPut = tt.failPut
+			got, err := createBucket(ctx, client, adminClientMock{}, tt.req, nil)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("createBucket() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				// a failed creation doesn't leave a half configured bucket behind
+				if _, ok := stub.buckets["bucket-1"]; ok {
+					t.Errorf("createBucket() left the bucket behind")
+				}
+				return
+			}
+			if got.Name != "bucket-1" || got.Versioning != tt.wantVersioning || got.ObjectLock != tt.wantObjectLock {
+				t.Errorf("createBucket() = %+v", got)
+			}
+			if got.CreationDate.String() == "" {
+				t.Errorf("createBucket() creation date is missing")
+			}
+			if (got.Retention == nil) != (tt.wantRetention == nil) || (got.Retention != nil && *got.Retention != *tt.wantRetention) {
+				t.Errorf("createBucket() retention = %+v, want %+v", got.Retention, tt.wantRetention)
+			}
+		})
+	}
+}
+
+func Test_listAndDeleteBuckets(t *testing.T) {
+	ctx := context.Background()
+	stub, client := newS3Stub(t)
+	for _, name := range []string{"bucket-1", "bucket-2"} {
+		if err := client.makeBucket(ctx, name, "", false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// creating an existing bucket is a conflict
+	err := client.makeBucket(ctx, "bucket-1", "", false)
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusConflict {
+		t.Errorf("makeBucket() of an existing bucket error = %v", err)
+	}
+
+	list, err := listBuckets(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.Total != 2 || len(list.Buckets) != 2 {
+		t.Errorf("listBuckets() = %+v, want 2 buckets", list)
+	}
+
+	stub.buckets["bucket-2"].objects = 1
+	err = client.removeBucket(ctx, "bucket-2")
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Reason != "BucketNotEmpty" {
+		t.Errorf("removeBucket() of a bucket with objects error = %v", err)
+	}
+	if err := client.removeBucket(ctx, "bucket-1"); err != nil {
+		t.Errorf("removeBucket() error = %v", err)
+	}
+	err = client.removeBucket(ctx, "bucket-1")
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusNotFound {
+		t.Errorf("removeBucket() of a missing bucket error = %v", err)
+	}
+	if _, err := getBucketInfo(ctx, client, "bucket-1"); err == nil {
+		t.Errorf("getBucketInfo() of a missing bucket should fail")
+	}
+}
+
+func Test_setBucketVersioningAndObjectLock(t *testing.T) {
+	ctx := context.Background()
+	_, client := newS3Stub(t)
+	if err := client.makeBucket(ctx, "plain", "", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.makeBucket(ctx, "locked", "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	bucket, err := setBucketVersioning(ctx, client, "plain", true)
+	if err != nil || bucket.Versioning != "Enabled" {
+		t.Errorf("setBucketVersioning() = %+v, %v", bucket, err)
+	}
+	bucket, err = setBucketVersioning(ctx, client, "plain", false)
+	if err != nil || bucket.Versioning != "Suspended" {
+		t.Errorf("setBucketVersioning() = %+v, %v", bucket, err)
+	}
+	// versioning can't be suspended when object lock is enabled
+	if _, err := setBucketVersioning(ctx, client, "locked", false); err == nil {
+		t.Errorf("setBucketVersioning() should fail on buckets with object lock")
+	}
+
+	// object lock can't be configured on buckets created without it
+	if _, err := setBucketObjectLock(ctx, client, "plain", &models.BucketRetention{Mode: "GOVERNANCE", Validity: 1, Unit: "YEARS"}); err == nil {
+		t.Errorf("setBucketObjectLock() should fail on buckets without object lock")
+	}
+	bucket, err = setBucketObjectLock(ctx, client, "locked", &models.BucketRetention{Mode: "COMPLIANCE", Validity: 1, Unit: "YEARS"})
+	if err != nil || bucket.Retention == nil || bucket.Retention.Mode != "COMPLIANCE" || bucket.Retention.Unit != "YEARS" {
+		t.Errorf("setBucketObjectLock() = %+v, %v", bucket, err)
+	}
+	// an empty retention clears the default retention
+	bucket, err = setBucketObjectLock(ctx, client, "locked", &models.BucketRetention{})
+	if err != nil || bucket.Retention != nil || !bucket.ObjectLock {
+		t.Errorf("setBucketObjectLock() = %+v, %v", bucket, err)
+	}
+}
+
+func Test_getTenantCredentials(t *testing.T) {
+	ctx := context.Background()
+	client := k8sClientMock{}
+	tenant := &v1.MinIOInstance{ObjectMeta: metav1.ObjectMeta{Name: "tenant-1", Namespace: "default"}}
+	customSecret := tenant.DeepCopy()
+	customSecret.Spec.CredsSecret = &corev1.LocalObjectReference{Name: "custom"}
+	tests := []struct {
+		name       string
+		tenant     *v1.MinIOInstance
+		data       map[string][]byte
+		getErr     error
+		wantSecret string
+		wantErr    bool
+	}{
+		{
+			name:       "Default secret",
+			tenant:     tenant,
+			data:       map[string][]byte{"accesskey": []byte("ak"), "secretkey": []byte("sk")},
+			wantSecret: "tenant-1-secret",
+		},
+		{
+			name:       "Secret of the tenant spec",
+			tenant:     customSecret,
+			data:       map[string][]byte{"accesskey": []byte("ak"), "secretkey": []byte("sk")},
+			wantSecret: "custom",
+		},
+		{
+			name:       "Missing keys",
+			tenant:     tenant,
+			data:       map[string][]byte{"accesskey": []byte("ak")},
+			wantSecret: "tenant-1-secret",
+			wantErr:    true,
+		},
+		{
+			name:       "Secret not readable",
+			tenant:     tenant,
+			getErr:     errors.New("forbidden"),
+			wantSecret: "tenant-1-secret",
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClientGetSecretMock = func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Secret, error) {
+				if name != tt.wantSecret {
+					t.Errorf("getSecret() name = %v, want %v", name, tt.wantSecret)
+				}
+				if tt.getErr != nil {
+					return nil, tt.getErr
+				}
+				return &corev1.Secret{Data: tt.data}, nil
+			}
+			got, err := getTenantCredentials(ctx, client, tt.tenant)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getTenantCredentials() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && (got.accessKey != "ak" || got.secretKey != "sk") {
+				t.Errorf("getTenantCredentials() = %+v", got)
+			}
+		})
+	}
+}
+
+func Test_tenantTLSConfig(t *testing.T) {
+	ctx := context.Background()
+	client := k8sClientMock{}
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+	serverCert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	defer func(caFile string) { serviceAccountCAFile = caFile }(serviceAccountCAFile)
+	serviceAccountCAFile = "/nonexistent/ca.crt"
+	tenantTLSConfigs = map[string]*tls.Config{}
+	defer func() { tenantTLSConfigs = map[string]*tls.Config{} }()
+
+	tenant := &v1.MinIOInstance{ObjectMeta: metav1.ObjectMeta{Name: "tenant-1", Namespace: "default"}}
+	externalCert := tenant.DeepCopy()
+	externalCert.Spec.ExternalCertSecret = &v1.LocalCertificateReference{Name: "tenant-1-tls", Type: string(corev1.SecretTypeTLS)}
+	tests := []struct {
+		name        string
+		tenant      *v1.MinIOInstance
+		secret      *corev1.Secret
+		wantErr     bool
+		wantTrusted bool
+	}{
+		{
+			name:   "Certificate of the tenant not trusted without external certificate",
+			tenant: tenant,
+		},
+		{
+			name:   "Certificate of a kubernetes.io/tls secret",
+			tenant: externalCert,
+			secret: &corev1.Secret{
+				Type: corev1.SecretTypeTLS,
+				Data: map[string][]byte{corev1.TLSCertKey: serverCert},
+			},
+			wantTrusted: true,
+		},
+		{
+			name:   "CA of a kubernetes.io/tls secret",
+			tenant: externalCert,
+			secret: &corev1.Secret{
+				Type: corev1.SecretTypeTLS,
+				Data: map[string][]byte{"ca.crt": serverCert, corev1.TLSCertKey: []byte("invalid")},
+			},
+			wantTrusted: true,
+		},
+		{
+			name:   "Secret without certificate",
+			tenant: externalCert,
+			secret: &corev1.Secret{
+				Type: corev1.SecretTypeTLS,
+				Data: map[string][]byte{corev1.TLSCertKey: []byte("invalid")},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClientGetSecretMock = func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Secret, error) {
+				if name != "tenant-1-tls" {
+					t.Errorf("getSecret() name = %v, want tenant-1-tls", name)
+				}
+				return tt.secret, nil
+			}
+			tlsConfig, err := tenantTLSConfig(ctx, client, tt.tenant)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("tenantTLSConfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if tlsConfig.InsecureSkipVerify {
+				t.Fatalf("tenantTLSConfig() doesn't verify certificates")
+			}
+			resp, err := (&http.Client{Transport: newTenantTransport(tlsConfig)}).Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if (err == nil) != tt.wantTrusted {
+				t.Errorf("tenantTLSConfig() trusted = %v, want %v (%v)", err == nil, tt.wantTrusted, err)
+			}
+			// the clients of the next requests reuse the transport and its connections
+			again, err := tenantTLSConfig(ctx, client, tt.tenant)
+			if err != nil || again != tlsConfig || newTenantTransport(again) != newTenantTransport(tlsConfig) {
+				t.Errorf("tenantTLSConfig() isn't reused: %v", err)
+			}
+			if transport := newTenantTransport(tlsConfig); transport.ResponseHeaderTimeout == 0 || transport.TLSHandshakeTimeout == 0 {
+				t.Errorf("newTenantTransport() without timeouts: %+v", transport)
+			}
+		})
+	}
+}
@@ -17,8 +17,12 @@
 package restapi
 
 import (
+	"encoding/json"
+	"fmt"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/minio/minio/pkg/env"
 )
@@ -42,6 +46,28 @@ var TLSRedirect = "off"
 // when generating minioInstance request
 var defaultTenantMemorySize = "16Gi"
 
+// defaultIngressDomainTemplate default template of the tenants S3 host
+var defaultIngressDomainTemplate = "{{.Tenant}}.{{.Namespace}}.m3.local"
+
+// defaultIngressConsoleDomainTemplate default template of the tenants console host
+var defaultIngressConsoleDomainTemplate = "console.{{.Domain}}"
+
+// defaultCertManagerSecretTimeout default time to wait for the tenants certificates
+var defaultCertManagerSecretTimeout = 10 * time.Minute
+
+// defaultWebhookTimeout default timeout of every webhook delivery attempt
+var defaultWebhookTimeout = 10 * time.Second
+
+// defaultPresignMaxExpiry default longest time a presigned url is valid
+var defaultPresignMaxExpiry = time.Hour
+
+// defaultJobDeadline default longest time an mc job can run
+var defaultJobDeadline = 6 * time.Hour
+
+// defaultGKECertificateStuckAfter default time a ManagedCertificate can be provisioning,
+// GKE takes up to an hour to provision a certificate
+var defaultGKECertificateStuckAfter = 2 * time.Hour
+
 // GetHostname gets m3 hostname set on env variable,
 // default one or defined on run command
 func GetHostname() string {
@@ -79,3 +105,224 @@ func GetSSLPort() int {
 func getTenantMemorySize() string {
 	return env.Get(M3TenantMemorySize, defaultTenantMemorySize)
 }
+
+// getIngressEnabled returns true if the tenants are exposed
+// through an Ingress
+func getIngressEnabled() bool {
+	return strings.ToLower(env.Get(M3Ingress, "off")) == "on"
+}
+
+// getIngressClass ingress class to be set on the
+// tenants Ingresses
+func getIngressClass() string {
+	return env.Get(M3IngressClass, "")
+}
+
+// getIngressDomainTemplate template used to build the
+// S3 host of every tenant
+func getIngressDomainTemplate() string {
+	return env.Get(M3IngressDomainTemplate, defaultIngressDomainTemplate)
+}
+
+// getIngressConsoleDomainTemplate template used to build the
+// console host of every tenant
+func getIngressConsoleDomainTemplate() string {
+	return env.Get(M3IngressConsoleDomainTemplate, defaultIngressConsoleDomainTemplate)
+}
+
+// getIngressTLSSecret name of the secret with the certificate
+// used to serve the tenants hosts
+func getIngressTLSSecret() string {
+	return env.Get(M3IngressTLSSecret, "")
+}
+
+// getIngressAnnotations annotations added to the tenants
+// Ingresses, they are set as a JSON object
+func getIngressAnnotations() (map[string]string, error) {
+	return getAnnotations(M3IngressAnnotations)
+}
+
+// getLoadBalancerDomainTemplate template used to build the S3
+// hostname annotated on the tenants LoadBalancer services
+func getLoadBalancerDomainTemplate() string {
+	return env.Get(M3LoadBalancerDomainTemplate, "")
+}
+
+// getLoadBalancerConsoleDomainTemplate template used to build the
+// console hostname annotated on the tenants LoadBalancer services
+func getLoadBalancerConsoleDomainTemplate() string {
+	return env.Get(M3LoadBalancerConsoleDomainTemplate, defaultIngressConsoleDomainTemplate)
+}
+
+// getLoadBalancerAnnotations annotations added to the tenants
+// LoadBalancer services, they are set as a JSON object
+func getLoadBalancerAnnotations() (map[string]string, error) {
+	return getAnnotations(M3LoadBalancerAnnotations)
+}
+
+// getGatewayName name of the Gateway the tenants
+// HTTPRoutes are attached to
+func getGatewayName() string {
+	return env.Get(M3GatewayName, "")
+}
+
+// getGatewayNamespace namespace of the Gateway the
+// tenants HTTPRoutes are attached to
+func getGatewayNamespace() string {
+	return env.Get(M3GatewayNamespace, "")
+}
+
+// getGatewaySectionName listener of the Gateway the
+// tenants HTTPRoutes are attached to
+func getGatewaySectionName() string {
+	return env.Get(M3GatewaySectionName, "")
+}
+
+// getGatewayDomainTemplate template used to build the
+// S3 hostname of every tenant
+func getGatewayDomainTemplate() string {
+	return env.Get(M3GatewayDomainTemplate, defaultIngressDomainTemplate)
+}
+
+// getGatewayConsoleDomainTemplate template used to build the
+// console hostname of every tenant
+func getGatewayConsoleDomainTemplate() string {
+	return env.Get(M3GatewayConsoleDomainTemplate, defaultIngressConsoleDomainTemplate)
+}
+
+// getGatewayTLS returns true if the listener of the
+// Gateway serves the tenants over https
+func getGatewayTLS() bool {
+	return strings.ToLower(env.Get(M3GatewayTLS, "on")) == "on"
+}
+
+// getAnnotations parses the JSON object of annotations
+// set on the env variable
+func getAnnotations(name string) (map[string]string, error) {
+	annotations := make(map[string]string)
+	value := strings.TrimSpace(env.Get(name, ""))
+	if value == "" {
+		return annotations, nil
+	}
+	if err := json.Unmarshal([]byte(value), &annotations); err != nil {
+		return nil, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return annotations, nil
+}
+
+// getCertManagerIssuer name of the cert-manager issuer
+// signing the tenants certificates
+func getCertManagerIssuer() string {
+	return env.Get(M3CertManagerIssuer, "")
+}
+
+// getCertManagerIssuerKind kind of the cert-manager issuer
+// signing the tenants certificates
+func getCertManagerIssuerKind() string {
+	return env.Get(M3CertManagerIssuerKind, "ClusterIssuer")
+}
+
+// getCertManagerSecretTimeout time to wait for cert-manager
+// to store the certificate of a new tenant
+func getCertManagerSecretTimeout() (time.Duration, error) {
+	return getDuration(M3CertManagerSecretTimeout, defaultCertManagerSecretTimeout)
+}
+
+// getGKECertificateStuckAfter time a ManagedCertificate can be
+// provisioning before it's reported as stuck
+func getGKECertificateStuckAfter() (time.Duration, error) {
+	return getDuration(M3GKECertificateStuckAfter, defaultGKECertificateStuckAfter)
+}
+
+// getGKEDomainTemplate template used to build the S3
+// host of the tenants exposed on the shared GKE Ingress
+func getGKEDomainTemplate() string {
+	return env.Get(M3GKEDomainTemplate, defaultIngressDomainTemplate)
+}
+
+// getGKEConsoleDomainTemplate template used to build the
+// console host of the tenants exposed on the shared GKE Ingress
+func getGKEConsoleDomainTemplate() string {
+	return env.Get(M3GKEConsoleDomainTemplate, defaultIngressConsoleDomainTemplate)
+}
+
+// getDuration parses the duration set on the env variable
+// or returns the default one
+func getDuration(name string, defaultValue time.Duration) (time.Duration, error) {
+	value := env.Get(name, "")
+	if value == "" {
+		return defaultValue, nil
+	}
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid %s: %v", name, err)
+	}
+	return duration, nil
+}
+
+// getWebhooksSecret name of the secret where the
+// webhooks are stored
+func getWebhooksSecret() string {
+	return env.Get(M3WebhooksSecret, "m3-webhooks")
+}
+
+// getWebhookMaxAttempts number of times a webhook
+// delivery is attempted
+func getWebhookMaxAttempts() (int, error) {
+	attempts, err := strconv.Atoi(env.Get(M3WebhookMaxAttempts, "5"))
+	if err != nil || attempts < 1 {
+		return 0, fmt.Errorf("invalid %s, it must be a positive number", M3WebhookMaxAttempts)
+	}
+	return attempts, nil
+}
+
+// getWebhookTimeout timeout of every webhook
+// delivery attempt
+func getWebhookTimeout() (time.Duration, error) {
+	return getDuration(M3WebhookTimeout, defaultWebhookTimeout)
+}
+
+// getWebhookQuotaThresholds percentages of the resource quotas
+// usage notified to the webhooks, in increasing order
+func getWebhookQuotaThresholds() ([]int, error) {
+	var thresholds []int
+	for _, value := range strings.Split(env.Get(M3WebhookQuotaThresholds, "80,90,100"), ",") {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		threshold, err := strconv.Atoi(value)
+		if err != nil || threshold <= 0 {
+			return nil, fmt.Errorf("invalid %s, %q is not a percentage", M3WebhookQuotaThresholds, value)
+		}
+		thresholds = append(thresholds, threshold)
+	}
+	sort.Ints(thresholds)
+	return thresholds, nil
+}
+
+// getPresignMaxExpiry longest time a presigned url
+// is valid, S3 doesn't accept urls valid for more than a week
+func getPresignMaxExpiry() (time.Duration, error) {
+	expiry, err := getDuration(M3PresignMaxExpiry, defaultPresignMaxExpiry)
+	if err != nil {
+		return 0, err
+	}
+	if expiry < time.Second || expiry > 7*24*time.Hour {
+		return 0, fmt.Errorf("invalid %s, it must be between 1s and 168h", M3PresignMaxExpiry)
+	}
+	return expiry, nil
+}
+
+// getJobDeadline longest time an mc job
+// can run before it's stopped
+func getJobDeadline() (time.Duration, error) {
+	deadline, err := getDuration(M3JobDeadline, defaultJobDeadline)
+	if err != nil {
+		return 0, err
+	}
+	if deadline < time.Second {
+		return 0, fmt.Errorf("invalid %s, it must be at least 1s", M3JobDeadline)
+	}
+	return deadline, nil
+}
@@ -20,13 +20,20 @@
 package restapi
 
 import (
+	"context"
 	"crypto/tls"
 	"net/http"
+	"time"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
+	"github.com/minio/m3/cluster"
 	"github.com/minio/m3/models"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/pkg/tracing"
 	"github.com/minio/m3/restapi/operations"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 )
 
 //go:generate swagger generate server --target ../../m3 --name M3 --spec ../swagger.yml --principal models.Principal --exclude-main
@@ -39,11 +46,8 @@ func configureAPI(api *operations.M3API) http.Handler {
 	// configure the api here
 	api.ServeError = errors.ServeError
 
-	// Set your custom logger if needed. Default one is log.Printf
-	// Expected interface func(string, ...interface{})
-	//
-	// Example:
-	// api.Logger = log.Printf
+	// structured logger configured through M3_LOG_LEVEL and M3_LOG_FORMAT
+	api.Logger = logger.Printf
 
 	api.JSONConsumer = runtime.JSONConsumer()
 
@@ -63,10 +67,75 @@ func configureAPI(api *operations.M3API) http.Handler {
 	registerTenantHandlers(api)
 	// Register ResourceQuota handlers
 	registerResourceQuotaHandlers(api)
+	// Register ManagedCertificate handlers
+	registerManagedCertificateHandlers(api)
+	// Register Webhook handlers
+	registerWebhookHandlers(api)
+	// Register Bucket handlers
+	registerBucketHandlers(api)
+	// Register Bucket Quota handlers
+	registerBucketQuotaHandlers(api)
+	// Register Bucket Lifecycle handlers
+	registerBucketLifecycleHandlers(api)
+	// Register Bucket Replication handlers
+	registerBucketReplicationHandlers(api)
+	// Register Notification handlers
+	registerNotificationHandlers(api)
+	// Register Object handlers
+	registerObjectHandlers(api)
+	// Register Job handlers
+	registerJobHandlers(api)
+	// Register IAM handlers
+	registerIAMHandlers(api)
+	// Register Tenant Server handlers
+	registerTenantServerHandlers(api)
+	// Register Usage handlers
+	registerUsageHandlers(api)
+
+	// tracing is a no-op unless an OTLP endpoint is configured through OTEL_EXPORTER_OTLP_ENDPOINT
+	shutdownTracing, err := tracing.Setup(context.Background())
+	if err != nil {
+		logger.Log.WithError(err).Error("error configuring tracing, spans won't be exported")
+		shutdownTracing = func(context.Context) error { return nil }
+	}
+
+	// integrations and webhooks run in the background and get notified whenever the operator changes the state
+	// of a tenant
+	integrationsStopCh := make(chan struct{})
+	integrations, err := getEnabledIntegrations()
+	if err != nil {
+		logger.Log.WithError(err).Error("error configuring integrations")
+	}
+	if len(integrations) > 0 {
+		if err := startIntegrations(integrations, integrationsStopCh); err != nil {
+			logger.Log.WithError(err).Error("error starting integrations")
+		}
+	}
+	webhooks := getWebhookDispatcher()
+	err = watchTenantStates(integrationsStopCh, func(ctx context.Context, tenant *operator.MinIOInstance, previousState string) {
+		req := &IntegrationRequest{Tenant: tenant, Token: cluster.GetServiceAccountToken()}
+		runTenantStatusChangedHooks(ctx, integrations, req, previousState)
+		webhooks.tenantStateChanged(ctx, tenant, previousState)
+	})
+	if err != nil {
+		logger.Log.WithError(err).Error("error watching tenants")
+	}
+	if thresholds, err := getWebhookQuotaThresholds(); err != nil {
+		logger.Log.WithError(err).Error("error configuring quota thresholds, quota.threshold events won't be delivered")
+	} else if err := watchQuotaThresholds(webhooks, thresholds, integrationsStopCh); err != nil {
+		logger.Log.WithError(err).Error("error watching resource quotas")
+	}
 
 	api.PreServerShutdown = func() {}
 
-	api.ServerShutdown = func() {}
+	api.ServerShutdown = func() {
+		close(integrationsStopCh)
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := shutdownTracing(ctx); err != nil {
+			logger.Log.WithError(err).Error("error flushing spans")
+		}
+	}
 
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
 }
@@ -86,11 +155,25 @@ func configureServer(s *http.Server, scheme, addr string) {
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
 // The middleware executes after routing but before authentication, binding and validation
 func setupMiddlewares(handler http.Handler) http.Handler {
-	return handler
+	return traceRequest(logRequest(instrumentHandler(handler)))
 }
 
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
 // So this is a good place to plug in a panic handling middleware, logging and metrics
 func setupGlobalMiddleware(handler http.Handler) http.Handler {
-	return handler
+	metricsHandler := promhttp.Handler()
+	return requestIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case metricsPath:
+			metricsHandler.ServeHTTP(w, r)
+			return
+		case livenessPath:
+			livenessHandler(w, r)
+			return
+		case readinessPath:
+			readinessHandler(w, r)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}))
 }
@@ -25,4 +25,59 @@ const (
 	M3TLSPort     = "M3_TLS_PORT"
 	// M3TenantMemorySize Memory size to be used when creating MinioInstance request
 	M3TenantMemorySize = "M3_TENANT_MEMORY_SIZE"
+	// M3Ingress when on every tenant is exposed through a networking.k8s.io/v1 Ingress
+	M3Ingress = "M3_INGRESS"
+	// M3IngressClass ingress class set on the tenants Ingresses, the cluster default is used when empty
+	M3IngressClass = "M3_INGRESS_CLASS"
+	// M3IngressDomainTemplate go template of the S3 host of the tenants, ie: {{.Tenant}}.{{.Namespace}}.example.com
+	M3IngressDomainTemplate = "M3_INGRESS_DOMAIN_TEMPLATE"
+	// M3IngressConsoleDomainTemplate go template of the console host of the tenants, {{.Domain}} is the S3 host
+	M3IngressConsoleDomainTemplate = "M3_INGRESS_CONSOLE_DOMAIN_TEMPLATE"
+	// M3IngressTLSSecret secret, on the tenant namespace, with the certificate used to serve the tenant hosts
+	M3IngressTLSSecret = "M3_INGRESS_TLS_SECRET"
+	// M3IngressAnnotations JSON object with the annotations added to the tenants Ingresses
+	M3IngressAnnotations = "M3_INGRESS_ANNOTATIONS"
+	// M3CertManagerIssuer name of the cert-manager issuer signing the tenants certificates
+	M3CertManagerIssuer = "M3_CERT_MANAGER_ISSUER"
+	// M3CertManagerIssuerKind kind of the cert-manager issuer, Issuer or ClusterIssuer
+	M3CertManagerIssuerKind = "M3_CERT_MANAGER_ISSUER_KIND"
+	// M3CertManagerSecretTimeout how long to wait for cert-manager to store the tenant certificate, ie: 10m
+	M3CertManagerSecretTimeout = "M3_CERT_MANAGER_SECRET_TIMEOUT"
+	// M3GKECertificateStuckAfter how long a GKE ManagedCertificate can be provisioning before it's reported as stuck
+	M3GKECertificateStuckAfter = "M3_GKE_CERTIFICATE_STUCK_AFTER"
+	// M3GKEDomainTemplate go template of the S3 host of the tenants exposed on the shared GKE Ingress
+	M3GKEDomainTemplate = "M3_GKE_DOMAIN_TEMPLATE"
+	// M3GKEConsoleDomainTemplate go template of the console host, {{.Domain}} is the S3 host
+	M3GKEConsoleDomainTemplate = "M3_GKE_CONSOLE_DOMAIN_TEMPLATE"
+	// M3WebhooksSecret secret, on m3's namespace, where the webhooks are stored
+	M3WebhooksSecret = "M3_WEBHOOKS_SECRET"
+	// M3WebhookMaxAttempts number of times a webhook delivery is attempted before giving up
+	M3WebhookMaxAttempts = "M3_WEBHOOK_MAX_ATTEMPTS"
+	// M3WebhookTimeout timeout of every webhook delivery attempt, ie: 10s
+	M3WebhookTimeout = "M3_WEBHOOK_TIMEOUT"
+	// M3WebhookQuotaThresholds comma separated percentages of a resource quota that trigger a quota.threshold event
+	M3WebhookQuotaThresholds = "M3_WEBHOOK_QUOTA_THRESHOLDS"
+	// M3PresignMaxExpiry longest time a presigned url of an object is valid, ie: 1h
+	M3PresignMaxExpiry = "M3_PRESIGN_MAX_EXPIRY"
+	// M3JobDeadline longest time an mc job can run against a tenant before it's stopped, ie: 6h
+	M3JobDeadline = "M3_JOB_DEADLINE"
+	// M3LoadBalancerDomainTemplate go template of the S3 hostname annotated for external-dns on the tenants
+	// LoadBalancer services, no hostname is annotated when empty
+	M3LoadBalancerDomainTemplate = "M3_LOAD_BALANCER_DOMAIN_TEMPLATE"
+	// M3LoadBalancerConsoleDomainTemplate go template of the console hostname, {{.Domain}} is the S3 hostname
+	M3LoadBalancerConsoleDomainTemplate = "M3_LOAD_BALANCER_CONSOLE_DOMAIN_TEMPLATE"
+	// M3LoadBalancerAnnotations JSON object with the annotations added to the tenants LoadBalancer services
+	M3LoadBalancerAnnotations = "M3_LOAD_BALANCER_ANNOTATIONS"
+	// M3GatewayName name of the Gateway the tenants HTTPRoutes are attached to
+	M3GatewayName = "M3_GATEWAY_NAME"
+	// M3GatewayNamespace namespace of the Gateway, the namespace of the tenant when empty
+	M3GatewayNamespace = "M3_GATEWAY_NAMESPACE"
+	// M3GatewaySectionName listener of the Gateway the HTTPRoutes are attached to, every listener when empty
+	M3GatewaySectionName = "M3_GATEWAY_SECTION_NAME"
+	// M3GatewayDomainTemplate go template of the S3 hostname of the tenants HTTPRoutes
+	M3GatewayDomainTemplate = "M3_GATEWAY_DOMAIN_TEMPLATE"
+	// M3GatewayConsoleDomainTemplate go template of the console hostname, {{.Domain}} is the S3 hostname
+	M3GatewayConsoleDomainTemplate = "M3_GATEWAY_CONSOLE_DOMAIN_TEMPLATE"
+	// M3GatewayTLS whether the listener of the Gateway terminates tls, on by default
+	M3GatewayTLS = "M3_GATEWAY_TLS"
 )
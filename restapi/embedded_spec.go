@@ -51,6 +51,37 @@ func init() {
   },
   "basePath": "/api/v1",
   "paths": {
+    "/managed-certificates": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the GKE ManagedCertificates created by m3 for the tenants",
+        "operationId": "ListManagedCertificates",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "only return the certificates stuck provisioning",
+            "name": "stuck",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listManagedCertificatesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/namespaces/{namespace}/resourcequotas/{resource-quota-name}": {
       "get": {
         "tags": [
@@ -233,8 +264,11 @@ func init() {
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/deleteTenantResponse"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -245,37 +279,32 @@ func init() {
         }
       }
     },
-    "/tenants": {
+    "/namespaces/{namespace}/tenants/{tenant}/buckets": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "List Tenant of All Namespaces",
-        "operationId": "ListAllTenants",
+        "summary": "List Buckets of a Tenant",
+        "operationId": "ListTenantBuckets",
         "parameters": [
           {
             "type": "string",
-            "name": "sort_by",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
+            "name": "namespace",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listTenantsResponse"
+              "$ref": "#/definitions/listBucketsResponse"
             }
           },
           "default": {
@@ -290,23 +319,35 @@ func init() {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Create Tenant",
-        "operationId": "CreateTenant",
+        "summary": "Create Bucket on a Tenant",
+        "operationId": "CreateTenantBucket",
         "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createTenantRequest"
+              "$ref": "#/definitions/createBucketRequest"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/createTenantResponse"
+              "$ref": "#/definitions/bucket"
             }
           },
           "default": {
@@ -317,280 +358,95 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "createTenantRequest": {
-      "type": "object",
-      "required": [
-        "name",
-        "volume_configuration",
-        "namespace"
-      ],
-      "properties": {
-        "access_key": {
-          "type": "string"
-        },
-        "annotations": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Bucket Info",
+        "operationId": "TenantBucketInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
           }
-        },
-        "enable_mcs": {
-          "type": "boolean",
-          "default": true
-        },
-        "enable_ssl": {
-          "type": "boolean",
-          "default": true
-        },
-        "image": {
-          "type": "string"
-        },
-        "mounth_path": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string",
-          "pattern": "^[a-z0-9-]{3,63}$"
-        },
-        "namespace": {
-          "type": "string"
-        },
-        "secret_key": {
-          "type": "string"
-        },
-        "service_name": {
-          "type": "string"
-        },
-        "volume_configuration": {
-          "type": "object",
-          "required": [
-            "size"
-          ],
-          "properties": {
-            "size": {
-              "type": "string"
-            },
-            "storage_class": {
-              "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
           }
-        },
-        "volumes_per_server": {
-          "type": "integer"
-        },
-        "zones": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/zone"
-          }
-        }
-      }
-    },
-    "createTenantResponse": {
-      "type": "object",
-      "properties": {
-        "access_key": {
-          "type": "string"
-        },
-        "secret_key": {
-          "type": "string"
-        }
-      }
-    },
-    "error": {
-      "type": "object",
-      "required": [
-        "message"
-      ],
-      "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "message": {
-          "type": "string"
         }
-      }
-    },
-    "listTenantsResponse": {
-      "type": "object",
-      "properties": {
-        "tenants": {
-          "type": "array",
-          "title": "list of resulting tenants",
-          "items": {
-            "$ref": "#/definitions/tenantList"
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete Bucket, it must be empty",
+        "operationId": "DeleteTenantBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "total": {
-          "type": "integer",
-          "format": "int64",
-          "title": "number of tenants accessible to tenant user"
         }
       }
     },
-    "principal": {
-      "type": "string"
-    },
-    "resourceQuota": {
-      "type": "object",
-      "properties": {
-        "elements": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/resourceQuotaElement"
-          }
-        },
-        "name": {
-          "type": "string"
-        }
-      }
-    },
-    "resourceQuotaElement": {
-      "type": "object",
-      "properties": {
-        "hard": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "name": {
-          "type": "string"
-        },
-        "used": {
-          "type": "integer",
-          "format": "int64"
-        }
-      }
-    },
-    "tenant": {
-      "type": "object",
-      "properties": {
-        "creation_date": {
-          "type": "string"
-        },
-        "currentState": {
-          "type": "string"
-        },
-        "instance_count": {
-          "type": "integer"
-        },
-        "name": {
-          "type": "string"
-        },
-        "namespace": {
-          "type": "string"
-        },
-        "volume_count": {
-          "type": "integer"
-        },
-        "volume_size": {
-          "type": "integer"
-        },
-        "volumes_per_server": {
-          "type": "integer"
-        },
-        "zone_count": {
-          "type": "integer"
-        },
-        "zones": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/zone"
-          }
-        }
-      }
-    },
-    "tenantList": {
-      "type": "object",
-      "properties": {
-        "creation_date": {
-          "type": "string"
-        },
-        "currentState": {
-          "type": "string"
-        },
-        "instance_count": {
-          "type": "integer"
-        },
-        "name": {
-          "type": "string"
-        },
-        "namespace": {
-          "type": "string"
-        },
-        "volume_count": {
-          "type": "integer"
-        },
-        "volume_size": {
-          "type": "integer"
-        },
-        "zone_count": {
-          "type": "integer"
-        }
-      }
-    },
-    "updateTenantRequest": {
-      "type": "object",
-      "properties": {
-        "image": {
-          "type": "string",
-          "pattern": "^((.*?)/(.*?):(.+))$"
-        }
-      }
-    },
-    "zone": {
-      "type": "object",
-      "properties": {
-        "name": {
-          "type": "string"
-        },
-        "servers": {
-          "type": "integer"
-        }
-      }
-    }
-  },
-  "securityDefinitions": {
-    "key": {
-      "type": "oauth2",
-      "flow": "accessCode",
-      "authorizationUrl": "http://min.io",
-      "tokenUrl": "http://min.io"
-    }
-  },
-  "security": [
-    {
-      "key": []
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "MinIO For Kubernetes",
-    "version": "0.1.0"
-  },
-  "basePath": "/api/v1",
-  "paths": {
-    "/namespaces/{namespace}/resourcequotas/{resource-quota-name}": {
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/lifecycle": {
       "get": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Get Resource Quota",
-        "operationId": "GetResourceQuota",
+        "summary": "Lifecycle rules of a Bucket",
+        "operationId": "GetTenantBucketLifecycle",
         "parameters": [
           {
             "type": "string",
@@ -600,7 +456,13 @@ func init() {
           },
           {
             "type": "string",
-            "name": "resource-quota-name",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
             "in": "path",
             "required": true
           }
@@ -609,7 +471,7 @@ func init() {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/resourceQuota"
+              "$ref": "#/definitions/bucketLifecycle"
             }
           },
           "default": {
@@ -619,15 +481,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/namespaces/{namespace}/tenants": {
-      "get": {
+      },
+      "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "List Tenants by Namespace",
-        "operationId": "ListTenants",
+        "summary": "Replace the lifecycle rules of a Bucket",
+        "operationId": "SetTenantBucketLifecycle",
         "parameters": [
           {
             "type": "string",
@@ -637,27 +497,30 @@ func init() {
           },
           {
             "type": "string",
-            "name": "sort_by",
-            "in": "query"
+            "name": "tenant",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycle"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listTenantsResponse"
+              "$ref": "#/definitions/bucketLifecycle"
             }
           },
           "default": {
@@ -667,15 +530,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/namespaces/{namespace}/tenants/{tenant}": {
-      "get": {
+      },
+      "delete": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Tenant Info",
-        "operationId": "TenantInfo",
+        "summary": "Remove all the lifecycle rules of a Bucket",
+        "operationId": "DeleteTenantBucketLifecycle",
         "parameters": [
           {
             "type": "string",
@@ -688,14 +549,17 @@ func init() {
             "name": "tenant",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "A successful response.",
-            "schema": {
-              "$ref": "#/definitions/tenant"
-            }
+          "204": {
+            "description": "A successful response."
           },
           "default": {
             "description": "Generic error response.",
@@ -704,13 +568,15 @@ func init() {
             }
           }
         }
-      },
-      "put": {
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/lifecycle/rules": {
+      "post": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Update Tenant",
-        "operationId": "UpdateTenant",
+        "summary": "Add a lifecycle rule to a Bucket",
+        "operationId": "AddTenantBucketLifecycleRule",
         "parameters": [
           {
             "type": "string",
@@ -724,20 +590,26 @@ func init() {
             "in": "path",
             "required": true
           },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/updateTenantRequest"
+              "$ref": "#/definitions/lifecycleRule"
             }
           }
         ],
         "responses": {
-          "200": {
+          "201": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/createTenantResponse"
+              "$ref": "#/definitions/bucketLifecycle"
             }
           },
           "default": {
@@ -747,13 +619,15 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/lifecycle/rules/{rule}": {
+      "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Delete Tenant",
-        "operationId": "DeleteTenant",
+        "summary": "Replace a lifecycle rule of a Bucket",
+        "operationId": "UpdateTenantBucketLifecycleRule",
         "parameters": [
           {
             "type": "string",
@@ -766,11 +640,34 @@ func init() {
             "name": "tenant",
             "in": "path",
             "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/lifecycleRule"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "A successful response."
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycle"
+            }
           },
           "default": {
             "description": "Generic error response.",
@@ -779,39 +676,84 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/tenants": {
-      "get": {
+      },
+      "delete": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "List Tenant of All Namespaces",
-        "operationId": "ListAllTenants",
+        "summary": "Remove a lifecycle rule of a Bucket",
+        "operationId": "DeleteTenantBucketLifecycleRule",
         "parameters": [
           {
             "type": "string",
-            "name": "sort_by",
-            "in": "query"
+            "name": "namespace",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "offset",
-            "in": "query"
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
           },
           {
-            "type": "integer",
-            "format": "int32",
-            "name": "limit",
-            "in": "query"
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/notifications": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Event notification rules of a Bucket",
+        "operationId": "GetTenantBucketNotifications",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "A successful response.",
             "schema": {
-              "$ref": "#/definitions/listTenantsResponse"
+              "$ref": "#/definitions/bucketNotifications"
             }
           },
           "default": {
@@ -822,24 +764,83 @@ func init() {
           }
         }
       },
-      "post": {
+      "put": {
         "tags": [
           "AdminAPI"
         ],
-        "summary": "Create Tenant",
-        "operationId": "CreateTenant",
+        "summary": "Replace the event notification rules of a Bucket",
+        "operationId": "SetTenantBucketNotifications",
         "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createTenantRequest"
+              "$ref": "#/definitions/bucketNotifications"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketNotifications"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove the event notification rules of a Bucket",
+        "operationId": "DeleteTenantBucketNotifications",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
             "description": "A successful response."
           },
           "default": {
@@ -850,241 +851,9069 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "CreateTenantRequestVolumeConfiguration": {
-      "type": "object",
-      "required": [
-        "size"
-      ],
-      "properties": {
-        "size": {
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/object-lock": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Set the default retention of a Bucket created with object lock",
+        "operationId": "SetTenantBucketObjectLock",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketRetention"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/objects": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the objects of a Bucket",
+        "operationId": "ListTenantBucketObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "list every object under the prefix instead of grouping them by folder",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "next_continuation_token of the previous page",
+            "name": "continuation_token",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketObjectsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/objects/metadata": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Metadata of an Object",
+        "operationId": "TenantBucketObjectInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "object",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketObject"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/objects/presign": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Generate a presigned URL to download or upload an Object",
+        "operationId": "PresignTenantBucketObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/presignObjectRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/presignedObjectURL"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/quota": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Quota of a Bucket",
+        "operationId": "GetTenantBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketQuota"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Set the Quota of a Bucket",
+        "operationId": "SetTenantBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketQuota"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setBucketQuotaResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Clear the Quota of a Bucket",
+        "operationId": "ClearTenantBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/replication": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Replication status of a Bucket",
+        "operationId": "GetTenantBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplication"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Replicate a Bucket to a Bucket of another Tenant",
+        "operationId": "SetTenantBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplication"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove the replication of a Bucket",
+        "operationId": "DeleteTenantBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/versioning": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Enable or suspend the versioning of a Bucket",
+        "operationId": "SetTenantBucketVersioning",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketVersioningRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/groups": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List IAM Groups of a Tenant",
+        "operationId": "ListTenantGroups",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listGroupsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Create IAM Group on a Tenant",
+        "operationId": "CreateTenantGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createGroupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/groups/{group}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "IAM Group Info",
+        "operationId": "TenantGroupInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "group",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove IAM Group, its members are removed from it",
+        "operationId": "RemoveTenantGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "group",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/groups/{group}/members": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add or remove members of an IAM Group",
+        "operationId": "UpdateTenantGroupMembers",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "group",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateGroupMembersRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/groups/{group}/policy": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Attach a Policy to an IAM Group",
+        "operationId": "SetTenantGroupPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "group",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/groups/{group}/status": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Enable or disable an IAM Group",
+        "operationId": "SetTenantGroupStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "group",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setAccountStatusRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/jobs": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the mc Jobs run against a Tenant",
+        "operationId": "ListTenantJobs",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listTenantJobsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Run an mc command against a Tenant as a Kubernetes Job",
+        "operationId": "CreateTenantJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/tenantJobRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenantJob"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/jobs/{job}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Status of an mc Job",
+        "operationId": "TenantJobInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "job",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenantJob"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove an mc Job, it's stopped if still running",
+        "operationId": "DeleteTenantJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "job",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/jobs/{job}/logs": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Output of an mc Job",
+        "operationId": "TenantJobLogs",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "job",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "only return the last lines of the output",
+            "name": "tail_lines",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenantJobLogs"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/notification-targets": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the notification targets of a Tenant",
+        "operationId": "ListTenantNotificationTargets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listNotificationTargetsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add a notification target to a Tenant",
+        "operationId": "CreateTenantNotificationTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "restart the tenant so it loads the change of its notification targets",
+            "name": "restart",
+            "in": "query"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationTarget"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/notificationTargetResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/notification-targets/{type}/{id}": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update a notification target of a Tenant",
+        "operationId": "UpdateTenantNotificationTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "restart the tenant so it loads the change of its notification targets",
+            "name": "restart",
+            "in": "query"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationTarget"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/notificationTargetResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove a notification target of a Tenant",
+        "operationId": "DeleteTenantNotificationTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "restart the tenant so it loads the change of its notification targets",
+            "name": "restart",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/policies": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List canned and custom Policies of a Tenant",
+        "operationId": "ListTenantPolicies",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPoliciesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add custom Policy to a Tenant",
+        "operationId": "AddTenantPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/policies/{policy}": {
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove Policy",
+        "operationId": "RemoveTenantPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "policy",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/quotas": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Quotas of the Buckets of a Tenant compared with its usable capacity",
+        "operationId": "ListTenantBucketQuotas",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenantBucketQuotas"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/servers": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Servers and drives of a Tenant as reported by MinIO",
+        "operationId": "TenantServerInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenantServerInfo"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/service-accounts": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Service Accounts of the root user of a Tenant",
+        "operationId": "ListTenantServiceAccounts",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listServiceAccountsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Create Service Account for the root user of a Tenant",
+        "operationId": "CreateTenantServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createServiceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCredentials"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/service-accounts/{access_key}": {
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete Service Account",
+        "operationId": "DeleteTenantServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/usage": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Data usage of a Tenant and its Buckets",
+        "operationId": "TenantUsage",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenantUsage"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/users": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List IAM Users of a Tenant",
+        "operationId": "ListTenantUsers",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listUsersResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add IAM User to a Tenant",
+        "operationId": "AddTenantUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addUserRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/users/{user}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "IAM User Info",
+        "operationId": "TenantUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "user",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove IAM User",
+        "operationId": "RemoveTenantUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "user",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/users/{user}/policy": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Attach a Policy to an IAM User",
+        "operationId": "SetTenantUserPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "user",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/users/{user}/status": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Enable or disable an IAM User",
+        "operationId": "SetTenantUserStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "user",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setAccountStatusRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/tenants": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Tenant of All Namespaces",
+        "operationId": "ListAllTenants",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listTenantsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Create Tenant",
+        "operationId": "CreateTenant",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createTenantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/createTenantResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/usage": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Data usage of all the Tenants the user has access to",
+        "operationId": "ClusterUsage",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/clusterUsage"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the webhooks notified of the tenants lifecycle events",
+        "operationId": "ListWebhooks",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listWebhooksResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Register a webhook, the signing secret is only returned here",
+        "operationId": "CreateWebhook",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/deliveries": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the latest webhook deliveries, newest first",
+        "operationId": "ListWebhookDeliveries",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "webhook",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listWebhookDeliveriesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{name}": {
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete a webhook",
+        "operationId": "DeleteWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "addUserRequest": {
+      "type": "object",
+      "required": [
+        "access_key",
+        "secret_key"
+      ],
+      "properties": {
+        "access_key": {
+          "type": "string",
+          "minLength": 3
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policy": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string",
+          "minLength": 8
+        }
+      }
+    },
+    "bucket": {
+      "type": "object",
+      "properties": {
+        "creation_date": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "type": "string"
+        },
+        "object_lock": {
+          "type": "boolean"
+        },
+        "retention": {
+          "$ref": "#/definitions/bucketRetention"
+        },
+        "versioning": {
+          "description": "Enabled or Suspended, empty if versioning was never enabled",
+          "type": "string"
+        },
+        "warnings": {
+          "description": "set when the bucket is created with a quota and the quotas of the buckets exceed the usable capacity of the tenant",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketLifecycle": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "maxItems": 1000,
+          "items": {
+            "$ref": "#/definitions/lifecycleRule"
+          }
+        }
+      }
+    },
+    "bucketNotifications": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/notificationRule"
+          }
+        }
+      }
+    },
+    "bucketObject": {
+      "type": "object",
+      "properties": {
+        "content_type": {
+          "type": "string"
+        },
+        "etag": {
+          "type": "string"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "metadata": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "storage_class": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketQuota": {
+      "type": "object",
+      "required": [
+        "quota"
+      ],
+      "properties": {
+        "bucket": {
+          "type": "string",
+          "readOnly": true
+        },
+        "quota": {
+          "description": "quota in bytes",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "type": {
+          "type": "string",
+          "default": "hard",
+          "enum": [
+            "hard",
+            "fifo"
+          ]
+        }
+      }
+    },
+    "bucketReplication": {
+      "type": "object",
+      "properties": {
+        "backlog": {
+          "$ref": "#/definitions/replicationBacklog"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/replicationTarget"
+          }
+        }
+      }
+    },
+    "bucketReplicationRequest": {
+      "type": "object",
+      "required": [
+        "target_namespace",
+        "target_tenant"
+      ],
+      "properties": {
+        "replicate_deletes": {
+          "description": "replicate delete markers and the deletion of versions",
+          "type": "boolean"
+        },
+        "target_bucket": {
+          "description": "bucket of the target tenant, the name of the source bucket when it's not set, it's created when it doesn't exist",
+          "type": "string"
+        },
+        "target_namespace": {
+          "type": "string"
+        },
+        "target_tenant": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketRetention": {
+      "description": "default retention of the objects of a bucket with object lock, empty to clear it",
+      "type": "object",
+      "properties": {
+        "mode": {
+          "type": "string",
+          "enum": [
+            "GOVERNANCE",
+            "COMPLIANCE"
+          ]
+        },
+        "unit": {
+          "type": "string",
+          "enum": [
+            "DAYS",
+            "YEARS"
+          ]
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
+        }
+      }
+    },
+    "bucketUsage": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "certificateStatus": {
+      "type": "object",
+      "properties": {
+        "dns_names": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "domains": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/domainCertificateStatus"
+          }
+        },
+        "issuer": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "not_after": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "not_before": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "ready": {
+          "type": "boolean"
+        },
+        "renewal_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "secret": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "clusterUsage": {
+      "type": "object",
+      "properties": {
+        "buckets_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failed_tenants": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tenantUsage"
+          }
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "createBucketRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "location": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string",
+          "pattern": "^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$"
+        },
+        "object_lock": {
+          "description": "object lock can only be enabled when the bucket is created, it enables versioning",
+          "type": "boolean"
+        },
+        "quota": {
+          "$ref": "#/definitions/bucketQuota"
+        },
+        "retention": {
+          "$ref": "#/definitions/bucketRetention"
+        },
+        "versioning": {
+          "type": "boolean"
+        }
+      }
+    },
+    "createGroupRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "members"
+      ],
+      "properties": {
+        "members": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "createServiceAccountRequest": {
+      "type": "object",
+      "properties": {
+        "policy": {
+          "description": "optional IAM policy document in JSON restricting the permissions of the service account",
+          "type": "string"
+        }
+      }
+    },
+    "createTenantRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "volume_configuration",
+        "namespace"
+      ],
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "default_bucket_quota": {
+          "description": "default hard quota of the buckets created through m3, ie 100Gi",
+          "type": "string"
+        },
+        "enable_mcs": {
+          "type": "boolean",
+          "default": true
+        },
+        "enable_ssl": {
+          "type": "boolean",
+          "default": true
+        },
+        "exposure": {
+          "description": "how the tenant is exposed, default leaves it to the integrations enabled on m3, gateway requires the gateway integration",
+          "type": "string",
+          "default": "default",
+          "enum": [
+            "default",
+            "load-balancer",
+            "gateway"
+          ]
+        },
+        "image": {
+          "type": "string"
+        },
+        "mounth_path": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string",
+          "pattern": "^[a-z0-9-]{3,63}$"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        },
+        "service_name": {
+          "type": "string"
+        },
+        "volume_configuration": {
+          "type": "object",
+          "required": [
+            "size"
+          ],
+          "properties": {
+            "size": {
+              "type": "string"
+            },
+            "storage_class": {
+              "type": "string"
+            }
+          }
+        },
+        "volumes_per_server": {
+          "type": "integer"
+        },
+        "zones": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/zone"
+          }
+        }
+      }
+    },
+    "createTenantResponse": {
+      "type": "object",
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "console_host": {
+          "type": "string"
+        },
+        "s3_host": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        },
+        "warnings": {
+          "description": "set when the tenant was created but an integration failed on it",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "deleteTenantResponse": {
+      "type": "object",
+      "properties": {
+        "removed": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/removedResource"
+          }
+        }
+      }
+    },
+    "domainCertificateStatus": {
+      "type": "object",
+      "properties": {
+        "domain": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "causes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/errorCause"
+          }
+        },
+        "code": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "detailed_message": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "request_id": {
+          "type": "string"
+        }
+      }
+    },
+    "errorCause": {
+      "type": "object",
+      "properties": {
+        "field": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        }
+      }
+    },
+    "group": {
+      "type": "object",
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "integrationStatus": {
+      "type": "object",
+      "properties": {
+        "certificate": {
+          "$ref": "#/definitions/certificateStatus"
+        },
+        "endpoints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tenantEndpoint"
+          }
+        },
+        "message": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "routes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routeStatus"
+          }
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "ready",
+            "pending",
+            "error"
+          ]
+        }
+      }
+    },
+    "lifecycleExpiration": {
+      "description": "only one of days, date or expired_object_delete_marker can be set",
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string",
+          "format": "date"
+        },
+        "days": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
+        },
+        "expired_object_delete_marker": {
+          "type": "boolean"
+        }
+      }
+    },
+    "lifecycleRule": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "abort_incomplete_multipart_upload": {
+          "type": "object",
+          "required": [
+            "days_after_initiation"
+          ],
+          "properties": {
+            "days_after_initiation": {
+              "type": "integer",
+              "format": "int32",
+              "minimum": 1
+            }
+          }
+        },
+        "enabled": {
+          "type": "boolean",
+          "default": true
+        },
+        "expiration": {
+          "$ref": "#/definitions/lifecycleExpiration"
+        },
+        "id": {
+          "type": "string",
+          "maxLength": 255
+        },
+        "noncurrent_version_expiration": {
+          "type": "object",
+          "required": [
+            "noncurrent_days"
+          ],
+          "properties": {
+            "noncurrent_days": {
+              "type": "integer",
+              "format": "int32",
+              "minimum": 1
+            }
+          }
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleTag"
+          }
+        },
+        "unmanaged_actions": {
+          "description": "actions of the rule that can't be managed by m3 (ie transitions), they're kept when the rule is updated",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "readOnly": true
+        }
+      }
+    },
+    "lifecycleTag": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "listBucketObjectsResponse": {
+      "type": "object",
+      "properties": {
+        "is_truncated": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "next_continuation_token": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketObject"
+          }
+        },
+        "prefixes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "listBucketsResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucket"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "listGroupsResponse": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/group"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "listManagedCertificatesResponse": {
+      "type": "object",
+      "properties": {
+        "certificates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/managedCertificate"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "listNotificationTargetsResponse": {
+      "type": "object",
+      "properties": {
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/notificationTarget"
+          }
+        }
+      }
+    },
+    "listPoliciesResponse": {
+      "type": "object",
+      "properties": {
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policy"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "listServiceAccountsResponse": {
+      "type": "object",
+      "properties": {
+        "service_accounts": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "listTenantJobsResponse": {
+      "type": "object",
+      "properties": {
+        "jobs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tenantJob"
+          }
+        }
+      }
+    },
+    "listTenantsResponse": {
+      "type": "object",
+      "properties": {
+        "tenants": {
+          "type": "array",
+          "title": "list of resulting tenants",
+          "items": {
+            "$ref": "#/definitions/tenantList"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of tenants accessible to tenant user"
+        }
+      }
+    },
+    "listUsersResponse": {
+      "type": "object",
+      "properties": {
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/user"
+          }
+        }
+      }
+    },
+    "listWebhookDeliveriesResponse": {
+      "type": "object",
+      "properties": {
+        "deliveries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhookDelivery"
+          }
+        }
+      }
+    },
+    "listWebhooksResponse": {
+      "type": "object",
+      "properties": {
+        "webhooks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhook"
+          }
+        }
+      }
+    },
+    "managedCertificate": {
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "domains": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/domainCertificateStatus"
+          }
+        },
+        "expire_time": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "stuck": {
+          "type": "boolean"
+        },
+        "stuck_reason": {
+          "type": "string"
+        },
+        "tenant": {
+          "type": "string"
+        }
+      }
+    },
+    "notificationRule": {
+      "type": "object",
+      "required": [
+        "target_arn",
+        "events"
+      ],
+      "properties": {
+        "events": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "enum": [
+              "s3:ObjectCreated:*",
+              "s3:ObjectCreated:Put",
+              "s3:ObjectCreated:Post",
+              "s3:ObjectCreated:Copy",
+              "s3:ObjectCreated:CompleteMultipartUpload",
+              "s3:ObjectAccessed:*",
+              "s3:ObjectAccessed:Get",
+              "s3:ObjectAccessed:Head",
+              "s3:ObjectRemoved:*",
+              "s3:ObjectRemoved:Delete",
+              "s3:ObjectRemoved:DeleteMarkerCreated"
+            ]
+          }
+        },
+        "id": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "suffix": {
+          "type": "string"
+        },
+        "target_arn": {
+          "type": "string"
+        }
+      }
+    },
+    "notificationTarget": {
+      "description": "the credentials of the targets (auth_token, sasl_password, password, token and the password of the amqp url) are returned masked as xxxxx, they're kept when a target is updated with the mask and cleared when it's updated with them empty",
+      "type": "object",
+      "required": [
+        "id",
+        "type"
+      ],
+      "properties": {
+        "amqp": {
+          "type": "object",
+          "properties": {
+            "durable": {
+              "type": "boolean"
+            },
+            "exchange": {
+              "type": "string"
+            },
+            "exchange_type": {
+              "type": "string"
+            },
+            "routing_key": {
+              "type": "string"
+            },
+            "url": {
+              "type": "string"
+            }
+          }
+        },
+        "arn": {
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "type": "string",
+          "maxLength": 64,
+          "pattern": "^[A-Za-z0-9_-]+$"
+        },
+        "kafka": {
+          "type": "object",
+          "required": [
+            "brokers",
+            "topic"
+          ],
+          "properties": {
+            "brokers": {
+              "type": "array",
+              "minItems": 1,
+              "items": {
+                "type": "string"
+              }
+            },
+            "sasl_password": {
+              "type": "string"
+            },
+            "sasl_username": {
+              "type": "string"
+            },
+            "tls": {
+              "type": "boolean"
+            },
+            "topic": {
+              "type": "string"
+            }
+          }
+        },
+        "nats": {
+          "type": "object",
+          "required": [
+            "address",
+            "subject"
+          ],
+          "properties": {
+            "address": {
+              "type": "string"
+            },
+            "password": {
+              "type": "string"
+            },
+            "subject": {
+              "type": "string"
+            },
+            "tls": {
+              "type": "boolean"
+            },
+            "token": {
+              "type": "string"
+            },
+            "username": {
+              "type": "string"
+            }
+          }
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "webhook",
+            "kafka",
+            "nats",
+            "amqp"
+          ]
+        },
+        "webhook": {
+          "type": "object",
+          "required": [
+            "endpoint"
+          ],
+          "properties": {
+            "auth_token": {
+              "type": "string"
+            },
+            "endpoint": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "notificationTargetResponse": {
+      "type": "object",
+      "properties": {
+        "restart_required": {
+          "description": "the tenant needs to be restarted to load the change",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "target": {
+          "$ref": "#/definitions/notificationTarget"
+        }
+      }
+    },
+    "policy": {
+      "type": "object",
+      "required": [
+        "name",
+        "document"
+      ],
+      "properties": {
+        "document": {
+          "description": "IAM policy document in JSON",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "presignObjectRequest": {
+      "type": "object",
+      "required": [
+        "object",
+        "method"
+      ],
+      "properties": {
+        "expires": {
+          "description": "seconds the url is valid, capped by M3_PRESIGN_MAX_EXPIRY",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "method": {
+          "type": "string",
+          "enum": [
+            "GET",
+            "PUT"
+          ]
+        },
+        "object": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "presignedObjectURL": {
+      "type": "object",
+      "properties": {
+        "expires": {
+          "description": "seconds the url is valid",
+          "type": "integer",
+          "format": "int64"
+        },
+        "expires_at": {
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "principal": {
+      "type": "string"
+    },
+    "removedResource": {
+      "type": "object",
+      "properties": {
+        "detail": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "replicationBacklog": {
+      "type": "object",
+      "properties": {
+        "failed_count": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "failed_size": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "pending_count": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "pending_size": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "replicated_size": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "replicationTarget": {
+      "type": "object",
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "backlog": {
+          "$ref": "#/definitions/replicationBacklog"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "namespace": {
+          "description": "namespace of the target tenant, only set for the targets created by m3",
+          "type": "string"
+        },
+        "online": {
+          "description": "not reported by older MinIO releases",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "replicate_deletes": {
+          "type": "boolean"
+        },
+        "rule_id": {
+          "type": "string"
+        },
+        "status": {
+          "description": "status of the replication rule of the target, empty when no rule replicates to the target",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "name of the target tenant, only set for the targets created by m3",
+          "type": "string"
+        }
+      }
+    },
+    "resourceQuota": {
+      "type": "object",
+      "properties": {
+        "elements": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resourceQuotaElement"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "resourceQuotaElement": {
+      "type": "object",
+      "properties": {
+        "hard": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string"
+        },
+        "used": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "routeCondition": {
+      "type": "object",
+      "properties": {
+        "last_transition_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "message": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "routeStatus": {
+      "type": "object",
+      "properties": {
+        "accepted": {
+          "type": "boolean"
+        },
+        "conditions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routeCondition"
+          }
+        },
+        "gateway": {
+          "type": "string"
+        },
+        "hostnames": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountCredentials": {
+      "type": "object",
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        }
+      }
+    },
+    "setAccountStatusRequest": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "type": "string",
+          "enum": [
+            "enabled",
+            "disabled"
+          ]
+        }
+      }
+    },
+    "setBucketQuotaResponse": {
+      "type": "object",
+      "properties": {
+        "quota": {
+          "$ref": "#/definitions/bucketQuota"
+        },
+        "warnings": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "setBucketVersioningRequest": {
+      "type": "object",
+      "required": [
+        "enabled"
+      ],
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        }
+      }
+    },
+    "setPolicyRequest": {
+      "type": "object",
+      "required": [
+        "policy"
+      ],
+      "properties": {
+        "policy": {
+          "description": "name of a canned or custom policy",
+          "type": "string"
+        }
+      }
+    },
+    "tenant": {
+      "type": "object",
+      "properties": {
+        "creation_date": {
+          "type": "string"
+        },
+        "currentState": {
+          "type": "string"
+        },
+        "health": {
+          "$ref": "#/definitions/tenantHealth"
+        },
+        "instance_count": {
+          "type": "integer"
+        },
+        "integrations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/integrationStatus"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "volume_count": {
+          "type": "integer"
+        },
+        "volume_size": {
+          "type": "integer"
+        },
+        "volumes_per_server": {
+          "type": "integer"
+        },
+        "zone_count": {
+          "type": "integer"
+        },
+        "zones": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/zone"
+          }
+        }
+      }
+    },
+    "tenantBucketQuotas": {
+      "type": "object",
+      "properties": {
+        "default_quota": {
+          "description": "default quota of the new buckets, from the tenant or from its namespace",
+          "type": "string"
+        },
+        "quotas": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketQuota"
+          }
+        },
+        "total_quota": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "usable_capacity": {
+          "description": "capacity of the tenant left after erasure coding parity",
+          "type": "integer",
+          "format": "int64"
+        },
+        "warnings": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "tenantClusterHealth": {
+      "type": "object",
+      "properties": {
+        "endpoint": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "healthy": {
+          "type": "boolean"
+        },
+        "write_quorum": {
+          "type": "integer"
+        }
+      }
+    },
+    "tenantDrive": {
+      "type": "object",
+      "properties": {
+        "erasure_set": {
+          "description": "index of the erasure set of the drive, -1 when it's unknown",
+          "type": "integer",
+          "format": "int32",
+          "x-omitempty": false
+        },
+        "path": {
+          "type": "string"
+        },
+        "reported_state": {
+          "description": "state of the drive as reported by MinIO",
+          "type": "string"
+        },
+        "set_index": {
+          "description": "position of the drive within its erasure set",
+          "type": "integer",
+          "format": "int32",
+          "x-omitempty": false
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "online",
+            "offline",
+            "healing"
+          ]
+        },
+        "total_space": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "used_space": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "uuid": {
+          "type": "string"
+        }
+      }
+    },
+    "tenantEndpoint": {
+      "type": "object",
+      "properties": {
+        "external_ip": {
+          "type": "string"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "port": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "service": {
+          "type": "string"
+        }
+      }
+    },
+    "tenantHealth": {
+      "type": "object",
+      "properties": {
+        "checked_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "cluster": {
+          "$ref": "#/definitions/tenantClusterHealth"
+        },
+        "grade": {
+          "type": "string",
+          "enum": [
+            "green",
+            "yellow",
+            "red"
+          ]
+        },
+        "history": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tenantHealthHistory"
+          }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tenantNodeHealth"
+          }
+        }
+      }
+    },
+    "tenantHealthHistory": {
+      "type": "object",
+      "properties": {
+        "checked_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "grade": {
+          "type": "string"
+        },
+        "online_nodes": {
+          "type": "integer"
+        },
+        "total_nodes": {
+          "type": "integer"
+        }
+      }
+    },
+    "tenantJob": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "command": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "completed": {
+          "type": "string"
+        },
+        "created": {
+          "type": "string"
+        },
+        "exit_code": {
+          "type": "integer",
+          "format": "int32",
+          "x-nullable": true
+        },
+        "message": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "started": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "running",
+            "succeeded",
+            "failed"
+          ]
+        }
+      }
+    },
+    "tenantJobLogs": {
+      "type": "object",
+      "properties": {
+        "logs": {
+          "type": "string",
+          "x-omitempty": false
+        },
+        "pod": {
+          "type": "string"
+        }
+      }
+    },
+    "tenantJobRequest": {
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "mirror",
+            "heal",
+            "admin-info"
+          ]
+        },
+        "bucket": {
+          "description": "bucket mirrored or healed, every bucket is healed when empty",
+          "type": "string"
+        },
+        "dry_run": {
+          "description": "only report what would be healed",
+          "type": "boolean"
+        },
+        "overwrite": {
+          "description": "overwrite the objects that differ on the target bucket",
+          "type": "boolean"
+        },
+        "prefix": {
+          "description": "prefix of the objects healed",
+          "type": "string"
+        },
+        "recursive": {
+          "description": "heal every object under the bucket or prefix",
+          "type": "boolean"
+        },
+        "remove": {
+          "description": "remove the objects of the target bucket that don't exist on the mirrored bucket",
+          "type": "boolean"
+        },
+        "target_bucket": {
+          "description": "bucket the bucket is mirrored to",
+          "type": "string"
+        },
+        "target_tenant": {
+          "description": "tenant of the namespace the bucket is mirrored to, the same tenant when empty",
+          "type": "string"
+        }
+      }
+    },
+    "tenantList": {
+      "type": "object",
+      "properties": {
+        "creation_date": {
+          "type": "string"
+        },
+        "currentState": {
+          "type": "string"
+        },
+        "instance_count": {
+          "type": "integer"
+        },
+        "name": {
+          "type": "string"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "volume_count": {
+          "type": "integer"
+        },
+        "volume_size": {
+          "type": "integer"
+        },
+        "zone_count": {
+          "type": "integer"
+        }
+      }
+    },
+    "tenantNodeHealth": {
+      "type": "object",
+      "properties": {
+        "endpoint": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "online",
+            "initializing",
+            "offline"
+          ]
+        }
+      }
+    },
+    "tenantServer": {
+      "type": "object",
+      "properties": {
+        "commit_id": {
+          "type": "string"
+        },
+        "drives": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tenantDrive"
+          }
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "uptime": {
+          "description": "uptime in seconds",
+          "type": "integer",
+          "format": "int64"
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "tenantServerInfo": {
+      "type": "object",
+      "properties": {
+        "deployment_id": {
+          "type": "string"
+        },
+        "erasure_sets": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "healing_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "mode": {
+          "type": "string"
+        },
+        "offline_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "online_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rrsc_parity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "servers": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tenantServer"
+          }
+        },
+        "standard_sc_parity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "total_space": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "used_space": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_skew": {
+          "type": "boolean"
+        },
+        "versions": {
+          "description": "distinct versions of MinIO running on the servers",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "tenantUsage": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketUsage"
+          }
+        },
+        "buckets_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "description": "set in the cluster usage when the usage of the tenant couldn't be read",
+          "type": "string"
+        },
+        "last_update": {
+          "description": "time of the last data usage scan of the tenant",
+          "type": "string",
+          "format": "date-time"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenant": {
+          "type": "string"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "updateGroupMembersRequest": {
+      "type": "object",
+      "properties": {
+        "add": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "remove": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "updateTenantRequest": {
+      "type": "object",
+      "properties": {
+        "image": {
+          "type": "string",
+          "pattern": "^((.*?)/(.*?):(.+))$"
+        }
+      }
+    },
+    "user": {
+      "type": "object",
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "member_of": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policy": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "webhook": {
+      "type": "object",
+      "required": [
+        "name",
+        "url"
+      ],
+      "properties": {
+        "events": {
+          "description": "events delivered to the webhook, all of them when empty",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "tenant.created",
+              "tenant.ready",
+              "tenant.upgraded",
+              "tenant.deleted",
+              "tenant.failed",
+              "quota.threshold"
+            ]
+          }
+        },
+        "name": {
+          "type": "string",
+          "pattern": "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+        },
+        "secret": {
+          "description": "key of the HMAC-SHA256 signature of the deliveries, generated when empty",
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "webhookDelivery": {
+      "type": "object",
+      "properties": {
+        "attempts": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "type": "string"
+        },
+        "event": {
+          "type": "string"
+        },
+        "event_id": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "last_attempt_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "response_code": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ]
+        },
+        "url": {
+          "type": "string"
+        },
+        "webhook": {
+          "type": "string"
+        }
+      }
+    },
+    "zone": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "servers": {
+          "type": "integer"
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "key": {
+      "type": "oauth2",
+      "flow": "accessCode",
+      "authorizationUrl": "http://min.io",
+      "tokenUrl": "http://min.io"
+    }
+  },
+  "security": [
+    {
+      "key": []
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "MinIO For Kubernetes",
+    "version": "0.1.0"
+  },
+  "basePath": "/api/v1",
+  "paths": {
+    "/managed-certificates": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the GKE ManagedCertificates created by m3 for the tenants",
+        "operationId": "ListManagedCertificates",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "only return the certificates stuck provisioning",
+            "name": "stuck",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listManagedCertificatesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/resourcequotas/{resource-quota-name}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Get Resource Quota",
+        "operationId": "GetResourceQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "resource-quota-name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/resourceQuota"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Tenants by Namespace",
+        "operationId": "ListTenants",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listTenantsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Tenant Info",
+        "operationId": "TenantInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenant"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update Tenant",
+        "operationId": "UpdateTenant",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateTenantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete Tenant",
+        "operationId": "DeleteTenant",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/deleteTenantResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Buckets of a Tenant",
+        "operationId": "ListTenantBuckets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Create Bucket on a Tenant",
+        "operationId": "CreateTenantBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createBucketRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Bucket Info",
+        "operationId": "TenantBucketInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete Bucket, it must be empty",
+        "operationId": "DeleteTenantBucket",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/lifecycle": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Lifecycle rules of a Bucket",
+        "operationId": "GetTenantBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycle"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Replace the lifecycle rules of a Bucket",
+        "operationId": "SetTenantBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycle"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycle"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove all the lifecycle rules of a Bucket",
+        "operationId": "DeleteTenantBucketLifecycle",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/lifecycle/rules": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add a lifecycle rule to a Bucket",
+        "operationId": "AddTenantBucketLifecycleRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/lifecycleRule"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycle"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/lifecycle/rules/{rule}": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Replace a lifecycle rule of a Bucket",
+        "operationId": "UpdateTenantBucketLifecycleRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/lifecycleRule"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketLifecycle"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove a lifecycle rule of a Bucket",
+        "operationId": "DeleteTenantBucketLifecycleRule",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "rule",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/notifications": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Event notification rules of a Bucket",
+        "operationId": "GetTenantBucketNotifications",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketNotifications"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Replace the event notification rules of a Bucket",
+        "operationId": "SetTenantBucketNotifications",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketNotifications"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketNotifications"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove the event notification rules of a Bucket",
+        "operationId": "DeleteTenantBucketNotifications",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/object-lock": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Set the default retention of a Bucket created with object lock",
+        "operationId": "SetTenantBucketObjectLock",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketRetention"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/objects": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the objects of a Bucket",
+        "operationId": "ListTenantBucketObjects",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "prefix",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "list every object under the prefix instead of grouping them by folder",
+            "name": "recursive",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "next_continuation_token of the previous page",
+            "name": "continuation_token",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listBucketObjectsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/objects/metadata": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Metadata of an Object",
+        "operationId": "TenantBucketObjectInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "object",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketObject"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/objects/presign": {
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Generate a presigned URL to download or upload an Object",
+        "operationId": "PresignTenantBucketObject",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/presignObjectRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/presignedObjectURL"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/quota": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Quota of a Bucket",
+        "operationId": "GetTenantBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketQuota"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Set the Quota of a Bucket",
+        "operationId": "SetTenantBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketQuota"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/setBucketQuotaResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Clear the Quota of a Bucket",
+        "operationId": "ClearTenantBucketQuota",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/replication": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Replication status of a Bucket",
+        "operationId": "GetTenantBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplication"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Replicate a Bucket to a Bucket of another Tenant",
+        "operationId": "SetTenantBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/bucketReplicationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucketReplication"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove the replication of a Bucket",
+        "operationId": "DeleteTenantBucketReplication",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/versioning": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Enable or suspend the versioning of a Bucket",
+        "operationId": "SetTenantBucketVersioning",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "bucket",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setBucketVersioningRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/bucket"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/groups": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List IAM Groups of a Tenant",
+        "operationId": "ListTenantGroups",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listGroupsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Create IAM Group on a Tenant",
+        "operationId": "CreateTenantGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createGroupRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/groups/{group}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "IAM Group Info",
+        "operationId": "TenantGroupInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "group",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove IAM Group, its members are removed from it",
+        "operationId": "RemoveTenantGroup",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "group",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/groups/{group}/members": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add or remove members of an IAM Group",
+        "operationId": "UpdateTenantGroupMembers",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "group",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/updateGroupMembersRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/groups/{group}/policy": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Attach a Policy to an IAM Group",
+        "operationId": "SetTenantGroupPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "group",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/groups/{group}/status": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Enable or disable an IAM Group",
+        "operationId": "SetTenantGroupStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "group",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setAccountStatusRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/group"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/jobs": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the mc Jobs run against a Tenant",
+        "operationId": "ListTenantJobs",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listTenantJobsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Run an mc command against a Tenant as a Kubernetes Job",
+        "operationId": "CreateTenantJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/tenantJobRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenantJob"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/jobs/{job}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Status of an mc Job",
+        "operationId": "TenantJobInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "job",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenantJob"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove an mc Job, it's stopped if still running",
+        "operationId": "DeleteTenantJob",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "job",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/jobs/{job}/logs": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Output of an mc Job",
+        "operationId": "TenantJobLogs",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "job",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "only return the last lines of the output",
+            "name": "tail_lines",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenantJobLogs"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/notification-targets": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the notification targets of a Tenant",
+        "operationId": "ListTenantNotificationTargets",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listNotificationTargetsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add a notification target to a Tenant",
+        "operationId": "CreateTenantNotificationTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "restart the tenant so it loads the change of its notification targets",
+            "name": "restart",
+            "in": "query"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationTarget"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/notificationTargetResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/notification-targets/{type}/{id}": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Update a notification target of a Tenant",
+        "operationId": "UpdateTenantNotificationTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "restart the tenant so it loads the change of its notification targets",
+            "name": "restart",
+            "in": "query"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/notificationTarget"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/notificationTargetResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove a notification target of a Tenant",
+        "operationId": "DeleteTenantNotificationTarget",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "type",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "boolean",
+            "description": "restart the tenant so it loads the change of its notification targets",
+            "name": "restart",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/policies": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List canned and custom Policies of a Tenant",
+        "operationId": "ListTenantPolicies",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listPoliciesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add custom Policy to a Tenant",
+        "operationId": "AddTenantPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/policy"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/policies/{policy}": {
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove Policy",
+        "operationId": "RemoveTenantPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "policy",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/quotas": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Quotas of the Buckets of a Tenant compared with its usable capacity",
+        "operationId": "ListTenantBucketQuotas",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenantBucketQuotas"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/servers": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Servers and drives of a Tenant as reported by MinIO",
+        "operationId": "TenantServerInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenantServerInfo"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/service-accounts": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Service Accounts of the root user of a Tenant",
+        "operationId": "ListTenantServiceAccounts",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listServiceAccountsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Create Service Account for the root user of a Tenant",
+        "operationId": "CreateTenantServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createServiceAccountRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/serviceAccountCredentials"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/service-accounts/{access_key}": {
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete Service Account",
+        "operationId": "DeleteTenantServiceAccount",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "access_key",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/usage": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Data usage of a Tenant and its Buckets",
+        "operationId": "TenantUsage",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/tenantUsage"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/users": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List IAM Users of a Tenant",
+        "operationId": "ListTenantUsers",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listUsersResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Add IAM User to a Tenant",
+        "operationId": "AddTenantUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/addUserRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/users/{user}": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "IAM User Info",
+        "operationId": "TenantUserInfo",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "user",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Remove IAM User",
+        "operationId": "RemoveTenantUser",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "user",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/users/{user}/policy": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Attach a Policy to an IAM User",
+        "operationId": "SetTenantUserPolicy",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "user",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setPolicyRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/namespaces/{namespace}/tenants/{tenant}/users/{user}/status": {
+      "put": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Enable or disable an IAM User",
+        "operationId": "SetTenantUserStatus",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "namespace",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "tenant",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "user",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setAccountStatusRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/user"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/tenants": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List Tenant of All Namespaces",
+        "operationId": "ListAllTenants",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "sort_by",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listTenantsResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Create Tenant",
+        "operationId": "CreateTenant",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createTenantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/createTenantResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/usage": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Data usage of all the Tenants the user has access to",
+        "operationId": "ClusterUsage",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/clusterUsage"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the webhooks notified of the tenants lifecycle events",
+        "operationId": "ListWebhooks",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listWebhooksResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Register a webhook, the signing secret is only returned here",
+        "operationId": "CreateWebhook",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/webhook"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/deliveries": {
+      "get": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "List the latest webhook deliveries, newest first",
+        "operationId": "ListWebhookDeliveries",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "webhook",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/listWebhookDeliveriesResponse"
+            }
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{name}": {
+      "delete": {
+        "tags": [
+          "AdminAPI"
+        ],
+        "summary": "Delete a webhook",
+        "operationId": "DeleteWebhook",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "name",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "A successful response."
+          },
+          "default": {
+            "description": "Generic error response.",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "CreateTenantRequestVolumeConfiguration": {
+      "type": "object",
+      "required": [
+        "size"
+      ],
+      "properties": {
+        "size": {
+          "type": "string"
+        },
+        "storage_class": {
+          "type": "string"
+        }
+      }
+    },
+    "LifecycleRuleAbortIncompleteMultipartUpload": {
+      "type": "object",
+      "required": [
+        "days_after_initiation"
+      ],
+      "properties": {
+        "days_after_initiation": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
+        }
+      }
+    },
+    "LifecycleRuleNoncurrentVersionExpiration": {
+      "type": "object",
+      "required": [
+        "noncurrent_days"
+      ],
+      "properties": {
+        "noncurrent_days": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
+        }
+      }
+    },
+    "NotificationTargetAmqp": {
+      "type": "object",
+      "properties": {
+        "durable": {
+          "type": "boolean"
+        },
+        "exchange": {
+          "type": "string"
+        },
+        "exchange_type": {
+          "type": "string"
+        },
+        "routing_key": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "NotificationTargetKafka": {
+      "type": "object",
+      "required": [
+        "brokers",
+        "topic"
+      ],
+      "properties": {
+        "brokers": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string"
+          }
+        },
+        "sasl_password": {
+          "type": "string"
+        },
+        "sasl_username": {
+          "type": "string"
+        },
+        "tls": {
+          "type": "boolean"
+        },
+        "topic": {
+          "type": "string"
+        }
+      }
+    },
+    "NotificationTargetNats": {
+      "type": "object",
+      "required": [
+        "address",
+        "subject"
+      ],
+      "properties": {
+        "address": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string"
+        },
+        "subject": {
+          "type": "string"
+        },
+        "tls": {
+          "type": "boolean"
+        },
+        "token": {
+          "type": "string"
+        },
+        "username": {
+          "type": "string"
+        }
+      }
+    },
+    "NotificationTargetWebhook": {
+      "type": "object",
+      "required": [
+        "endpoint"
+      ],
+      "properties": {
+        "auth_token": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        }
+      }
+    },
+    "addUserRequest": {
+      "type": "object",
+      "required": [
+        "access_key",
+        "secret_key"
+      ],
+      "properties": {
+        "access_key": {
+          "type": "string",
+          "minLength": 3
+        },
+        "groups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "policy": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string",
+          "minLength": 8
+        }
+      }
+    },
+    "bucket": {
+      "type": "object",
+      "properties": {
+        "creation_date": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "name": {
+          "type": "string"
+        },
+        "object_lock": {
+          "type": "boolean"
+        },
+        "retention": {
+          "$ref": "#/definitions/bucketRetention"
+        },
+        "versioning": {
+          "description": "Enabled or Suspended, empty if versioning was never enabled",
+          "type": "string"
+        },
+        "warnings": {
+          "description": "set when the bucket is created with a quota and the quotas of the buckets exceed the usable capacity of the tenant",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "bucketLifecycle": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "maxItems": 1000,
+          "items": {
+            "$ref": "#/definitions/lifecycleRule"
+          }
+        }
+      }
+    },
+    "bucketNotifications": {
+      "type": "object",
+      "properties": {
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/notificationRule"
+          }
+        }
+      }
+    },
+    "bucketObject": {
+      "type": "object",
+      "properties": {
+        "content_type": {
+          "type": "string"
+        },
+        "etag": {
+          "type": "string"
+        },
+        "last_modified": {
+          "type": "string"
+        },
+        "metadata": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "storage_class": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketQuota": {
+      "type": "object",
+      "required": [
+        "quota"
+      ],
+      "properties": {
+        "bucket": {
+          "type": "string",
+          "readOnly": true
+        },
+        "quota": {
+          "description": "quota in bytes",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "type": {
+          "type": "string",
+          "default": "hard",
+          "enum": [
+            "hard",
+            "fifo"
+          ]
+        }
+      }
+    },
+    "bucketReplication": {
+      "type": "object",
+      "properties": {
+        "backlog": {
+          "$ref": "#/definitions/replicationBacklog"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/replicationTarget"
+          }
+        }
+      }
+    },
+    "bucketReplicationRequest": {
+      "type": "object",
+      "required": [
+        "target_namespace",
+        "target_tenant"
+      ],
+      "properties": {
+        "replicate_deletes": {
+          "description": "replicate delete markers and the deletion of versions",
+          "type": "boolean"
+        },
+        "target_bucket": {
+          "description": "bucket of the target tenant, the name of the source bucket when it's not set, it's created when it doesn't exist",
+          "type": "string"
+        },
+        "target_namespace": {
+          "type": "string"
+        },
+        "target_tenant": {
+          "type": "string"
+        }
+      }
+    },
+    "bucketRetention": {
+      "description": "default retention of the objects of a bucket with object lock, empty to clear it",
+      "type": "object",
+      "properties": {
+        "mode": {
+          "type": "string",
+          "enum": [
+            "GOVERNANCE",
+            "COMPLIANCE"
+          ]
+        },
+        "unit": {
+          "type": "string",
+          "enum": [
+            "DAYS",
+            "YEARS"
+          ]
+        },
+        "validity": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
+        }
+      }
+    },
+    "bucketUsage": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "certificateStatus": {
+      "type": "object",
+      "properties": {
+        "dns_names": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "domains": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/domainCertificateStatus"
+          }
+        },
+        "issuer": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "not_after": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "not_before": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "ready": {
+          "type": "boolean"
+        },
+        "renewal_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "secret": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "clusterUsage": {
+      "type": "object",
+      "properties": {
+        "buckets_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "failed_tenants": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "objects": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tenantUsage"
+          }
+        },
+        "versions": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "createBucketRequest": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "location": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string",
+          "pattern": "^[a-z0-9][a-z0-9.-]{1,61}[a-z0-9]$"
+        },
+        "object_lock": {
+          "description": "object lock can only be enabled when the bucket is created, it enables versioning",
+          "type": "boolean"
+        },
+        "quota": {
+          "$ref": "#/definitions/bucketQuota"
+        },
+        "retention": {
+          "$ref": "#/definitions/bucketRetention"
+        },
+        "versioning": {
+          "type": "boolean"
+        }
+      }
+    },
+    "createGroupRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "members"
+      ],
+      "properties": {
+        "members": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        }
+      }
+    },
+    "createServiceAccountRequest": {
+      "type": "object",
+      "properties": {
+        "policy": {
+          "description": "optional IAM policy document in JSON restricting the permissions of the service account",
+          "type": "string"
+        }
+      }
+    },
+    "createTenantRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "volume_configuration",
+        "namespace"
+      ],
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "default_bucket_quota": {
+          "description": "default hard quota of the buckets created through m3, ie 100Gi",
+          "type": "string"
+        },
+        "enable_mcs": {
+          "type": "boolean",
+          "default": true
+        },
+        "enable_ssl": {
+          "type": "boolean",
+          "default": true
+        },
+        "exposure": {
+          "description": "how the tenant is exposed, default leaves it to the integrations enabled on m3, gateway requires the gateway integration",
+          "type": "string",
+          "default": "default",
+          "enum": [
+            "default",
+            "load-balancer",
+            "gateway"
+          ]
+        },
+        "image": {
+          "type": "string"
+        },
+        "mounth_path": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string",
+          "pattern": "^[a-z0-9-]{3,63}$"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        },
+        "service_name": {
+          "type": "string"
+        },
+        "volume_configuration": {
+          "type": "object",
+          "required": [
+            "size"
+          ],
+          "properties": {
+            "size": {
+              "type": "string"
+            },
+            "storage_class": {
+              "type": "string"
+            }
+          }
+        },
+        "volumes_per_server": {
+          "type": "integer"
+        },
+        "zones": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/zone"
+          }
+        }
+      }
+    },
+    "createTenantResponse": {
+      "type": "object",
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "console_host": {
+          "type": "string"
+        },
+        "s3_host": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        },
+        "warnings": {
+          "description": "set when the tenant was created but an integration failed on it",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "deleteTenantResponse": {
+      "type": "object",
+      "properties": {
+        "removed": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/removedResource"
+          }
+        }
+      }
+    },
+    "domainCertificateStatus": {
+      "type": "object",
+      "properties": {
+        "domain": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "causes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/errorCause"
+          }
+        },
+        "code": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "detailed_message": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "request_id": {
+          "type": "string"
+        }
+      }
+    },
+    "errorCause": {
+      "type": "object",
+      "properties": {
+        "field": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        }
+      }
+    },
+    "group": {
+      "type": "object",
+      "properties": {
+        "members": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "policy": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "integrationStatus": {
+      "type": "object",
+      "properties": {
+        "certificate": {
+          "$ref": "#/definitions/certificateStatus"
+        },
+        "endpoints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tenantEndpoint"
+          }
+        },
+        "message": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "routes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routeStatus"
+          }
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "ready",
+            "pending",
+            "error"
+          ]
+        }
+      }
+    },
+    "lifecycleExpiration": {
+      "description": "only one of days, date or expired_object_delete_marker can be set",
+      "type": "object",
+      "properties": {
+        "date": {
+          "type": "string",
+          "format": "date"
+        },
+        "days": {
+          "type": "integer",
+          "format": "int32",
+          "minimum": 1
+        },
+        "expired_object_delete_marker": {
+          "type": "boolean"
+        }
+      }
+    },
+    "lifecycleRule": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "abort_incomplete_multipart_upload": {
+          "type": "object",
+          "required": [
+            "days_after_initiation"
+          ],
+          "properties": {
+            "days_after_initiation": {
+              "type": "integer",
+              "format": "int32",
+              "minimum": 1
+            }
+          }
+        },
+        "enabled": {
+          "type": "boolean",
+          "default": true
+        },
+        "expiration": {
+          "$ref": "#/definitions/lifecycleExpiration"
+        },
+        "id": {
+          "type": "string",
+          "maxLength": 255
+        },
+        "noncurrent_version_expiration": {
+          "type": "object",
+          "required": [
+            "noncurrent_days"
+          ],
+          "properties": {
+            "noncurrent_days": {
+              "type": "integer",
+              "format": "int32",
+              "minimum": 1
+            }
+          }
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/lifecycleTag"
+          }
+        },
+        "unmanaged_actions": {
+          "description": "actions of the rule that can't be managed by m3 (ie transitions), they're kept when the rule is updated",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "readOnly": true
+        }
+      }
+    },
+    "lifecycleTag": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "key": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "listBucketObjectsResponse": {
+      "type": "object",
+      "properties": {
+        "is_truncated": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "next_continuation_token": {
+          "type": "string"
+        },
+        "objects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketObject"
+          }
+        },
+        "prefixes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "listBucketsResponse": {
+      "type": "object",
+      "properties": {
+        "buckets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucket"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "listGroupsResponse": {
+      "type": "object",
+      "properties": {
+        "groups": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/group"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "listManagedCertificatesResponse": {
+      "type": "object",
+      "properties": {
+        "certificates": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/managedCertificate"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "listNotificationTargetsResponse": {
+      "type": "object",
+      "properties": {
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/notificationTarget"
+          }
+        }
+      }
+    },
+    "listPoliciesResponse": {
+      "type": "object",
+      "properties": {
+        "policies": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policy"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "listServiceAccountsResponse": {
+      "type": "object",
+      "properties": {
+        "service_accounts": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "listTenantJobsResponse": {
+      "type": "object",
+      "properties": {
+        "jobs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tenantJob"
+          }
+        }
+      }
+    },
+    "listTenantsResponse": {
+      "type": "object",
+      "properties": {
+        "tenants": {
+          "type": "array",
+          "title": "list of resulting tenants",
+          "items": {
+            "$ref": "#/definitions/tenantList"
+          }
+        },
+        "total": {
+          "type": "integer",
+          "format": "int64",
+          "title": "number of tenants accessible to tenant user"
+        }
+      }
+    },
+    "listUsersResponse": {
+      "type": "object",
+      "properties": {
+        "total": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/user"
+          }
+        }
+      }
+    },
+    "listWebhookDeliveriesResponse": {
+      "type": "object",
+      "properties": {
+        "deliveries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhookDelivery"
+          }
+        }
+      }
+    },
+    "listWebhooksResponse": {
+      "type": "object",
+      "properties": {
+        "webhooks": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/webhook"
+          }
+        }
+      }
+    },
+    "managedCertificate": {
+      "type": "object",
+      "properties": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "domains": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/domainCertificateStatus"
+          }
+        },
+        "expire_time": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "stuck": {
+          "type": "boolean"
+        },
+        "stuck_reason": {
+          "type": "string"
+        },
+        "tenant": {
+          "type": "string"
+        }
+      }
+    },
+    "notificationRule": {
+      "type": "object",
+      "required": [
+        "target_arn",
+        "events"
+      ],
+      "properties": {
+        "events": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "enum": [
+              "s3:ObjectCreated:*",
+              "s3:ObjectCreated:Put",
+              "s3:ObjectCreated:Post",
+              "s3:ObjectCreated:Copy",
+              "s3:ObjectCreated:CompleteMultipartUpload",
+              "s3:ObjectAccessed:*",
+              "s3:ObjectAccessed:Get",
+              "s3:ObjectAccessed:Head",
+              "s3:ObjectRemoved:*",
+              "s3:ObjectRemoved:Delete",
+              "s3:ObjectRemoved:DeleteMarkerCreated"
+            ]
+          }
+        },
+        "id": {
+          "type": "string"
+        },
+        "prefix": {
+          "type": "string"
+        },
+        "suffix": {
+          "type": "string"
+        },
+        "target_arn": {
+          "type": "string"
+        }
+      }
+    },
+    "notificationTarget": {
+      "description": "the credentials of the targets (auth_token, sasl_password, password, token and the password of the amqp url) are returned masked as xxxxx, they're kept when a target is updated with the mask and cleared when it's updated with them empty",
+      "type": "object",
+      "required": [
+        "id",
+        "type"
+      ],
+      "properties": {
+        "amqp": {
+          "type": "object",
+          "properties": {
+            "durable": {
+              "type": "boolean"
+            },
+            "exchange": {
+              "type": "string"
+            },
+            "exchange_type": {
+              "type": "string"
+            },
+            "routing_key": {
+              "type": "string"
+            },
+            "url": {
+              "type": "string"
+            }
+          }
+        },
+        "arn": {
+          "type": "string",
+          "readOnly": true
+        },
+        "id": {
+          "type": "string",
+          "maxLength": 64,
+          "pattern": "^[A-Za-z0-9_-]+$"
+        },
+        "kafka": {
+          "type": "object",
+          "required": [
+            "brokers",
+            "topic"
+          ],
+          "properties": {
+            "brokers": {
+              "type": "array",
+              "minItems": 1,
+              "items": {
+                "type": "string"
+              }
+            },
+            "sasl_password": {
+              "type": "string"
+            },
+            "sasl_username": {
+              "type": "string"
+            },
+            "tls": {
+              "type": "boolean"
+            },
+            "topic": {
+              "type": "string"
+            }
+          }
+        },
+        "nats": {
+          "type": "object",
+          "required": [
+            "address",
+            "subject"
+          ],
+          "properties": {
+            "address": {
+              "type": "string"
+            },
+            "password": {
+              "type": "string"
+            },
+            "subject": {
+              "type": "string"
+            },
+            "tls": {
+              "type": "boolean"
+            },
+            "token": {
+              "type": "string"
+            },
+            "username": {
+              "type": "string"
+            }
+          }
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "webhook",
+            "kafka",
+            "nats",
+            "amqp"
+          ]
+        },
+        "webhook": {
+          "type": "object",
+          "required": [
+            "endpoint"
+          ],
+          "properties": {
+            "auth_token": {
+              "type": "string"
+            },
+            "endpoint": {
+              "type": "string"
+            }
+          }
+        }
+      }
+    },
+    "notificationTargetResponse": {
+      "type": "object",
+      "properties": {
+        "restart_required": {
+          "description": "the tenant needs to be restarted to load the change",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "target": {
+          "$ref": "#/definitions/notificationTarget"
+        }
+      }
+    },
+    "policy": {
+      "type": "object",
+      "required": [
+        "name",
+        "document"
+      ],
+      "properties": {
+        "document": {
+          "description": "IAM policy document in JSON",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "presignObjectRequest": {
+      "type": "object",
+      "required": [
+        "object",
+        "method"
+      ],
+      "properties": {
+        "expires": {
+          "description": "seconds the url is valid, capped by M3_PRESIGN_MAX_EXPIRY",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "method": {
+          "type": "string",
+          "enum": [
+            "GET",
+            "PUT"
+          ]
+        },
+        "object": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "presignedObjectURL": {
+      "type": "object",
+      "properties": {
+        "expires": {
+          "description": "seconds the url is valid",
+          "type": "integer",
+          "format": "int64"
+        },
+        "expires_at": {
+          "type": "string"
+        },
+        "method": {
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "principal": {
+      "type": "string"
+    },
+    "removedResource": {
+      "type": "object",
+      "properties": {
+        "detail": {
+          "type": "string"
+        },
+        "kind": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "replicationBacklog": {
+      "type": "object",
+      "properties": {
+        "failed_count": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "failed_size": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "pending_count": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "pending_size": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "replicated_size": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        }
+      }
+    },
+    "replicationTarget": {
+      "type": "object",
+      "properties": {
+        "arn": {
+          "type": "string"
+        },
+        "backlog": {
+          "$ref": "#/definitions/replicationBacklog"
+        },
+        "bucket": {
+          "type": "string"
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "namespace": {
+          "description": "namespace of the target tenant, only set for the targets created by m3",
+          "type": "string"
+        },
+        "online": {
+          "description": "not reported by older MinIO releases",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "replicate_deletes": {
+          "type": "boolean"
+        },
+        "rule_id": {
+          "type": "string"
+        },
+        "status": {
+          "description": "status of the replication rule of the target, empty when no rule replicates to the target",
+          "type": "string"
+        },
+        "tenant": {
+          "description": "name of the target tenant, only set for the targets created by m3",
+          "type": "string"
+        }
+      }
+    },
+    "resourceQuota": {
+      "type": "object",
+      "properties": {
+        "elements": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/resourceQuotaElement"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "resourceQuotaElement": {
+      "type": "object",
+      "properties": {
+        "hard": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "name": {
+          "type": "string"
+        },
+        "used": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "routeCondition": {
+      "type": "object",
+      "properties": {
+        "last_transition_time": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "message": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "type": {
+          "type": "string"
+        }
+      }
+    },
+    "routeStatus": {
+      "type": "object",
+      "properties": {
+        "accepted": {
+          "type": "boolean"
+        },
+        "conditions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/routeCondition"
+          }
+        },
+        "gateway": {
+          "type": "string"
+        },
+        "hostnames": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "serviceAccountCredentials": {
+      "type": "object",
+      "properties": {
+        "access_key": {
+          "type": "string"
+        },
+        "secret_key": {
+          "type": "string"
+        }
+      }
+    },
+    "setAccountStatusRequest": {
+      "type": "object",
+      "required": [
+        "status"
+      ],
+      "properties": {
+        "status": {
+          "type": "string",
+          "enum": [
+            "enabled",
+            "disabled"
+          ]
+        }
+      }
+    },
+    "setBucketQuotaResponse": {
+      "type": "object",
+      "properties": {
+        "quota": {
+          "$ref": "#/definitions/bucketQuota"
+        },
+        "warnings": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "setBucketVersioningRequest": {
+      "type": "object",
+      "required": [
+        "enabled"
+      ],
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        }
+      }
+    },
+    "setPolicyRequest": {
+      "type": "object",
+      "required": [
+        "policy"
+      ],
+      "properties": {
+        "policy": {
+          "description": "name of a canned or custom policy",
+          "type": "string"
+        }
+      }
+    },
+    "tenant": {
+      "type": "object",
+      "properties": {
+        "creation_date": {
+          "type": "string"
+        },
+        "currentState": {
+          "type": "string"
+        },
+        "health": {
+          "$ref": "#/definitions/tenantHealth"
+        },
+        "instance_count": {
+          "type": "integer"
+        },
+        "integrations": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/integrationStatus"
+          }
+        },
+        "name": {
+          "type": "string"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "volume_count": {
+          "type": "integer"
+        },
+        "volume_size": {
+          "type": "integer"
+        },
+        "volumes_per_server": {
+          "type": "integer"
+        },
+        "zone_count": {
+          "type": "integer"
+        },
+        "zones": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/zone"
+          }
+        }
+      }
+    },
+    "tenantBucketQuotas": {
+      "type": "object",
+      "properties": {
+        "default_quota": {
+          "description": "default quota of the new buckets, from the tenant or from its namespace",
+          "type": "string"
+        },
+        "quotas": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/bucketQuota"
+          }
+        },
+        "total_quota": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "usable_capacity": {
+          "description": "capacity of the tenant left after erasure coding parity",
+          "type": "integer",
+          "format": "int64"
+        },
+        "warnings": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "tenantClusterHealth": {
+      "type": "object",
+      "properties": {
+        "endpoint": {
+          "type": "string"
+        },
+        "error": {
+          "type": "string"
+        },
+        "healthy": {
+          "type": "boolean"
+        },
+        "write_quorum": {
+          "type": "integer"
+        }
+      }
+    },
+    "tenantDrive": {
+      "type": "object",
+      "properties": {
+        "erasure_set": {
+          "description": "index of the erasure set of the drive, -1 when it's unknown",
+          "type": "integer",
+          "format": "int32",
+          "x-omitempty": false
+        },
+        "path": {
+          "type": "string"
+        },
+        "reported_state": {
+          "description": "state of the drive as reported by MinIO",
+          "type": "string"
+        },
+        "set_index": {
+          "description": "position of the drive within its erasure set",
+          "type": "integer",
+          "format": "int32",
+          "x-omitempty": false
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "online",
+            "offline",
+            "healing"
+          ]
+        },
+        "total_space": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "used_space": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "uuid": {
+          "type": "string"
+        }
+      }
+    },
+    "tenantEndpoint": {
+      "type": "object",
+      "properties": {
+        "external_ip": {
+          "type": "string"
+        },
+        "hostname": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "port": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "service": {
+          "type": "string"
+        }
+      }
+    },
+    "tenantHealth": {
+      "type": "object",
+      "properties": {
+        "checked_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "cluster": {
+          "$ref": "#/definitions/tenantClusterHealth"
+        },
+        "grade": {
+          "type": "string",
+          "enum": [
+            "green",
+            "yellow",
+            "red"
+          ]
+        },
+        "history": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tenantHealthHistory"
+          }
+        },
+        "nodes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tenantNodeHealth"
+          }
+        }
+      }
+    },
+    "tenantHealthHistory": {
+      "type": "object",
+      "properties": {
+        "checked_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "grade": {
+          "type": "string"
+        },
+        "online_nodes": {
+          "type": "integer"
+        },
+        "total_nodes": {
+          "type": "integer"
+        }
+      }
+    },
+    "tenantJob": {
+      "type": "object",
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "command": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "completed": {
+          "type": "string"
+        },
+        "created": {
+          "type": "string"
+        },
+        "exit_code": {
+          "type": "integer",
+          "format": "int32",
+          "x-nullable": true
+        },
+        "message": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "started": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "running",
+            "succeeded",
+            "failed"
+          ]
+        }
+      }
+    },
+    "tenantJobLogs": {
+      "type": "object",
+      "properties": {
+        "logs": {
+          "type": "string",
+          "x-omitempty": false
+        },
+        "pod": {
+          "type": "string"
+        }
+      }
+    },
+    "tenantJobRequest": {
+      "type": "object",
+      "required": [
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string",
+          "enum": [
+            "mirror",
+            "heal",
+            "admin-info"
+          ]
+        },
+        "bucket": {
+          "description": "bucket mirrored or healed, every bucket is healed when empty",
+          "type": "string"
+        },
+        "dry_run": {
+          "description": "only report what would be healed",
+          "type": "boolean"
+        },
+        "overwrite": {
+          "description": "overwrite the objects that differ on the target bucket",
+          "type": "boolean"
+        },
+        "prefix": {
+          "description": "prefix of the objects healed",
+          "type": "string"
+        },
+        "recursive": {
+          "description": "heal every object under the bucket or prefix",
+          "type": "boolean"
+        },
+        "remove": {
+          "description": "remove the objects of the target bucket that don't exist on the mirrored bucket",
+          "type": "boolean"
+        },
+        "target_bucket": {
+          "description": "bucket the bucket is mirrored to",
           "type": "string"
         },
-        "storage_class": {
+        "target_tenant": {
+          "description": "tenant of the namespace the bucket is mirrored to, the same tenant when empty",
           "type": "string"
         }
       }
     },
-    "createTenantRequest": {
+    "tenantList": {
       "type": "object",
-      "required": [
-        "name",
-        "volume_configuration",
-        "namespace"
-      ],
       "properties": {
-        "access_key": {
+        "creation_date": {
           "type": "string"
         },
-        "annotations": {
-          "type": "object",
-          "additionalProperties": {
-            "type": "string"
-          }
-        },
-        "enable_mcs": {
-          "type": "boolean",
-          "default": true
-        },
-        "enable_ssl": {
-          "type": "boolean",
-          "default": true
-        },
-        "image": {
+        "currentState": {
           "type": "string"
         },
-        "mounth_path": {
-          "type": "string"
+        "instance_count": {
+          "type": "integer"
         },
         "name": {
-          "type": "string",
-          "pattern": "^[a-z0-9-]{3,63}$"
-        },
-        "namespace": {
-          "type": "string"
-        },
-        "secret_key": {
           "type": "string"
         },
-        "service_name": {
+        "namespace": {
           "type": "string"
         },
-        "volume_configuration": {
-          "type": "object",
-          "required": [
-            "size"
-          ],
-          "properties": {
-            "size": {
-              "type": "string"
-            },
-            "storage_class": {
-              "type": "string"
-            }
-          }
+        "volume_count": {
+          "type": "integer"
         },
-        "volumes_per_server": {
+        "volume_size": {
           "type": "integer"
         },
-        "zones": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/zone"
-          }
+        "zone_count": {
+          "type": "integer"
         }
       }
     },
-    "createTenantResponse": {
+    "tenantNodeHealth": {
       "type": "object",
       "properties": {
-        "access_key": {
+        "endpoint": {
           "type": "string"
         },
-        "secret_key": {
+        "error": {
+          "type": "string"
+        },
+        "name": {
           "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "online",
+            "initializing",
+            "offline"
+          ]
         }
       }
     },
-    "error": {
+    "tenantServer": {
       "type": "object",
-      "required": [
-        "message"
-      ],
       "properties": {
-        "code": {
+        "commit_id": {
+          "type": "string"
+        },
+        "drives": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tenantDrive"
+          }
+        },
+        "endpoint": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "uptime": {
+          "description": "uptime in seconds",
           "type": "integer",
           "format": "int64"
         },
-        "message": {
+        "version": {
           "type": "string"
         }
       }
     },
-    "listTenantsResponse": {
+    "tenantServerInfo": {
       "type": "object",
       "properties": {
-        "tenants": {
+        "deployment_id": {
+          "type": "string"
+        },
+        "erasure_sets": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "healing_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "mode": {
+          "type": "string"
+        },
+        "offline_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "online_drives": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rrsc_parity": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "servers": {
           "type": "array",
-          "title": "list of resulting tenants",
           "items": {
-            "$ref": "#/definitions/tenantList"
+            "$ref": "#/definitions/tenantServer"
           }
         },
-        "total": {
+        "standard_sc_parity": {
           "type": "integer",
-          "format": "int64",
-          "title": "number of tenants accessible to tenant user"
+          "format": "int64"
+        },
+        "total_space": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "used_space": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "version_skew": {
+          "type": "boolean"
+        },
+        "versions": {
+          "description": "distinct versions of MinIO running on the servers",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "principal": {
-      "type": "string"
-    },
-    "resourceQuota": {
+    "tenantUsage": {
       "type": "object",
       "properties": {
-        "elements": {
+        "buckets": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/resourceQuotaElement"
+            "$ref": "#/definitions/bucketUsage"
           }
         },
-        "name": {
+        "buckets_count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "description": "set in the cluster usage when the usage of the tenant couldn't be read",
           "type": "string"
-        }
-      }
-    },
-    "resourceQuotaElement": {
-      "type": "object",
-      "properties": {
-        "hard": {
+        },
+        "last_update": {
+          "description": "time of the last data usage scan of the tenant",
+          "type": "string",
+          "format": "date-time"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "objects": {
           "type": "integer",
           "format": "int64"
         },
-        "name": {
+        "size": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "tenant": {
           "type": "string"
         },
-        "used": {
+        "versions": {
           "type": "integer",
           "format": "int64"
         }
       }
     },
-    "tenant": {
+    "updateGroupMembersRequest": {
       "type": "object",
       "properties": {
-        "creation_date": {
-          "type": "string"
+        "add": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "currentState": {
+        "remove": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "updateTenantRequest": {
+      "type": "object",
+      "properties": {
+        "image": {
+          "type": "string",
+          "pattern": "^((.*?)/(.*?):(.+))$"
+        }
+      }
+    },
+    "user": {
+      "type": "object",
+      "properties": {
+        "access_key": {
           "type": "string"
         },
-        "instance_count": {
-          "type": "integer"
+        "member_of": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "name": {
+        "policy": {
           "type": "string"
         },
-        "namespace": {
+        "status": {
           "type": "string"
-        },
-        "volume_count": {
-          "type": "integer"
-        },
-        "volume_size": {
-          "type": "integer"
-        },
-        "volumes_per_server": {
-          "type": "integer"
-        },
-        "zone_count": {
-          "type": "integer"
-        },
-        "zones": {
+        }
+      }
+    },
+    "webhook": {
+      "type": "object",
+      "required": [
+        "name",
+        "url"
+      ],
+      "properties": {
+        "events": {
+          "description": "events delivered to the webhook, all of them when empty",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/zone"
+            "type": "string",
+            "enum": [
+              "tenant.created",
+              "tenant.ready",
+              "tenant.upgraded",
+              "tenant.deleted",
+              "tenant.failed",
+              "quota.threshold"
+            ]
           }
+        },
+        "name": {
+          "type": "string",
+          "pattern": "^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+        },
+        "secret": {
+          "description": "key of the HMAC-SHA256 signature of the deliveries, generated when empty",
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
         }
       }
     },
-    "tenantList": {
+    "webhookDelivery": {
       "type": "object",
       "properties": {
-        "creation_date": {
-          "type": "string"
+        "attempts": {
+          "type": "integer",
+          "format": "int64"
         },
-        "currentState": {
+        "created_at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
           "type": "string"
         },
-        "instance_count": {
-          "type": "integer"
+        "event": {
+          "type": "string"
         },
-        "name": {
+        "event_id": {
           "type": "string"
         },
-        "namespace": {
+        "id": {
           "type": "string"
         },
-        "volume_count": {
-          "type": "integer"
+        "last_attempt_at": {
+          "type": "string",
+          "format": "date-time"
         },
-        "volume_size": {
-          "type": "integer"
+        "response_code": {
+          "type": "integer",
+          "format": "int64"
         },
-        "zone_count": {
-          "type": "integer"
-        }
-      }
-    },
-    "updateTenantRequest": {
-      "type": "object",
-      "properties": {
-        "image": {
+        "status": {
           "type": "string",
-          "pattern": "^((.*?)/(.*?):(.+))$"
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ]
+        },
+        "url": {
+          "type": "string"
+        },
+        "webhook": {
+          "type": "string"
         }
       }
     },
@@ -0,0 +1,136 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/pkg/logger"
+	minio "github.com/minio/minio-go/v6"
+	"github.com/minio/minio/pkg/madmin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// prepareError translates an error returned by the kubernetes api server (or by any of the
+// actions executed by m3) into a models.Error carrying the http status code that better
+// describes the failure and the id of the request, errors that can't be translated are returned as 500
+func prepareError(ctx context.Context, err error) *models.Error {
+	if err == nil {
+		return nil
+	}
+	apiErr := &models.Error{
+		Code:            http.StatusInternalServerError,
+		Message:         swag.String(err.Error()),
+		DetailedMessage: err.Error(),
+		RequestID:       logger.RequestID(ctx),
+	}
+
+	switch {
+	case apierrors.IsNotFound(err):
+		apiErr.Code = http.StatusNotFound
+	case apierrors.IsAlreadyExists(err), apierrors.IsConflict(err):
+		apiErr.Code = http.StatusConflict
+	case apierrors.IsForbidden(err):
+		apiErr.Code = http.StatusForbidden
+	case apierrors.IsUnauthorized(err):
+		apiErr.Code = http.StatusUnauthorized
+	case apierrors.IsInvalid(err):
+		apiErr.Code = http.StatusUnprocessableEntity
+	case apierrors.IsBadRequest(err):
+		apiErr.Code = http.StatusBadRequest
+	case apierrors.IsTimeout(err), apierrors.IsServerTimeout(err), isTimeoutError(err):
+		apiErr.Code = http.StatusGatewayTimeout
+	case apierrors.IsServiceUnavailable(err):
+		apiErr.Code = http.StatusServiceUnavailable
+	}
+
+	// errors coming from the api server contain a status with a short message and the causes of the failure
+	if status, ok := err.(apierrors.APIStatus); ok {
+		errStatus := status.Status()
+		if errStatus.Message != "" {
+			apiErr.Message = swag.String(errStatus.Message)
+		}
+		apiErr.Reason = string(errStatus.Reason)
+		if errStatus.Details != nil {
+			for _, cause := range errStatus.Details.Causes {
+				apiErr.Causes = append(apiErr.Causes, &models.ErrorCause{
+					Field:   cause.Field,
+					Reason:  string(cause.Type),
+					Message: cause.Message,
+				})
+			}
+		}
+	}
+
+	// errors returned by the tenants carry the s3 error code and the status code of the response
+	var s3Err minio.ErrorResponse
+	if errors.As(err, &s3Err) && s3Err.StatusCode != 0 {
+		apiErr.Code = int64(s3Err.StatusCode)
+		apiErr.Reason = s3Err.Code
+		if s3Err.Message != "" {
+			apiErr.Message = swag.String(s3Err.Message)
+		}
+	}
+
+	// errors of the admin api of the tenants only carry the error code
+	var adminErr madmin.ErrorResponse
+	if errors.As(err, &adminErr) {
+		if code, ok := adminErrorCodes[adminErr.Code]; ok {
+			apiErr.Code = code
+		}
+		apiErr.Reason = adminErr.Code
+	}
+	return apiErr
+}
+
+// adminErrorCodes are the http status codes of the errors returned by the admin api of the tenants
+var adminErrorCodes = map[string]int64{
+	"AccessDenied":                                  http.StatusForbidden,
+	"InvalidArgument":                               http.StatusBadRequest,
+	"XMinioAdminInvalidArgument":                    http.StatusBadRequest,
+	"XMinioMalformedJSON":                           http.StatusBadRequest,
+	"XMinioAdminNoSuchUser":                         http.StatusNotFound,
+	"XMinioAdminNoSuchGroup":                        http.StatusNotFound,
+	"XMinioAdminNoSuchPolicy":                       http.StatusNotFound,
+	"XMinioAdminNoSuchQuotaConfiguration":           http.StatusNotFound,
+	"XMinioAdminGroupNotEmpty":                      http.StatusConflict,
+	"XMinioAdminInvalidAccessKey":                   http.StatusBadRequest,
+	"XMinioAdminInvalidSecretKey":                   http.StatusBadRequest,
+	"XMinioAdminRemoteTargetNotFoundError":          http.StatusNotFound,
+	"XMinioAdminBucketRemoteAlreadyExists":          http.StatusConflict,
+	"XMinioAdminRemoteTargetNotVersionedError":      http.StatusBadRequest,
+	"XMinioAdminReplicationSourceNotVersionedError": http.StatusBadRequest,
+	"XMinioAdminReplicationRemoteConnectionError":   http.StatusBadGateway,
+}
+
+// isTimeoutError returns true if the error was caused by a deadline being exceeded while
+// waiting for the kubernetes api server or any other dependency to answer
+func isTimeoutError(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return netErr.Timeout()
+	}
+	return false
+}
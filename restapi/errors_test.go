@@ -0,0 +1,127 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	minio "github.com/minio/minio-go/v6"
+	"github.com/minio/minio/pkg/madmin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func Test_prepareError(t *testing.T) {
+	minioInstances := schema.GroupResource{Group: "operator.min.io", Resource: "minioinstances"}
+	invalidErr := apierrors.NewInvalid(schema.GroupKind{Group: "operator.min.io", Kind: "MinIOInstance"}, "tenant-1", field.ErrorList{
+		field.Invalid(field.NewPath("spec", "zones"), 0, "must be greater than zero"),
+	})
+	tests := []struct {
+		name       string
+		err        error
+		wantCode   int64
+		wantReason string
+		wantCauses int
+	}{
+		{
+			name:       "Not found",
+			err:        apierrors.NewNotFound(minioInstances, "tenant-1"),
+			wantCode:   404,
+			wantReason: "NotFound",
+		},
+		{
+			name:       "Already exists",
+			err:        apierrors.NewAlreadyExists(minioInstances, "tenant-1"),
+			wantCode:   409,
+			wantReason: "AlreadyExists",
+		},
+		{
+			name:       "Forbidden",
+			err:        apierrors.NewForbidden(minioInstances, "tenant-1", errors.New("not allowed")),
+			wantCode:   403,
+			wantReason: "Forbidden",
+		},
+		{
+			name:       "Unauthorized",
+			err:        apierrors.NewUnauthorized("invalid token"),
+			wantCode:   401,
+			wantReason: "Unauthorized",
+		},
+		{
+			name:       "Invalid with causes",
+			err:        invalidErr,
+			wantCode:   422,
+			wantReason: "Invalid",
+			wantCauses: 1,
+		},
+		{
+			name:       "Api server timeout",
+			err:        apierrors.NewTimeoutError("request timed out", 0),
+			wantCode:   504,
+			wantReason: "Timeout",
+		},
+		{
+			name:       "Service unavailable",
+			err:        apierrors.NewServiceUnavailable("cache not synced"),
+			wantCode:   503,
+			wantReason: "ServiceUnavailable",
+		},
+		{
+			name:     "Context deadline exceeded",
+			err:      fmt.Errorf("waiting for tenant: %w", context.DeadlineExceeded),
+			wantCode: 504,
+		},
+		{
+			name:       "S3 error",
+			err:        minio.ErrorResponse{StatusCode: 409, Code: "BucketNotEmpty", Message: "The bucket you tried to delete is not empty"},
+			wantCode:   409,
+			wantReason: "BucketNotEmpty",
+		},
+		{
+			name:       "Admin error",
+			err:        madmin.ErrorResponse{Code: "XMinioAdminNoSuchUser", Message: "The specified user does not exist"},
+			wantCode:   404,
+			wantReason: "XMinioAdminNoSuchUser",
+		},
+		{
+			name:     "Unknown error",
+			err:      errors.New("something happened"),
+			wantCode: 500,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := prepareError(context.Background(), tt.err)
+			if got.Code != tt.wantCode {
+				t.Errorf("prepareError() code = %v, want %v", got.Code, tt.wantCode)
+			}
+			if got.Reason != tt.wantReason {
+				t.Errorf("prepareError() reason = %v, want %v", got.Reason, tt.wantReason)
+			}
+			if len(got.Causes) != tt.wantCauses {
+				t.Errorf("prepareError() causes = %v, want %v", len(got.Causes), tt.wantCauses)
+			}
+			if got.Message == nil || *got.Message == "" {
+				t.Errorf("prepareError() message should not be empty")
+			}
+		})
+	}
+}
@@ -0,0 +1,167 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/pkg/logger"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	operatorScheme "github.com/minio/minio-operator/pkg/client/clientset/versioned/scheme"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+// Reasons of the events recorded on the MinIOInstances
+const (
+	eventReasonTenantCreated           = "TenantCreated"
+	eventReasonTenantCreateFailed      = "TenantCreateFailed"
+	eventReasonTenantUpgraded          = "TenantUpgraded"
+	eventReasonTenantUpgradeFailed     = "TenantUpgradeFailed"
+	eventReasonTenantDeleted           = "TenantDeleted"
+	eventReasonTenantDeleteFailed      = "TenantDeleteFailed"
+	eventReasonTenantJobCreated        = "TenantJobCreated"
+	eventReasonTenantIntegrationFailed = "TenantIntegrationFailed"
+)
+
+const (
+	// eventComponent is the source of the events recorded by m3
+	eventComponent = "m3"
+	// principalAnnotation carries the user that requested the action on every event recorded by m3
+	principalAnnotation = "m3.min.io/principal"
+	// principalCacheTTL is how long the user behind a token is remembered
+	principalCacheTTL = 5 * time.Minute
+	// principalCacheSize bounds the number of tokens remembered
+	principalCacheSize = 1024
+)
+
+var (
+	tenantEventsOnce sync.Once
+	tenantEvents     *tenantEventRecorder
+)
+
+// getTenantEventRecorder returns the recorder used to write events on the tenants, events are written
+// with m3's own service account since the callers are not expected to be allowed to create events
+func getTenantEventRecorder() *tenantEventRecorder {
+	tenantEventsOnce.Do(func() {
+		clientset, err := cluster.K8sClient(cluster.GetServiceAccountToken())
+		if err != nil {
+			logger.Log.WithError(err).Error("error creating the event recorder, events won't be recorded")
+			tenantEvents = newTenantEventRecorder(&record.FakeRecorder{}, nil)
+			return
+		}
+		broadcaster := record.NewBroadcaster()
+		broadcaster.StartLogging(logger.Log.Debugf)
+		broadcaster.StartRecordingToSink(&typedcorev1.EventSinkImpl{Interface: clientset.CoreV1().Events("")})
+		recorder := broadcaster.NewRecorder(operatorScheme.Scheme, corev1.EventSource{Component: eventComponent})
+		tenantEvents = newTenantEventRecorder(recorder, func(ctx context.Context, token string) (string, error) {
+			review, err := clientset.AuthenticationV1().TokenReviews().Create(ctx, &authenticationv1.TokenReview{
+				Spec: authenticationv1.TokenReviewSpec{Token: token},
+			}, metav1.CreateOptions{})
+			if err != nil {
+				return "", err
+			}
+			if !review.Status.Authenticated {
+				return "", fmt.Errorf("token not authenticated: %s", review.Status.Error)
+			}
+			return review.Status.User.Username, nil
+		})
+		tenantEvents.webhooks = getWebhookDispatcher()
+	})
+	return tenantEvents
+}
+
+// cachedPrincipal is the user behind a token
+type cachedPrincipal struct {
+	name    string
+	expires time.Time
+}
+
+// tenantEventRecorder records events on the MinIOInstances including the user that requested the action
+type tenantEventRecorder struct {
+	recorder record.EventRecorder
+	// resolve returns the user name authenticated by token, when it's nil or it fails the
+	// principal is identified by the fingerprint of the token
+	resolve func(ctx context.Context, token string) (string, error)
+	// webhooks get notified of the recorded events, it can be nil
+	webhooks *webhookDispatcher
+
+	mu         sync.Mutex
+	principals map[string]cachedPrincipal
+}
+
+func newTenantEventRecorder(recorder record.EventRecorder, resolve func(ctx context.Context, token string) (string, error)) *tenantEventRecorder {
+	return &tenantEventRecorder{
+		recorder:   recorder,
+		resolve:    resolve,
+		principals: make(map[string]cachedPrincipal),
+	}
+}
+
+// principal returns the user authenticated by token, results are cached to avoid a TokenReview per request
+func (r *tenantEventRecorder) principal(ctx context.Context, token string) string {
+	fingerprint := principalFingerprint(token)
+	r.mu.Lock()
+	cached, ok := r.principals[fingerprint]
+	r.mu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.name
+	}
+
+	name := "token:" + fingerprint
+	if r.resolve != nil {
+		user, err := r.resolve(ctx, token)
+		if err != nil {
+			logger.FromContext(ctx).WithError(err).Warn("unable to resolve the user of the request")
+		} else if user != "" {
+			name = user
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.principals) >= principalCacheSize {
+		r.principals = make(map[string]cachedPrincipal)
+	}
+	r.principals[fingerprint] = cachedPrincipal{name: name, expires: time.Now().Add(principalCacheTTL)}
+	return name
+}
+
+// record writes an event on tenant, the user authenticated by token is added to the message and annotations
+func (r *tenantEventRecorder) record(ctx context.Context, token string, tenant *operator.MinIOInstance, eventType, reason, messageFmt string, args ...interface{}) {
+	principal := r.principal(ctx, token)
+	message := fmt.Sprintf(messageFmt, args...)
+	r.recorder.AnnotatedEventf(tenant, map[string]string{principalAnnotation: principal}, eventType, reason, "%s by %s", message, principal)
+	r.webhooks.notifyTenantEvent(ctx, tenant, reason, message, principal)
+}
+
+// tenantReference is used to record events on tenants that couldn't be retrieved, ie: a failed creation
+func tenantReference(namespace, name string) *operator.MinIOInstance {
+	return &operator.MinIOInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+		},
+	}
+}
@@ -0,0 +1,83 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/tools/record"
+)
+
+func Test_tenantEventRecorder(t *testing.T) {
+	tests := []struct {
+		name          string
+		resolve       func(ctx context.Context, token string) (string, error)
+		wantPrincipal string
+	}{
+		{
+			name: "Principal resolved",
+			resolve: func(ctx context.Context, token string) (string, error) {
+				return "jane@example.com", nil
+			},
+			wantPrincipal: "jane@example.com",
+		},
+		{
+			name: "Principal can't be resolved",
+			resolve: func(ctx context.Context, token string) (string, error) {
+				return "", errors.New("tokenreviews.authentication.k8s.io is forbidden")
+			},
+			wantPrincipal: "token:" + principalFingerprint("secret-token"),
+		},
+		{
+			name:          "No resolver",
+			wantPrincipal: "token:" + principalFingerprint("secret-token"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resolved := 0
+			var resolve func(ctx context.Context, token string) (string, error)
+			if tt.resolve != nil {
+				resolve = func(ctx context.Context, token string) (string, error) {
+					resolved++
+					return tt.resolve(ctx, token)
+				}
+			}
+			fakeRecorder := record.NewFakeRecorder(2)
+			recorder := newTenantEventRecorder(fakeRecorder, resolve)
+			tenant := tenantReference("default", "tenant-1")
+			for i := 0; i < 2; i++ {
+				recorder.record(context.Background(), "secret-token", tenant, corev1.EventTypeNormal, eventReasonTenantDeleted, "Tenant deleted")
+				event := <-fakeRecorder.Events
+				want := "Normal TenantDeleted Tenant deleted by " + tt.wantPrincipal
+				if event != want {
+					t.Errorf("record() event = %q, want %q", event, want)
+				}
+				if strings.Contains(event, "secret-token") {
+					t.Errorf("record() event must not contain the token")
+				}
+			}
+			if tt.resolve != nil && resolved != 1 {
+				t.Errorf("record() resolved the principal %d times, want 1", resolved)
+			}
+		})
+	}
+}
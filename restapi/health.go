@@ -0,0 +1,213 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/pkg/logger"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+)
+
+const (
+	// livenessPath and readinessPath are served without authentication so kubelet can probe them
+	livenessPath  = "/healthz"
+	readinessPath = "/readyz"
+	// readinessCheckTimeout is the timeout of every request done by the readiness checks
+	readinessCheckTimeout = 3 * time.Second
+)
+
+// discoveryClient subset of the kubernetes discovery client used by the readiness checks
+type discoveryClient interface {
+	ServerVersion() (*version.Info, error)
+	ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error)
+}
+
+// healthCheck is a single named readiness check, the failures of the informational checks are reported as degraded
+// without making m3 unready
+type healthCheck struct {
+	name          string
+	check         func() error
+	informational bool
+}
+
+// healthCheckResult is the outcome of a single check as reported by /readyz
+type healthCheckResult struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// healthResponse is the body returned by /healthz and /readyz
+type healthResponse struct {
+	Status string              `json:"status"`
+	Checks []healthCheckResult `json:"checks,omitempty"`
+}
+
+// crdResource is the CustomResourceDefinitions resource, the CRDs are read through the dynamic client
+var crdResource = schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+// minioInstanceCRDName is the name of the MinIOInstance CustomResourceDefinition
+var minioInstanceCRDName = "minioinstances." + operator.SchemeGroupVersion.Group
+
+// checkMinIOInstanceCRD fails unless the CRD is established and serves the version m3 was built against
+func checkMinIOInstanceCRD(crd *unstructured.Unstructured) error {
+	version := operator.SchemeGroupVersion.Version
+	versions, _, _ := unstructured.NestedSlice(crd.Object, "spec", "versions")
+	served := false
+	for _, v := range versions {
+		if v, ok := v.(map[string]interface{}); ok && v["name"] == version && v["served"] == true {
+			served = true
+		}
+	}
+	if !served {
+		return fmt.Errorf("CRD %s doesn't serve version %s", crd.GetName(), version)
+	}
+	conditions, _, _ := unstructured.NestedSlice(crd.Object, "status", "conditions")
+	for _, c := range conditions {
+		if c, ok := c.(map[string]interface{}); ok && c["type"] == "Established" && c["status"] == "True" {
+			return nil
+		}
+	}
+	return fmt.Errorf("CRD %s is not established", crd.GetName())
+}
+
+// readinessChecks returns the checks m3 needs to pass before receiving traffic: the kubernetes api server
+// is reachable and the MinIOInstance CRD is established and serves the version m3 was built against. The MinIO
+// release catalog (or a preferred MinIO image) is fetched from the internet, it's informational since m3 serves
+// everything but the tenants created without an image while it's unavailable
+func readinessChecks(client discoveryClient, getCRD func(name string) (*unstructured.Unstructured, error), minioImage func() (*string, error)) []healthCheck {
+	return []healthCheck{
+		{
+			name: "kubernetes-api",
+			check: func() error {
+				_, err := client.ServerVersion()
+				return err
+			},
+		},
+		{
+			name: "minioinstance-crd",
+			check: func() error {
+				groupVersion := operator.SchemeGroupVersion.String()
+				resources, err := client.ServerResourcesForGroupVersion(groupVersion)
+				if err != nil {
+					return fmt.Errorf("%s is not served by the kubernetes api server, is the MinIO operator installed? %v", groupVersion, err)
+				}
+				found := false
+				for _, resource := range resources.APIResources {
+					if resource.Kind == operator.MinIOCRDResourceKind {
+						found = true
+					}
+				}
+				if !found {
+					return fmt.Errorf("%s is not served by %s", operator.MinIOCRDResourceKind, groupVersion)
+				}
+				crd, err := getCRD(minioInstanceCRDName)
+				if err != nil {
+					return fmt.Errorf("unable to read CRD %s: %v", minioInstanceCRDName, err)
+				}
+				return checkMinIOInstanceCRD(crd)
+			},
+		},
+		{
+			name: "release-catalog",
+			check: func() error {
+				_, err := minioImage()
+				return err
+			},
+			informational: true,
+		},
+	}
+}
+
+// runHealthChecks runs every check and returns the http status code and the body to report
+func runHealthChecks(checks []healthCheck) (int, *healthResponse) {
+	code := http.StatusOK
+	resp := &healthResponse{Status: "ok"}
+	for _, c := range checks {
+		result := healthCheckResult{Name: c.name, Status: "ok"}
+		err := c.check()
+		switch {
+		case err != nil && c.informational:
+			if resp.Status == "ok" {
+				resp.Status = "degraded"
+			}
+			result.Status = "degraded"
+			result.Error = err.Error()
+		case err != nil:
+			code = http.StatusServiceUnavailable
+			resp.Status = "failed"
+			result.Status = "failed"
+			result.Error = err.Error()
+		}
+		resp.Checks = append(resp.Checks, result)
+	}
+	return code, resp
+}
+
+// livenessHandler reports m3 is alive as long as it's able to serve http requests
+func livenessHandler(w http.ResponseWriter, r *http.Request) {
+	writeHealthResponse(w, r, http.StatusOK, &healthResponse{Status: "ok"})
+}
+
+// readinessHandler runs the readiness checks using m3's own service account
+func readinessHandler(w http.ResponseWriter, r *http.Request) {
+	config := cluster.GetK8sConfig(cluster.GetServiceAccountToken())
+	config.Timeout = readinessCheckTimeout
+	client, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		writeHealthResponse(w, r, http.StatusServiceUnavailable, &healthResponse{
+			Status: "failed",
+			Checks: []healthCheckResult{{Name: "kubernetes-api", Status: "failed", Error: err.Error()}},
+		})
+		return
+	}
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		writeHealthResponse(w, r, http.StatusServiceUnavailable, &healthResponse{
+			Status: "failed",
+			Checks: []healthCheckResult{{Name: "kubernetes-api", Status: "failed", Error: err.Error()}},
+		})
+		return
+	}
+	getCRD := func(name string) (*unstructured.Unstructured, error) {
+		return dynamicClient.Resource(crdResource).Get(r.Context(), name, metav1.GetOptions{})
+	}
+	code, resp := runHealthChecks(readinessChecks(client, getCRD, cluster.GetMinioImage))
+	writeHealthResponse(w, r, code, resp)
+}
+
+func writeHealthResponse(w http.ResponseWriter, r *http.Request, code int, resp *healthResponse) {
+	if resp.Status != "ok" {
+		logger.FromContext(r.Context()).WithField("checks", resp.Checks).Warn("readiness check failed")
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		logger.FromContext(r.Context()).WithError(err).Error("error writing health response")
+	}
+}
@@ -0,0 +1,207 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+type discoveryClientMock struct{}
+
+var discoveryServerVersionMock func() (*version.Info, error)
+var discoveryServerResourcesMock func(groupVersion string) (*metav1.APIResourceList, error)
+
+// mock function of ServerVersion()
+func (d discoveryClientMock) ServerVersion() (*version.Info, error) {
+	return discoveryServerVersionMock()
+}
+
+// mock function of ServerResourcesForGroupVersion()
+func (d discoveryClientMock) ServerResourcesForGroupVersion(groupVersion string) (*metav1.APIResourceList, error) {
+	return discoveryServerResourcesMock(groupVersion)
+}
+
+func Test_readinessChecks(t *testing.T) {
+	client := discoveryClientMock{}
+	serverVersion := func() (*version.Info, error) {
+		return &version.Info{Major: "1", Minor: "18"}, nil
+	}
+	operatorResources := func(groupVersion string) (*metav1.APIResourceList, error) {
+		if groupVersion != "operator.min.io/v1" {
+			return nil, errors.New("the server could not find the requested resource")
+		}
+		return &metav1.APIResourceList{
+			GroupVersion: groupVersion,
+			APIResources: []metav1.APIResource{{Name: "minioinstances", Kind: "MinIOInstance"}},
+		}, nil
+	}
+	establishedCRD := func(served bool, established string) func(name string) (*unstructured.Unstructured, error) {
+		return func(name string) (*unstructured.Unstructured, error) {
+			if name != "minioinstances.operator.min.io" {
+				return nil, errors.New("customresourcedefinitions not found")
+			}
+			return &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": name},
+				"spec": map[string]interface{}{"versions": []interface{}{
+					map[string]interface{}{"name": "v1", "served": served, "storage": true},
+				}},
+				"status": map[string]interface{}{"conditions": []interface{}{
+					map[string]interface{}{"type": "NamesAccepted", "status": "True"},
+					map[string]interface{}{"type": "Established", "status": established},
+				}},
+			}}, nil
+		}
+	}
+	minioImage := func() (*string, error) {
+		return swag.String("minio/minio:RELEASE.2020-05-16T01-33-21Z"), nil
+	}
+	tests := []struct {
+		name            string
+		serverVersion   func() (*version.Info, error)
+		serverResources func(groupVersion string) (*metav1.APIResourceList, error)
+		getCRD          func(name string) (*unstructured.Unstructured, error)
+		minioImage      func() (*string, error)
+		wantCode        int
+		wantFailed      []string
+		wantDegraded    []string
+	}{
+		{
+			name:            "All checks pass",
+			serverVersion:   serverVersion,
+			serverResources: operatorResources,
+			getCRD:          establishedCRD(true, "True"),
+			minioImage:      minioImage,
+			wantCode:        http.StatusOK,
+		},
+		{
+			name: "Api server unreachable",
+			serverVersion: func() (*version.Info, error) {
+				return nil, errors.New("connection refused")
+			},
+			serverResources: func(groupVersion string) (*metav1.APIResourceList, error) {
+				return nil, errors.New("connection refused")
+			},
+			minioImage: minioImage,
+			wantCode:   http.StatusServiceUnavailable,
+			wantFailed: []string{"kubernetes-api", "minioinstance-crd"},
+		},
+		{
+			name:          "CRD not installed",
+			serverVersion: serverVersion,
+			serverResources: func(groupVersion string) (*metav1.APIResourceList, error) {
+				return nil, errors.New("the server could not find the requested resource")
+			},
+			minioImage: minioImage,
+			wantCode:   http.StatusServiceUnavailable,
+			wantFailed: []string{"minioinstance-crd"},
+		},
+		{
+			name:          "CRD installed without MinIOInstance",
+			serverVersion: serverVersion,
+			serverResources: func(groupVersion string) (*metav1.APIResourceList, error) {
+				return &metav1.APIResourceList{
+					GroupVersion: groupVersion,
+					APIResources: []metav1.APIResource{{Name: "mirrorinstances", Kind: "MirrorInstance"}},
+				}, nil
+			},
+			minioImage: minioImage,
+			wantCode:   http.StatusServiceUnavailable,
+			wantFailed: []string{"minioinstance-crd"},
+		},
+		{
+			name:            "CRD not established",
+			serverVersion:   serverVersion,
+			serverResources: operatorResources,
+			getCRD:          establishedCRD(true, "False"),
+			minioImage:      minioImage,
+			wantCode:        http.StatusServiceUnavailable,
+			wantFailed:      []string{"minioinstance-crd"},
+		},
+		{
+			name:            "CRD version not served",
+			serverVersion:   serverVersion,
+			serverResources: operatorResources,
+			getCRD:          establishedCRD(false, "True"),
+			minioImage:      minioImage,
+			wantCode:        http.StatusServiceUnavailable,
+			wantFailed:      []string{"minioinstance-crd"},
+		},
+		{
+			name:            "CRD not readable",
+			serverVersion:   serverVersion,
+			serverResources: operatorResources,
+			getCRD: func(name string) (*unstructured.Unstructured, error) {
+				return nil, errors.New("forbidden")
+			},
+			minioImage: minioImage,
+			wantCode:   http.StatusServiceUnavailable,
+			wantFailed: []string{"minioinstance-crd"},
+		},
+		{
+			name:            "Release catalog not loaded",
+			serverVersion:   serverVersion,
+			serverResources: operatorResources,
+			getCRD:          establishedCRD(true, "True"),
+			minioImage: func() (*string, error) {
+				return nil, errors.New("unable to fetch minio releases")
+			},
+			wantCode:     http.StatusOK,
+			wantDegraded: []string{"release-catalog"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			discoveryServerVersionMock = tt.serverVersion
+			discoveryServerResourcesMock = tt.serverResources
+			code, resp := runHealthChecks(readinessChecks(client, tt.getCRD, tt.minioImage))
+			if code != tt.wantCode {
+				t.Errorf("runHealthChecks() code = %v, want %v", code, tt.wantCode)
+			}
+			var failed, degraded []string
+			for _, result := range resp.Checks {
+				if result.Status != "ok" && result.Error == "" {
+					t.Errorf("check %s is %s without reporting an error", result.Name, result.Status)
+				}
+				switch result.Status {
+				case "failed":
+					failed = append(failed, result.Name)
+				case "degraded":
+					degraded = append(degraded, result.Name)
+				}
+			}
+			if !reflect.DeepEqual(degraded, tt.wantDegraded) || (len(degraded) > 0 && len(failed) == 0 && resp.Status != "degraded") {
+				t.Errorf("runHealthChecks() degraded = %v, status %s, want %v", degraded, resp.Status, tt.wantDegraded)
+			}
+			if len(failed) != len(tt.wantFailed) {
+				t.Fatalf("runHealthChecks() failed = %v, want %v", failed, tt.wantFailed)
+			}
+			for i := range failed {
+				if failed[i] != tt.wantFailed[i] {
+					t.Errorf("runHealthChecks() failed = %v, want %v", failed, tt.wantFailed)
+				}
+			}
+		})
+	}
+}
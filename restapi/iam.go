@@ -0,0 +1,696 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/restapi/operations"
+	"github.com/minio/m3/restapi/operations/admin_api"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// iamTimeout is the timeout of the requests sent to the tenants to manage their users, groups and policies
+const iamTimeout = 30 * time.Second
+
+func registerIAMHandlers(api *operations.M3API) {
+	// List Users
+	api.AdminAPIListTenantUsersHandler = admin_api.ListTenantUsersHandlerFunc(func(params admin_api.ListTenantUsersParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getListTenantUsersResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewListTenantUsersDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewListTenantUsersOK().WithPayload(resp)
+	})
+	// Add User
+	api.AdminAPIAddTenantUserHandler = admin_api.AddTenantUserHandlerFunc(func(params admin_api.AddTenantUserParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getAddTenantUserResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewAddTenantUserDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewAddTenantUserCreated().WithPayload(resp)
+	})
+	// User Info
+	api.AdminAPITenantUserInfoHandler = admin_api.TenantUserInfoHandlerFunc(func(params admin_api.TenantUserInfoParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getTenantUserInfoResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewTenantUserInfoDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewTenantUserInfoOK().WithPayload(resp)
+	})
+	// Remove User
+	api.AdminAPIRemoveTenantUserHandler = admin_api.RemoveTenantUserHandlerFunc(func(params admin_api.RemoveTenantUserParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		if err := getRemoveTenantUserResponse(ctx, string(*principal), params); err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewRemoveTenantUserDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewRemoveTenantUserNoContent()
+	})
+	// Enable or disable User
+	api.AdminAPISetTenantUserStatusHandler = admin_api.SetTenantUserStatusHandlerFunc(func(params admin_api.SetTenantUserStatusParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getSetTenantUserStatusResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewSetTenantUserStatusDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewSetTenantUserStatusOK().WithPayload(resp)
+	})
+	// Set User Policy
+	api.AdminAPISetTenantUserPolicyHandler = admin_api.SetTenantUserPolicyHandlerFunc(func(params admin_api.SetTenantUserPolicyParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getSetTenantUserPolicyResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewSetTenantUserPolicyDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewSetTenantUserPolicyOK().WithPayload(resp)
+	})
+	// List Groups
+	api.AdminAPIListTenantGroupsHandler = admin_api.ListTenantGroupsHandlerFunc(func(params admin_api.ListTenantGroupsParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getListTenantGroupsResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewListTenantGroupsDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewListTenantGroupsOK().WithPayload(resp)
+	})
+	// Create Group
+	api.AdminAPICreateTenantGroupHandler = admin_api.CreateTenantGroupHandlerFunc(func(params admin_api.CreateTenantGroupParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getCreateTenantGroupResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewCreateTenantGroupDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewCreateTenantGroupCreated().WithPayload(resp)
+	})
+	// Group Info
+	api.AdminAPITenantGroupInfoHandler = admin_api.TenantGroupInfoHandlerFunc(func(params admin_api.TenantGroupInfoParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getTenantGroupInfoResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewTenantGroupInfoDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewTenantGroupInfoOK().WithPayload(resp)
+	})
+	// Remove Group
+	api.AdminAPIRemoveTenantGroupHandler = admin_api.RemoveTenantGroupHandlerFunc(func(params admin_api.RemoveTenantGroupParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		if err := getRemoveTenantGroupResponse(ctx, string(*principal), params); err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewRemoveTenantGroupDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewRemoveTenantGroupNoContent()
+	})
+	// Update Group Members
+	api.AdminAPIUpdateTenantGroupMembersHandler = admin_api.UpdateTenantGroupMembersHandlerFunc(func(params admin_api.UpdateTenantGroupMembersParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getUpdateTenantGroupMembersResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewUpdateTenantGroupMembersDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewUpdateTenantGroupMembersOK().WithPayload(resp)
+	})
+	// Enable or disable Group
+	api.AdminAPISetTenantGroupStatusHandler = admin_api.SetTenantGroupStatusHandlerFunc(func(params admin_api.SetTenantGroupStatusParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getSetTenantGroupStatusResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewSetTenantGroupStatusDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewSetTenantGroupStatusOK().WithPayload(resp)
+	})
+	// Set Group Policy
+	api.AdminAPISetTenantGroupPolicyHandler = admin_api.SetTenantGroupPolicyHandlerFunc(func(params admin_api.SetTenantGroupPolicyParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getSetTenantGroupPolicyResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewSetTenantGroupPolicyDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewSetTenantGroupPolicyOK().WithPayload(resp)
+	})
+	// List Policies
+	api.AdminAPIListTenantPoliciesHandler = admin_api.ListTenantPoliciesHandlerFunc(func(params admin_api.ListTenantPoliciesParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getListTenantPoliciesResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewListTenantPoliciesDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewListTenantPoliciesOK().WithPayload(resp)
+	})
+	// Add Policy
+	api.AdminAPIAddTenantPolicyHandler = admin_api.AddTenantPolicyHandlerFunc(func(params admin_api.AddTenantPolicyParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getAddTenantPolicyResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewAddTenantPolicyDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewAddTenantPolicyCreated().WithPayload(resp)
+	})
+	// Remove Policy
+	api.AdminAPIRemoveTenantPolicyHandler = admin_api.RemoveTenantPolicyHandlerFunc(func(params admin_api.RemoveTenantPolicyParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		if err := getRemoveTenantPolicyResponse(ctx, string(*principal), params); err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewRemoveTenantPolicyDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewRemoveTenantPolicyNoContent()
+	})
+	// List Service Accounts
+	api.AdminAPIListTenantServiceAccountsHandler = admin_api.ListTenantServiceAccountsHandlerFunc(func(params admin_api.ListTenantServiceAccountsParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getListTenantServiceAccountsResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewListTenantServiceAccountsDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewListTenantServiceAccountsOK().WithPayload(resp)
+	})
+	// Create Service Account
+	api.AdminAPICreateTenantServiceAccountHandler = admin_api.CreateTenantServiceAccountHandlerFunc(func(params admin_api.CreateTenantServiceAccountParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getCreateTenantServiceAccountResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewCreateTenantServiceAccountDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewCreateTenantServiceAccountCreated().WithPayload(resp)
+	})
+	// Delete Service Account
+	api.AdminAPIDeleteTenantServiceAccountHandler = admin_api.DeleteTenantServiceAccountHandlerFunc(func(params admin_api.DeleteTenantServiceAccountParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		if err := getDeleteTenantServiceAccountResponse(ctx, string(*principal), params); err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewDeleteTenantServiceAccountDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewDeleteTenantServiceAccountNoContent()
+	})
+}
+
+// listUsers returns the users of the tenant sorted by access key
+func listUsers(ctx context.Context, client MinioAdmin) (*models.ListUsersResponse, error) {
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.ListUsersResponse{Total: int64(len(users))}
+	for accessKey, info := range users {
+		resp.Users = append(resp.Users, userModel(accessKey, info))
+	}
+	sort.Slice(resp.Users, func(i, j int) bool {
+		return resp.Users[i].AccessKey < resp.Users[j].AccessKey
+	})
+	return resp, nil
+}
+
+func userModel(accessKey string, info madmin.UserInfo) *models.User {
+	return &models.User{
+		AccessKey: accessKey,
+		Status:    string(info.Status),
+		Policy:    info.PolicyName,
+		MemberOf:  info.MemberOf,
+	}
+}
+
+func getUser(ctx context.Context, client MinioAdmin, accessKey string) (*models.User, error) {
+	info, err := client.getUserInfo(ctx, accessKey)
+	if err != nil {
+		return nil, err
+	}
+	return userModel(accessKey, info), nil
+}
+
+// ensurePolicyExists returns a bad request if the policy is neither a canned nor a custom policy of the tenant,
+// it's checked before changing anything so a typo doesn't leave an user or a group half configured
+func ensurePolicyExists(ctx context.Context, client MinioAdmin, name string) error {
+	policies, err := client.listPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	for _, policyName := range strings.Split(name, ",") {
+		if _, ok := policies[policyName]; !ok {
+			return apierrors.NewBadRequest(fmt.Sprintf("policy %s doesn't exist", policyName))
+		}
+	}
+	return nil
+}
+
+// addUser adds the user to the tenant, attaches the policy and adds it to the groups, groups are created if they
+// don't exist
+func addUser(ctx context.Context, client MinioAdmin, req *models.AddUserRequest) (*models.User, error) {
+	accessKey := swag.StringValue(req.AccessKey)
+	if req.Policy != "" {
+		if err := ensurePolicyExists(ctx, client, req.Policy); err != nil {
+			return nil, err
+		}
+	}
+	users, err := client.listUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// adding an existing user would silently change its secret key
+	if _, ok := users[accessKey]; ok {
+		return nil, apierrors.NewAlreadyExists(schema.GroupResource{Resource: "users"}, accessKey)
+	}
+	if err := client.addUser(ctx, accessKey, swag.StringValue(req.SecretKey)); err != nil {
+		return nil, err
+	}
+	if req.Policy != "" {
+		if err := client.setPolicy(ctx, req.Policy, accessKey, false); err != nil {
+			return nil, err
+		}
+	}
+	for _, group := range req.Groups {
+		if err := client.updateGroupMembers(ctx, madmin.GroupAddRemove{Group: group, Members: []string{accessKey}}); err != nil {
+			return nil, err
+		}
+	}
+	return getUser(ctx, client, accessKey)
+}
+
+func setUserStatus(ctx context.Context, client MinioAdmin, accessKey, status string) (*models.User, error) {
+	if err := client.setUserStatus(ctx, accessKey, madmin.AccountStatus(status)); err != nil {
+		return nil, err
+	}
+	return getUser(ctx, client, accessKey)
+}
+
+func setUserPolicy(ctx context.Context, client MinioAdmin, accessKey, policy string) (*models.User, error) {
+	if err := ensurePolicyExists(ctx, client, policy); err != nil {
+		return nil, err
+	}
+	if err := client.setPolicy(ctx, policy, accessKey, false); err != nil {
+		return nil, err
+	}
+	return getUser(ctx, client, accessKey)
+}
+
+// listGroups returns the groups of the tenant with their members sorted by name
+func listGroups(ctx context.Context, client MinioAdmin) (*models.ListGroupsResponse, error) {
+	groups, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(groups)
+	resp := &models.ListGroupsResponse{Total: int64(len(groups))}
+	for _, name := range groups {
+		group, err := getGroup(ctx, client, name)
+		if err != nil {
+			return nil, err
+		}
+		resp.Groups = append(resp.Groups, group)
+	}
+	return resp, nil
+}
+
+func getGroup(ctx context.Context, client MinioAdmin, name string) (*models.Group, error) {
+	desc, err := client.getGroupDescription(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return &models.Group{
+		Name:    desc.Name,
+		Status:  desc.Status,
+		Policy:  desc.Policy,
+		Members: desc.Members,
+	}, nil
+}
+
+// createGroup creates the group with its members, MinIO doesn't have empty groups so at least a member is required
+func createGroup(ctx context.Context, client MinioAdmin, req *models.CreateGroupRequest) (*models.Group, error) {
+	name := swag.StringValue(req.Name)
+	if req.Policy != "" {
+		if err := ensurePolicyExists(ctx, client, req.Policy); err != nil {
+			return nil, err
+		}
+	}
+	groups, err := client.listGroups(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, group := range groups {
+		if group == name {
+			return nil, apierrors.NewAlreadyExists(schema.GroupResource{Resource: "groups"}, name)
+		}
+	}
+	if err := client.updateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: req.Members}); err != nil {
+		return nil, err
+	}
+	if req.Policy != "" {
+		if err := client.setPolicy(ctx, req.Policy, name, true); err != nil {
+			return nil, err
+		}
+	}
+	return getGroup(ctx, client, name)
+}
+
+// updateGroupMembers adds and removes members of the group, members are added first so the group isn't removed
+// when all of its members are replaced
+func updateGroupMembers(ctx context.Context, client MinioAdmin, name string, req *models.UpdateGroupMembersRequest) (*models.Group, error) {
+	if len(req.Add) > 0 {
+		if err := client.updateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: req.Add}); err != nil {
+			return nil, err
+		}
+	}
+	if len(req.Remove) > 0 {
+		if err := client.updateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: req.Remove, IsRemove: true}); err != nil {
+			return nil, err
+		}
+	}
+	return getGroup(ctx, client, name)
+}
+
+func setGroupStatus(ctx context.Context, client MinioAdmin, name, status string) (*models.Group, error) {
+	if err := client.setGroupStatus(ctx, name, madmin.GroupStatus(status)); err != nil {
+		return nil, err
+	}
+	return getGroup(ctx, client, name)
+}
+
+func setGroupPolicy(ctx context.Context, client MinioAdmin, name, policy string) (*models.Group, error) {
+	if err := ensurePolicyExists(ctx, client, policy); err != nil {
+		return nil, err
+	}
+	if err := client.setPolicy(ctx, policy, name, true); err != nil {
+		return nil, err
+	}
+	return getGroup(ctx, client, name)
+}
+
+// removeGroup removes the members of the group and then the group, MinIO refuses to remove groups with members
+func removeGroup(ctx context.Context, client MinioAdmin, name string) error {
+	desc, err := client.getGroupDescription(ctx, name)
+	if err != nil {
+		return err
+	}
+	if len(desc.Members) > 0 {
+		if err := client.updateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, Members: desc.Members, IsRemove: true}); err != nil {
+			return err
+		}
+	}
+	return client.updateGroupMembers(ctx, madmin.GroupAddRemove{Group: name, IsRemove: true})
+}
+
+// listPolicies returns the canned and custom policies of the tenant sorted by name
+func listPolicies(ctx context.Context, client MinioAdmin) (*models.ListPoliciesResponse, error) {
+	policies, err := client.listPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.ListPoliciesResponse{Total: int64(len(policies))}
+	for name, policy := range policies {
+		document, err := policy.MarshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		resp.Policies = append(resp.Policies, &models.Policy{
+			Name:     swag.String(name),
+			Document: swag.String(string(document)),
+		})
+	}
+	sort.Slice(resp.Policies, func(i, j int) bool {
+		return *resp.Policies[i].Name < *resp.Policies[j].Name
+	})
+	return resp, nil
+}
+
+// parsePolicy parses and validates a policy document, invalid documents are reported as bad requests
+func parsePolicy(document string) (*iampolicy.Policy, error) {
+	policy, err := iampolicy.ParseConfig(strings.NewReader(document))
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid policy: %v", err))
+	}
+	return policy, nil
+}
+
+// addPolicy adds a custom policy to the tenant, an existing policy with the same name is replaced
+func addPolicy(ctx context.Context, client MinioAdmin, req *models.Policy) (*models.Policy, error) {
+	policy, err := parsePolicy(swag.StringValue(req.Document))
+	if err != nil {
+		return nil, err
+	}
+	if err := client.addPolicy(ctx, swag.StringValue(req.Name), policy); err != nil {
+		return nil, err
+	}
+	document, err := policy.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return &models.Policy{Name: req.Name, Document: swag.String(string(document))}, nil
+}
+
+// listServiceAccounts returns the service accounts of the root user of the tenant
+func listServiceAccounts(ctx context.Context, client MinioAdmin) (*models.ListServiceAccountsResponse, error) {
+	accounts, err := client.listServiceAccounts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(accounts)
+	return &models.ListServiceAccountsResponse{ServiceAccounts: accounts, Total: int64(len(accounts))}, nil
+}
+
+// createServiceAccount creates a service account of the root user of the tenant, the optional policy restricts
+// its permissions, the secret key is only returned here
+func createServiceAccount(ctx context.Context, client MinioAdmin, req *models.CreateServiceAccountRequest) (*models.ServiceAccountCredentials, error) {
+	var policy *iampolicy.Policy
+	if req != nil && req.Policy != "" {
+		var err error
+		if policy, err = parsePolicy(req.Policy); err != nil {
+			return nil, err
+		}
+	}
+	creds, err := client.addServiceAccount(ctx, policy)
+	if err != nil {
+		return nil, err
+	}
+	return &models.ServiceAccountCredentials{AccessKey: creds.AccessKey, SecretKey: creds.SecretKey}, nil
+}
+
+func getListTenantUsersResponse(ctx context.Context, token string, params admin_api.ListTenantUsersParams) (*models.ListUsersResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return listUsers(ctx, client)
+}
+
+func getAddTenantUserResponse(ctx context.Context, token string, params admin_api.AddTenantUserParams) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return addUser(ctx, client, params.Body)
+}
+
+func getTenantUserInfoResponse(ctx context.Context, token string, params admin_api.TenantUserInfoParams) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return getUser(ctx, client, params.User)
+}
+
+func getRemoveTenantUserResponse(ctx context.Context, token string, params admin_api.RemoveTenantUserParams) error {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return err
+	}
+	return client.removeUser(ctx, params.User)
+}
+
+func getSetTenantUserStatusResponse(ctx context.Context, token string, params admin_api.SetTenantUserStatusParams) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return setUserStatus(ctx, client, params.User, swag.StringValue(params.Body.Status))
+}
+
+func getSetTenantUserPolicyResponse(ctx context.Context, token string, params admin_api.SetTenantUserPolicyParams) (*models.User, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return setUserPolicy(ctx, client, params.User, swag.StringValue(params.Body.Policy))
+}
+
+func getListTenantGroupsResponse(ctx context.Context, token string, params admin_api.ListTenantGroupsParams) (*models.ListGroupsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return listGroups(ctx, client)
+}
+
+func getCreateTenantGroupResponse(ctx context.Context, token string, params admin_api.CreateTenantGroupParams) (*models.Group, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return createGroup(ctx, client, params.Body)
+}
+
+func getTenantGroupInfoResponse(ctx context.Context, token string, params admin_api.TenantGroupInfoParams) (*models.Group, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return getGroup(ctx, client, params.Group)
+}
+
+func getRemoveTenantGroupResponse(ctx context.Context, token string, params admin_api.RemoveTenantGroupParams) error {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return err
+	}
+	return removeGroup(ctx, client, params.Group)
+}
+
+func getUpdateTenantGroupMembersResponse(ctx context.Context, token string, params admin_api.UpdateTenantGroupMembersParams) (*models.Group, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return updateGroupMembers(ctx, client, params.Group, params.Body)
+}
+
+func getSetTenantGroupStatusResponse(ctx context.Context, token string, params admin_api.SetTenantGroupStatusParams) (*models.Group, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return setGroupStatus(ctx, client, params.Group, swag.StringValue(params.Body.Status))
+}
+
+func getSetTenantGroupPolicyResponse(ctx context.Context, token string, params admin_api.SetTenantGroupPolicyParams) (*models.Group, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return setGroupPolicy(ctx, client, params.Group, swag.StringValue(params.Body.Policy))
+}
+
+func getListTenantPoliciesResponse(ctx context.Context, token string, params admin_api.ListTenantPoliciesParams) (*models.ListPoliciesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return listPolicies(ctx, client)
+}
+
+func getAddTenantPolicyResponse(ctx context.Context, token string, params admin_api.AddTenantPolicyParams) (*models.Policy, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return addPolicy(ctx, client, params.Body)
+}
+
+func getRemoveTenantPolicyResponse(ctx context.Context, token string, params admin_api.RemoveTenantPolicyParams) error {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return err
+	}
+	return client.removePolicy(ctx, params.Policy)
+}
+
+func getListTenantServiceAccountsResponse(ctx context.Context, token string, params admin_api.ListTenantServiceAccountsParams) (*models.ListServiceAccountsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return listServiceAccounts(ctx, client)
+}
+
+func getCreateTenantServiceAccountResponse(ctx context.Context, token string, params admin_api.CreateTenantServiceAccountParams) (*models.ServiceAccountCredentials, error) {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return createServiceAccount(ctx, client, params.Body)
+}
+
+func getDeleteTenantServiceAccountResponse(ctx context.Context, token string, params admin_api.DeleteTenantServiceAccountParams) error {
+	ctx, cancel := context.WithTimeout(ctx, iamTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return err
+	}
+	return client.deleteServiceAccount(ctx, params.AccessKey)
+}
@@ -0,0 +1,396 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	"github.com/minio/minio/pkg/auth"
+	iampolicy "github.com/minio/minio/pkg/iam/policy"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+type adminClientMock struct{}
+
+var minioListUsersMock func(ctx context.Context) (map[string]madmin.UserInfo, error)
+var minioGetUserInfoMock func(ctx context.Context, accessKey string) (madmin.UserInfo, error)
+var minioAddUserMock func(ctx context.Context, accessKey, secretKey string) error
+var minioSetUserStatusMock func(ctx context.Context, accessKey string, status madmin.AccountStatus) error
+var minioRemoveUserMock func(ctx context.Context, accessKey string) error
+var minioListGroupsMock func(ctx context.Context) ([]string, error)
+var minioGetGroupDescriptionMock func(ctx context.Context, group string) (*madmin.GroupDesc, error)
+var minioUpdateGroupMembersMock func(ctx context.Context, update madmin.GroupAddRemove) error
+var minioSetGroupStatusMock func(ctx context.Context, group string, status madmin.GroupStatus) error
+var minioListPoliciesMock func(ctx context.Context) (map[string]*iampolicy.Policy, error)
+var minioAddPolicyMock func(ctx context.Context, name string, policy *iampolicy.Policy) error
+var minioRemovePolicyMock func(ctx context.Context, name string) error
+var minioSetPolicyMock func(ctx context.Context, policyName, entityName string, isGroup bool) error
+var minioAddServiceAccountMock func(ctx context.Context, policy *iampolicy.Policy) (auth.Credentials, error)
+var minioListServiceAccountsMock func(ctx context.Context) ([]string, error)
+var minioDeleteServiceAccountMock func(ctx context.Context, accessKey string) error
+
+func (ac adminClientMock) listUsers(ctx context.Context) (map[string]madmin.UserInfo, error) {
+	return minioListUsersMock(ctx)
+}
+
+func (ac adminClientMock) getUserInfo(ctx context.Context, accessKey string) (madmin.UserInfo, error) {
+	return minioGetUserInfoMock(ctx, accessKey)
+}
+
+func (ac adminClientMock) addUser(ctx context.Context, accessKey, secretKey string) error {
+	return minioAddUserMock(ctx, accessKey, secretKey)
+}
+
+func (ac adminClientMock) setUserStatus(ctx context.Context, accessKey string, status madmin.AccountStatus) error {
+	return minioSetUserStatusMock(ctx, accessKey, status)
+}
+
+func (ac adminClientMock) removeUser(ctx context.Context, accessKey string) error {
+	return minioRemoveUserMock(ctx, accessKey)
+}
+
+func (ac adminClientMock) listGroups(ctx context.Context) ([]string, error) {
+	return minioListGroupsMock(ctx)
+}
+
+func (ac adminClientMock) getGroupDescription(ctx context.Context, group string) (*madmin.GroupDesc, error) {
+	return minioGetGroupDescriptionMock(ctx, group)
+}
+
+func (ac adminClientMock) updateGroupMembers(ctx context.Context, update madmin.GroupAddRemove) error {
+	return minioUpdateGroupMembersMock(ctx, update)
+}
+
+func (ac adminClientMock) setGroupStatus(ctx context.Context, group string, status madmin.GroupStatus) error {
+	return minioSetGroupStatusMock(ctx, group, status)
+}
+
+func (ac adminClientMock) listPolicies(ctx context.Context) (map[string]*iampolicy.Policy, error) {
+	return minioListPoliciesMock(ctx)
+}
+
+func (ac adminClientMock) addPolicy(ctx context.Context, name string, policy *iampolicy.Policy) error {
+	return minioAddPolicyMock(ctx, name, policy)
+}
+
+func (ac adminClientMock) removePolicy(ctx context.Context, name string) error {
+	return minioRemovePolicyMock(ctx, name)
+}
+
+func (ac adminClientMock) setPolicy(ctx context.Context, policyName, entityName string, isGroup bool) error {
+	return minioSetPolicyMock(ctx, policyName, entityName, isGroup)
+}
+
+func (ac adminClientMock) addServiceAccount(ctx context.Context, policy *iampolicy.Policy) (auth.Credentials, error) {
+	return minioAddServiceAccountMock(ctx, policy)
+}
+
+func (ac adminClientMock) listServiceAccounts(ctx context.Context) ([]string, error) {
+	return minioListServiceAccountsMock(ctx)
+}
+
+func (ac adminClientMock) deleteServiceAccount(ctx context.Context, accessKey string) error {
+	return minioDeleteServiceAccountMock(ctx, accessKey)
+}
+
+// mockCannedPolicies returns the policies of a new tenant
+func mockCannedPolicies(ctx context.Context) (map[string]*iampolicy.Policy, error) {
+	return map[string]*iampolicy.Policy{
+		"readonly":  &iampolicy.ReadOnly,
+		"readwrite": &iampolicy.ReadWrite,
+	}, nil
+}
+
+func Test_addUser(t *testing.T) {
+	ctx := context.Background()
+	client := adminClientMock{}
+	minioListPoliciesMock = mockCannedPolicies
+	tests := []struct {
+		name          string
+		req           *models.AddUserRequest
+		existing      map[string]madmin.UserInfo
+		wantCode      int64
+		wantPolicy    string
+		wantGroupAdds []string
+	}{
+		{
+			name:          "User with policy and groups",
+			req:           &models.AddUserRequest{AccessKey: swag.String("user-1"), SecretKey: swag.String("secret123"), Policy: "readonly", Groups: []string{"devs", "ops"}},
+			existing:      map[string]madmin.UserInfo{},
+			wantPolicy:    "readonly",
+			wantGroupAdds: []string{"devs", "ops"},
+		},
+		{
+			name:     "User already exists",
+			req:      &models.AddUserRequest{AccessKey: swag.String("user-1"), SecretKey: swag.String("secret123")},
+			existing: map[string]madmin.UserInfo{"user-1": {Status: madmin.AccountEnabled}},
+			wantCode: http.StatusConflict,
+		},
+		{
+			name:     "Unknown policy",
+			req:      &models.AddUserRequest{AccessKey: swag.String("user-1"), SecretKey: swag.String("secret123"), Policy: "readonly,admin"},
+			existing: map[string]madmin.UserInfo{},
+			wantCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var added bool
+			var policy string
+			var groupAdds []string
+			minioListUsersMock = func(ctx context.Context) (map[string]madmin.UserInfo, error) {
+				return tt.existing, nil
+			}
+			minioAddUserMock = func(ctx context.Context, accessKey, secretKey string) error {
+				added = true
+				return nil
+			}
+			minioSetPolicyMock = func(ctx context.Context, policyName, entityName string, isGroup bool) error {
+				if isGroup || entityName != "user-1" {
+					t.Errorf("setPolicy() entity = %v, group %v", entityName, isGroup)
+				}
+				policy = policyName
+				return nil
+			}
+			minioUpdateGroupMembersMock = func(ctx context.Context, update madmin.GroupAddRemove) error {
+				if update.IsRemove || !reflect.DeepEqual(update.Members, []string{"user-1"}) {
+					t.Errorf("updateGroupMembers() = %+v", update)
+				}
+				groupAdds = append(groupAdds, update.Group)
+				return nil
+			}
+			minioGetUserInfoMock = func(ctx context.Context, accessKey string) (madmin.UserInfo, error) {
+				return madmin.UserInfo{Status: madmin.AccountEnabled, PolicyName: policy, MemberOf: groupAdds}, nil
+			}
+			got, err := addUser(ctx, client, tt.req)
+			if tt.wantCode != 0 {
+				if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != tt.wantCode {
+					t.Fatalf("addUser() error = %v, want code %v", err, tt.wantCode)
+				}
+				if added {
+					t.Errorf("addUser() shouldn't add the user")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("addUser() error = %v", err)
+			}
+			if got.AccessKey != "user-1" || got.Status != "enabled" || got.Policy != tt.wantPolicy || !reflect.DeepEqual(got.MemberOf, tt.wantGroupAdds) {
+				t.Errorf("addUser() = %+v", got)
+			}
+		})
+	}
+}
+
+func Test_listUsers(t *testing.T) {
+	minioListUsersMock = func(ctx context.Context) (map[string]madmin.UserInfo, error) {
+		return map[string]madmin.UserInfo{
+			"zed":   {Status: madmin.AccountDisabled},
+			"alice": {Status: madmin.AccountEnabled, PolicyName: "readwrite"},
+		}, nil
+	}
+	got, err := listUsers(context.Background(), adminClientMock{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Total != 2 || got.Users[0].AccessKey != "alice" || got.Users[0].Policy != "readwrite" || got.Users[1].Status != "disabled" {
+		t.Errorf("listUsers() = %+v", got)
+	}
+
+	minioListUsersMock = func(ctx context.Context) (map[string]madmin.UserInfo, error) {
+		return nil, madmin.ErrorResponse{Code: "AccessDenied", Message: "Access Denied."}
+	}
+	_, err = listUsers(context.Background(), adminClientMock{})
+	if apiErr := prepareError(context.Background(), err); apiErr == nil || apiErr.Code != http.StatusForbidden {
+		t.Errorf("listUsers() error = %v", err)
+	}
+}
+
+func Test_groups(t *testing.T) {
+	ctx := context.Background()
+	client := adminClientMock{}
+	groups := map[string]*madmin.GroupDesc{}
+	minioListPoliciesMock = mockCannedPolicies
+	minioListGroupsMock = func(ctx context.Context) ([]string, error) {
+		var names []string
+		for name := range groups {
+			names = append(names, name)
+		}
+		return names, nil
+	}
+	minioGetGroupDescriptionMock = func(ctx context.Context, group string) (*madmin.GroupDesc, error) {
+		if desc, ok := groups[group]; ok {
+			return desc, nil
+		}
+		return nil, madmin.ErrorResponse{Code: "XMinioAdminNoSuchGroup", Message: "The specified group does not exist."}
+	}
+	// behaves as MinIO, groups are created when members are added and removed when they are empty
+	minioUpdateGroupMembersMock = func(ctx context.Context, update madmin.GroupAddRemove) error {
+		desc, ok := groups[update.Group]
+		if !update.IsRemove {
+			if !ok {
+				desc = &madmin.GroupDesc{Name: update.Group, Status: "enabled"}
+				groups[update.Group] = desc
+			}
+			desc.Members = append(desc.Members, update.Members...)
+			return nil
+		}
+		if !ok {
+			return madmin.ErrorResponse{Code: "XMinioAdminNoSuchGroup"}
+		}
+		if len(update.Members) == 0 {
+			if len(desc.Members) > 0 {
+				return madmin.ErrorResponse{Code: "XMinioAdminGroupNotEmpty"}
+			}
+			delete(groups, update.Group)
+			return nil
+		}
+		removed := map[string]bool{}
+		for _, member := range update.Members {
+			removed[member] = true
+		}
+		var members []string
+		for _, member := range desc.Members {
+			if !removed[member] {
+				members = append(members, member)
+			}
+		}
+		desc.Members = members
+		return nil
+	}
+	minioSetPolicyMock = func(ctx context.Context, policyName, entityName string, isGroup bool) error {
+		if !isGroup {
+			t.Errorf("setPolicy() should be set on a group")
+		}
+		groups[entityName].Policy = policyName
+		return nil
+	}
+
+	group, err := createGroup(ctx, client, &models.CreateGroupRequest{Name: swag.String("devs"), Members: []string{"alice"}, Policy: "readwrite"})
+	if err != nil || group.Policy != "readwrite" || !reflect.DeepEqual(group.Members, []string{"alice"}) {
+		t.Fatalf("createGroup() = %+v, %v", group, err)
+	}
+	_, err = createGroup(ctx, client, &models.CreateGroupRequest{Name: swag.String("devs"), Members: []string{"bob"}})
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusConflict {
+		t.Errorf("createGroup() of an existing group error = %v", err)
+	}
+	_, err = setGroupPolicy(ctx, client, "devs", "consoleAdmin")
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusBadRequest {
+		t.Errorf("setGroupPolicy() of an unknown policy error = %v", err)
+	}
+
+	// replacing all the members keeps the group
+	group, err = updateGroupMembers(ctx, client, "devs", &models.UpdateGroupMembersRequest{Add: []string{"bob", "carol"}, Remove: []string{"alice"}})
+	if err != nil || !reflect.DeepEqual(group.Members, []string{"bob", "carol"}) {
+		t.Fatalf("updateGroupMembers() = %+v, %v", group, err)
+	}
+	list, err := listGroups(ctx, client)
+	if err != nil || list.Total != 1 || list.Groups[0].Name != "devs" {
+		t.Errorf("listGroups() = %+v, %v", list, err)
+	}
+
+	if err := removeGroup(ctx, client, "devs"); err != nil {
+		t.Fatalf("removeGroup() error = %v", err)
+	}
+	if _, ok := groups["devs"]; ok {
+		t.Errorf("removeGroup() should remove the group")
+	}
+	err = removeGroup(ctx, client, "devs")
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != http.StatusNotFound {
+		t.Errorf("removeGroup() of a missing group error = %v", err)
+	}
+}
+
+func Test_addPolicy(t *testing.T) {
+	ctx := context.Background()
+	client := adminClientMock{}
+	tests := []struct {
+		name     string
+		document string
+		addErr   error
+		wantCode int64
+	}{
+		{
+			name:     "Valid policy",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:GetObject"],"Resource":["arn:aws:s3:::bucket-1/*"]}]}`,
+		},
+		{
+			name:     "Malformed document",
+			document: `{"Version":`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "Unknown action",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:Fly"],"Resource":["arn:aws:s3:::*"]}]}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "Rejected by the tenant",
+			document: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:*"],"Resource":["arn:aws:s3:::*"]}]}`,
+			addErr:   errors.New("connection refused"),
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var added *iampolicy.Policy
+			minioAddPolicyMock = func(ctx context.Context, name string, policy *iampolicy.Policy) error {
+				added = policy
+				return tt.addErr
+			}
+			got, err := addPolicy(ctx, client, &models.Policy{Name: swag.String("bucket-1-read"), Document: swag.String(tt.document)})
+			if tt.wantCode != 0 {
+				if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != tt.wantCode {
+					t.Fatalf("addPolicy() error = %v, want code %v", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil || added == nil || *got.Name != "bucket-1-read" || *got.Document == "" {
+				t.Errorf("addPolicy() = %+v, %v", got, err)
+			}
+		})
+	}
+}
+
+func Test_createServiceAccount(t *testing.T) {
+	ctx := context.Background()
+	client := adminClientMock{}
+	var policy *iampolicy.Policy
+	minioAddServiceAccountMock = func(ctx context.Context, p *iampolicy.Policy) (auth.Credentials, error) {
+		policy = p
+		return auth.Credentials{AccessKey: "SVCACCT", SecretKey: "SVCSECRET"}, nil
+	}
+	got, err := createServiceAccount(ctx, client, &models.CreateServiceAccountRequest{})
+	if err != nil || got.AccessKey != "SVCACCT" || got.SecretKey != "SVCSECRET" || policy != nil {
+		t.Errorf("createServiceAccount() = %+v, %v", got, err)
+	}
+	_, err = createServiceAccount(ctx, client, &models.CreateServiceAccountRequest{
+		Policy: `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:ListBucket"],"Resource":["arn:aws:s3:::bucket-1"]}]}`,
+	})
+	if err != nil || policy == nil {
+		t.Errorf("createServiceAccount() with policy error = %v, policy %v", err, policy)
+	}
+	if _, err := createServiceAccount(ctx, client, &models.CreateServiceAccountRequest{Policy: "readonly"}); err == nil {
+		t.Errorf("createServiceAccount() with an invalid policy should fail")
+	}
+}
@@ -0,0 +1,341 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/models"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/dynamic"
+)
+
+// m3TenantLabel is set on every resource created by m3 for a tenant, its value is the tenant name
+const m3TenantLabel = "m3.min.io/tenant"
+
+// ingressIntegrationName enables the Ingress integration on M3_INTEGRATIONS
+const ingressIntegrationName = "ingress"
+
+func init() {
+	RegisterIntegration(ingressIntegrationName, func() (Integration, error) {
+		config, err := getIngressConfig()
+		if err != nil {
+			return nil, err
+		}
+		return &ingressIntegration{config: config, newClient: cluster.DynamicClient}, nil
+	})
+}
+
+// ingressGVR networking.k8s.io/v1 Ingress, the client-go version used by m3 doesn't include the typed
+// client for it so the Ingresses are managed through the dynamic client
+var ingressGVR = schema.GroupVersionResource{Group: "networking.k8s.io", Version: "v1", Resource: "ingresses"}
+
+// ingressConfig describes how tenants are exposed through an Ingress
+type ingressConfig struct {
+	className             string
+	domainTemplate        string
+	consoleDomainTemplate string
+	tlsSecret             string
+	annotations           map[string]string
+}
+
+// getIngressConfig returns the exposure configuration set through the M3_INGRESS_* env variables
+func getIngressConfig() (*ingressConfig, error) {
+	annotations, err := getIngressAnnotations()
+	if err != nil {
+		return nil, err
+	}
+	return &ingressConfig{
+		className:             getIngressClass(),
+		domainTemplate:        getIngressDomainTemplate(),
+		consoleDomainTemplate: getIngressConsoleDomainTemplate(),
+		tlsSecret:             getIngressTLSSecret(),
+		annotations:           annotations,
+	}, nil
+}
+
+// tenantHosts are the hosts under which a tenant is exposed
+type tenantHosts struct {
+	Tenant    string
+	Namespace string
+	// Domain is the S3 host, it's available to the console template
+	Domain  string
+	Console string
+}
+
+// hosts renders the domain templates for the given tenant
+func (c *ingressConfig) hosts(tenant, namespace string) (*tenantHosts, error) {
+	return renderTenantHosts(c.domainTemplate, c.consoleDomainTemplate, tenant, namespace)
+}
+
+// renderTenantHosts renders the S3 and console domain templates for the given tenant
+func renderTenantHosts(domainTemplate, consoleDomainTemplate, tenant, namespace string) (*tenantHosts, error) {
+	hosts := &tenantHosts{Tenant: tenant, Namespace: namespace}
+	domain, err := renderHostTemplate(domainTemplate, hosts)
+	if err != nil {
+		return nil, err
+	}
+	hosts.Domain = domain
+	console, err := renderHostTemplate(consoleDomainTemplate, hosts)
+	if err != nil {
+		return nil, err
+	}
+	hosts.Console = console
+	return hosts, nil
+}
+
+func renderHostTemplate(text string, data *tenantHosts) (string, error) {
+	tmpl, err := template.New("host").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("invalid domain template %q: %v", text, err)
+	}
+	var host bytes.Buffer
+	if err := tmpl.Execute(&host, data); err != nil {
+		return "", fmt.Errorf("invalid domain template %q: %v", text, err)
+	}
+	return host.String(), nil
+}
+
+// The following types mirror the subset of networking.k8s.io/v1 used by m3
+
+type ingressV1 struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              ingressV1Spec `json:"spec"`
+}
+
+type ingressV1Spec struct {
+	IngressClassName *string         `json:"ingressClassName,omitempty"`
+	TLS              []ingressV1TLS  `json:"tls,omitempty"`
+	Rules            []ingressV1Rule `json:"rules"`
+}
+
+type ingressV1TLS struct {
+	Hosts      []string `json:"hosts,omitempty"`
+	SecretName string   `json:"secretName,omitempty"`
+}
+
+type ingressV1Rule struct {
+	Host string            `json:"host,omitempty"`
+	HTTP ingressV1HTTPRule `json:"http"`
+}
+
+type ingressV1HTTPRule struct {
+	Paths []ingressV1Path `json:"paths"`
+}
+
+type ingressV1Path struct {
+	Path     string           `json:"path"`
+	PathType string           `json:"pathType"`
+	Backend  ingressV1Backend `json:"backend"`
+}
+
+type ingressV1Backend struct {
+	Service ingressV1ServiceBackend `json:"service"`
+}
+
+type ingressV1ServiceBackend struct {
+	Name string               `json:"name"`
+	Port ingressV1BackendPort `json:"port"`
+}
+
+type ingressV1BackendPort struct {
+	Number int32 `json:"number"`
+}
+
+// tenantIngressName name of the Ingress created for a tenant
+func tenantIngressName(tenant string) string {
+	return fmt.Sprintf("%s-ingress", tenant)
+}
+
+func ingressV1ServiceRule(host, service string, port int32) ingressV1Rule {
+	return ingressV1Rule{
+		Host: host,
+		HTTP: ingressV1HTTPRule{
+			Paths: []ingressV1Path{
+				{
+					Path:     "/",
+					PathType: "Prefix",
+					Backend: ingressV1Backend{
+						Service: ingressV1ServiceBackend{
+							Name: service,
+							Port: ingressV1BackendPort{Number: port},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// newTenantIngress returns the Ingress exposing the S3 service of the tenant and, when enabled, its console. The
+// Ingress is owned by the MinIOInstance so it's garbage collected with it
+func newTenantIngress(minInst *operator.MinIOInstance, config *ingressConfig) (*unstructured.Unstructured, *tenantHosts, error) {
+	hosts, err := config.hosts(minInst.Name, minInst.Namespace)
+	if err != nil {
+		return nil, nil, err
+	}
+	serviceName := minInst.MinIOCIServiceName()
+	if serviceName == "" {
+		serviceName = minInst.Name
+	}
+	ingress := ingressV1{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: ingressGVR.GroupVersion().String(),
+			Kind:       "Ingress",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            tenantIngressName(minInst.Name),
+			Namespace:       minInst.Namespace,
+			Labels:          map[string]string{m3TenantLabel: minInst.Name},
+			Annotations:     config.annotations,
+			OwnerReferences: minInst.OwnerRef(),
+		},
+		Spec: ingressV1Spec{
+			Rules: []ingressV1Rule{ingressV1ServiceRule(hosts.Domain, serviceName, operator.MinIOPort)},
+		},
+	}
+	tlsHosts := []string{hosts.Domain}
+	if minInst.HasMCSEnabled() {
+		ingress.Spec.Rules = append(ingress.Spec.Rules, ingressV1ServiceRule(hosts.Console, minInst.MCSCIServiceName(), operator.MCSPort))
+		tlsHosts = append(tlsHosts, hosts.Console)
+	} else {
+		hosts.Console = ""
+	}
+	if config.className != "" {
+		ingress.Spec.IngressClassName = &config.className
+	}
+	if config.tlsSecret != "" {
+		ingress.Spec.TLS = []ingressV1TLS{{Hosts: tlsHosts, SecretName: config.tlsSecret}}
+	}
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&ingress)
+	if err != nil {
+		return nil, nil, err
+	}
+	return &unstructured.Unstructured{Object: obj}, hosts, nil
+}
+
+// createTenantIngress exposes the tenant through a networking.k8s.io/v1 Ingress
+func createTenantIngress(ctx context.Context, client dynamic.Interface, minInst *operator.MinIOInstance, config *ingressConfig) (*tenantHosts, error) {
+	ingress, hosts, err := newTenantIngress(minInst, config)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := client.Resource(ingressGVR).Namespace(minInst.Namespace).Create(ctx, ingress, metav1.CreateOptions{}); err != nil {
+		return nil, err
+	}
+	return hosts, nil
+}
+
+// ingressIntegration exposes every tenant through its own networking.k8s.io/v1 Ingress, tenants created with
+// another exposure are skipped
+type ingressIntegration struct {
+	NoopIntegration
+	config    *ingressConfig
+	newClient func(token string) (dynamic.Interface, error)
+}
+
+// Name implements Integration
+func (i *ingressIntegration) Name() string {
+	return ingressIntegrationName
+}
+
+// TenantCreated implements Integration
+func (i *ingressIntegration) TenantCreated(ctx context.Context, req *IntegrationRequest) error {
+	if !exposedByIntegrations(req.Tenant) {
+		return nil
+	}
+	client, err := i.newClient(req.Token)
+	if err != nil {
+		return err
+	}
+	_, err = createTenantIngress(ctx, client, req.Tenant, i.config)
+	return err
+}
+
+// TenantDeleted implements Integration, the Ingress is owned by the MinIOInstance but it's removed right
+// away instead of waiting for the garbage collector
+func (i *ingressIntegration) TenantDeleted(ctx context.Context, req *IntegrationRequest) ([]*models.RemovedResource, error) {
+	client, err := i.newClient(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	name := tenantIngressName(req.Tenant.Name)
+	removed, errs := trackRemoved(nil, nil, "Ingress", name,
+		client.Resource(ingressGVR).Namespace(req.Tenant.Namespace).Delete(ctx, name, metav1.DeleteOptions{}))
+	return removed, utilerrors.NewAggregate(errs)
+}
+
+// TenantHosts implements tenantHostsProvider
+func (i *ingressIntegration) TenantHosts(tenant *operator.MinIOInstance) (string, string, error) {
+	if !exposedByIntegrations(tenant) {
+		return "", "", nil
+	}
+	hosts, err := i.config.hosts(tenant.Name, tenant.Namespace)
+	if err != nil {
+		return "", "", err
+	}
+	if !tenant.HasMCSEnabled() {
+		hosts.Console = ""
+	}
+	return hosts.Domain, hosts.Console, nil
+}
+
+// TenantS3Endpoint implements tenantHostsProvider, the ingress controller only serves https when a certificate
+// is set on the Ingresses
+func (i *ingressIntegration) TenantS3Endpoint(tenant *operator.MinIOInstance) (string, bool, error) {
+	s3Host, _, err := i.TenantHosts(tenant)
+	return s3Host, i.config.tlsSecret != "", err
+}
+
+// Status implements Integration, the integration is ready once the ingress controller assigned an address
+// to the Ingress
+func (i *ingressIntegration) Status(ctx context.Context, req *IntegrationRequest) *models.IntegrationStatus {
+	if !exposedByIntegrations(req.Tenant) {
+		return nil
+	}
+	client, err := i.newClient(req.Token)
+	if err != nil {
+		return &models.IntegrationStatus{State: models.IntegrationStatusStateError, Message: err.Error()}
+	}
+	name := tenantIngressName(req.Tenant.Name)
+	ingress, err := client.Resource(ingressGVR).Namespace(req.Tenant.Namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return &models.IntegrationStatus{State: models.IntegrationStatusStateError, Message: fmt.Sprintf("ingress %s not found", name)}
+		}
+		return &models.IntegrationStatus{State: models.IntegrationStatusStateError, Message: err.Error()}
+	}
+	addresses, _, _ := unstructured.NestedSlice(ingress.Object, "status", "loadBalancer", "ingress")
+	if len(addresses) == 0 {
+		return &models.IntegrationStatus{
+			State:   models.IntegrationStatusStatePending,
+			Message: fmt.Sprintf("ingress %s has no address assigned yet", name),
+		}
+	}
+	return &models.IntegrationStatus{State: models.IntegrationStatusStateReady}
+}
@@ -0,0 +1,132 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"testing"
+
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+)
+
+func Test_createTenantIngress(t *testing.T) {
+	tenant := func(mcs bool) *operator.MinIOInstance {
+		minInst := &operator.MinIOInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenant-1", Namespace: "tenants", UID: "1234"},
+			Spec:       operator.MinIOInstanceSpec{ServiceName: "tenant-1"},
+		}
+		if mcs {
+			minInst.Spec.MCS = &operator.MCSConfig{Replicas: 2}
+		}
+		return minInst
+	}
+	tests := []struct {
+		name        string
+		tenant      *operator.MinIOInstance
+		config      *ingressConfig
+		wantDomain  string
+		wantConsole string
+		wantRules   int
+		wantTLS     bool
+		wantClass   string
+		wantErr     bool
+	}{
+		{
+			name:   "S3 and console hosts",
+			tenant: tenant(true),
+			config: &ingressConfig{
+				className:             "nginx",
+				domainTemplate:        "{{.Tenant}}.{{.Namespace}}.example.com",
+				consoleDomainTemplate: "console.{{.Domain}}",
+				tlsSecret:             "wildcard-tls",
+				annotations:           map[string]string{"nginx.ingress.kubernetes.io/proxy-body-size": "0"},
+			},
+			wantDomain:  "tenant-1.tenants.example.com",
+			wantConsole: "console.tenant-1.tenants.example.com",
+			wantRules:   2,
+			wantTLS:     true,
+			wantClass:   "nginx",
+		},
+		{
+			name:   "Tenant without console, default ingress class and no TLS",
+			tenant: tenant(false),
+			config: &ingressConfig{
+				domainTemplate:        "{{.Tenant}}.cloud.min.dev",
+				consoleDomainTemplate: "console.{{.Domain}}",
+			},
+			wantDomain: "tenant-1.cloud.min.dev",
+			wantRules:  1,
+		},
+		{
+			name:   "Invalid domain template",
+			tenant: tenant(false),
+			config: &ingressConfig{
+				domainTemplate:        "{{.Tenant}}.{{.Zone}}.example.com",
+				consoleDomainTemplate: "console.{{.Domain}}",
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+			hosts, err := createTenantIngress(context.Background(), client, tt.tenant, tt.config)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("createTenantIngress() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if hosts.Domain != tt.wantDomain || hosts.Console != tt.wantConsole {
+				t.Errorf("createTenantIngress() hosts = %s %s, want %s %s", hosts.Domain, hosts.Console, tt.wantDomain, tt.wantConsole)
+			}
+			ingress, err := client.Resource(ingressGVR).Namespace("tenants").Get(context.Background(), "tenant-1-ingress", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("createTenantIngress() ingress not created: %v", err)
+			}
+			rules, _, _ := unstructured.NestedSlice(ingress.Object, "spec", "rules")
+			if len(rules) != tt.wantRules {
+				t.Errorf("createTenantIngress() rules = %v, want %v", len(rules), tt.wantRules)
+			}
+			host, _, _ := unstructured.NestedString(rules[0].(map[string]interface{}), "host")
+			if host != tt.wantDomain {
+				t.Errorf("createTenantIngress() first rule host = %v, want %v", host, tt.wantDomain)
+			}
+			tls, _, _ := unstructured.NestedSlice(ingress.Object, "spec", "tls")
+			if (len(tls) > 0) != tt.wantTLS {
+				t.Errorf("createTenantIngress() tls = %v, want %v", tls, tt.wantTLS)
+			}
+			class, _, _ := unstructured.NestedString(ingress.Object, "spec", "ingressClassName")
+			if class != tt.wantClass {
+				t.Errorf("createTenantIngress() ingress class = %v, want %v", class, tt.wantClass)
+			}
+			if owners := ingress.GetOwnerReferences(); len(owners) != 1 || owners[0].UID != "1234" {
+				t.Errorf("createTenantIngress() ingress should be owned by the tenant, got %v", owners)
+			}
+			if ingress.GetLabels()[m3TenantLabel] != "tenant-1" {
+				t.Errorf("createTenantIngress() ingress should be labeled with the tenant")
+			}
+			if len(ingress.GetAnnotations()) != len(tt.config.annotations) {
+				t.Errorf("createTenantIngress() annotations = %v, want %v", ingress.GetAnnotations(), tt.config.annotations)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/models"
+	certmanagerv1 "github.com/minio/m3/pkg/apis/certmanager/v1"
+	"github.com/minio/m3/pkg/clientgen/clientset/versioned"
+	"github.com/minio/m3/pkg/logger"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+)
+
+// certManagerIntegrationName enables the cert-manager integration on M3_INTEGRATIONS
+const certManagerIntegrationName = "cert-manager"
+
+// certManagerSecretPollInterval how often the Secret of a new certificate is looked for
+const certManagerSecretPollInterval = 5 * time.Second
+
+func init() {
+	RegisterIntegration(certManagerIntegrationName, newCertManagerIntegration)
+}
+
+// certManagerClients are the clients used by the cert-manager integration on behalf of a user
+type certManagerClients struct {
+	certs    versioned.Interface
+	k8s      K8sClient
+	dynamic  dynamic.Interface
+	operator OperatorClient
+}
+
+func newCertManagerClients(token string) (*certManagerClients, error) {
+	certs, err := versioned.NewForConfig(cluster.GetK8sConfig(token))
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := cluster.K8sClient(token)
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := cluster.DynamicClient(token)
+	if err != nil {
+		return nil, err
+	}
+	opClientClientSet, err := cluster.OperatorClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return &certManagerClients{
+		certs:    certs,
+		k8s:      &k8sClient{client: clientset},
+		dynamic:  dynamicClient,
+		operator: &operatorClient{client: opClientClientSet},
+	}, nil
+}
+
+// certManagerIntegration requests a cert-manager.io/v1 Certificate for the S3 and console hosts of every
+// tenant, the hosts are built with the M3_INGRESS_*_DOMAIN_TEMPLATE templates. Once cert-manager stores the
+// certificate it's used by the tenant Ingress or, when the ingress integration is disabled, by MinIO. MinIO is
+// reached through its services inside the cluster so the certificate is issued for them as well in that case,
+// which requires an issuer able to sign cluster names, ie: a CA issuer
+type certManagerIntegration struct {
+	NoopIntegration
+	issuer        certmanagerv1.IssuerReference
+	hosts         *ingressConfig
+	ingress       bool
+	secretTimeout time.Duration
+	newClients    func(token string) (*certManagerClients, error)
+}
+
+func newCertManagerIntegration() (Integration, error) {
+	issuer := getCertManagerIssuer()
+	if issuer == "" {
+		return nil, fmt.Errorf("%s is required", M3CertManagerIssuer)
+	}
+	timeout, err := getCertManagerSecretTimeout()
+	if err != nil {
+		return nil, err
+	}
+	hosts, err := getIngressConfig()
+	if err != nil {
+		return nil, err
+	}
+	return &certManagerIntegration{
+		issuer:        certmanagerv1.IssuerReference{Name: issuer, Kind: getCertManagerIssuerKind()},
+		hosts:         hosts,
+		ingress:       sets.NewString(getEnabledIntegrationNames()...).Has(ingressIntegrationName),
+		secretTimeout: timeout,
+		newClients:    newCertManagerClients,
+	}, nil
+}
+
+// Name implements Integration
+func (i *certManagerIntegration) Name() string {
+	return certManagerIntegrationName
+}
+
+// dnsNames returns the hosts the certificate of the tenant is issued for
+func (i *certManagerIntegration) dnsNames(tenant *operator.MinIOInstance) ([]string, error) {
+	hosts, err := i.hosts.hosts(tenant.Name, tenant.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	dnsNames := []string{hosts.Domain}
+	if tenant.HasMCSEnabled() {
+		dnsNames = append(dnsNames, hosts.Console)
+	}
+	if !i.ingress {
+		dnsNames = append(dnsNames, tenantServiceDNSNames(tenant)...)
+	}
+	return dnsNames, nil
+}
+
+// tenantServiceDNSNames returns the names MinIO is reached through inside the cluster, the service used by m3 and
+// the pods behind the headless service used by the MinIO nodes to reach each other
+func tenantServiceDNSNames(tenant *operator.MinIOInstance) []string {
+	tenant = tenant.DeepCopy().EnsureDefaults()
+	return []string{
+		fmt.Sprintf("%s.%s.svc.%s", tenant.MinIOCIServiceName(), tenant.Namespace, operator.ClusterDomain),
+		fmt.Sprintf("*.%s.%s.svc.%s", tenant.MinIOHLServiceName(), tenant.Namespace, operator.ClusterDomain),
+	}
+}
+
+// TenantCreated implements Integration, the Certificate is created right away but cert-manager may take minutes
+// to issue it so the Secret is waited for in the background, up to M3_CERT_MANAGER_SECRET_TIMEOUT, with the service
+// account of m3 since the request is long gone by then. Tenants created with another exposure are skipped since
+// their hosts aren't the ones of the ingress
+func (i *certManagerIntegration) TenantCreated(ctx context.Context, req *IntegrationRequest) error {
+	if !exposedByIntegrations(req.Tenant) {
+		return nil
+	}
+	clients, err := i.newClients(req.Token)
+	if err != nil {
+		return err
+	}
+	dnsNames, err := i.dnsNames(req.Tenant)
+	if err != nil {
+		return err
+	}
+	if _, err := createTenantCertificate(ctx, clients.certs, req.Tenant, dnsNames, i.issuer); err != nil {
+		return err
+	}
+	bgClients, err := i.newClients(cluster.GetServiceAccountToken())
+	if err != nil {
+		return err
+	}
+	bgCtx := logger.WithFields(context.Background(), logrus.Fields{
+		logger.FieldNamespace: req.Tenant.Namespace,
+		logger.FieldTenant:    req.Tenant.Name,
+		"integration":         certManagerIntegrationName,
+	})
+	go func() {
+		ctx, cancel := context.WithTimeout(bgCtx, i.secretTimeout)
+		defer cancel()
+		if err := useTenantCertificate(ctx, bgClients, req.Tenant, dnsNames, !i.ingress, certManagerSecretPollInterval); err != nil {
+			logger.FromContext(ctx).WithError(err).Error("error setting up the tenant certificate")
+			return
+		}
+		logger.FromContext(ctx).Info("tenant certificate issued")
+	}()
+	return nil
+}
+
+// TenantDeleted implements Integration, the Secret is removed as well since cert-manager doesn't own it
+func (i *certManagerIntegration) TenantDeleted(ctx context.Context, req *IntegrationRequest) ([]*models.RemovedResource, error) {
+	clients, err := i.newClients(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	name := tenantCertificateName(req.Tenant.Name)
+	removed, errs := trackRemoved(nil, nil, "Certificate", name,
+		clients.certs.CertmanagerV1().Certificates(req.Tenant.Namespace).Delete(ctx, name, metav1.DeleteOptions{}))
+	removed, errs = trackRemoved(removed, errs, "Secret", name,
+		clients.k8s.deleteSecret(ctx, req.Tenant.Namespace, name, metav1.DeleteOptions{}))
+	return removed, utilerrors.NewAggregate(errs)
+}
+
+// Status implements Integration, it reports the Ready condition of the Certificate and its expiry
+func (i *certManagerIntegration) Status(ctx context.Context, req *IntegrationRequest) *models.IntegrationStatus {
+	if !exposedByIntegrations(req.Tenant) {
+		return nil
+	}
+	clients, err := i.newClients(req.Token)
+	if err != nil {
+		return &models.IntegrationStatus{State: models.IntegrationStatusStateError, Message: err.Error()}
+	}
+	cert, err := clients.certs.CertmanagerV1().Certificates(req.Tenant.Namespace).Get(ctx, tenantCertificateName(req.Tenant.Name), metav1.GetOptions{})
+	if err != nil {
+		return &models.IntegrationStatus{State: models.IntegrationStatusStateError, Message: err.Error()}
+	}
+	return certificateIntegrationStatus(cert)
+}
+
+// newTenantCertificate returns the Certificate of the tenant, it's owned by the MinIOInstance
+func newTenantCertificate(tenant *operator.MinIOInstance, dnsNames []string, issuer certmanagerv1.IssuerReference) *certmanagerv1.Certificate {
+	name := tenantCertificateName(tenant.Name)
+	return &certmanagerv1.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       tenant.Namespace,
+			Labels:          map[string]string{m3TenantLabel: tenant.Name},
+			OwnerReferences: tenant.OwnerRef(),
+		},
+		Spec: certmanagerv1.CertificateSpec{
+			CommonName: dnsNames[0],
+			DNSNames:   dnsNames,
+			SecretName: name,
+			IssuerRef:  issuer,
+		},
+	}
+}
+
+// createTenantCertificate requests a certificate for the tenant hosts to cert-manager
+func createTenantCertificate(ctx context.Context, client versioned.Interface, tenant *operator.MinIOInstance, dnsNames []string, issuer certmanagerv1.IssuerReference) (*certmanagerv1.Certificate, error) {
+	cert := newTenantCertificate(tenant, dnsNames, issuer)
+	return client.CertmanagerV1().Certificates(tenant.Namespace).Create(ctx, cert, metav1.CreateOptions{})
+}
+
+// useTenantCertificate waits until cert-manager stores the tenant certificate and sets it as the TLS
+// certificate of the tenant Ingress or, if the tenant doesn't have one and the certificate was issued for the
+// services of the tenant, as the MinIO certificate
+func useTenantCertificate(ctx context.Context, clients *certManagerClients, tenant *operator.MinIOInstance, dnsNames []string, servicesIncluded bool, pollInterval time.Duration) error {
+	secretName := tenantCertificateName(tenant.Name)
+	err := wait.PollImmediateUntil(pollInterval, func() (bool, error) {
+		_, err := clients.k8s.getSecret(ctx, tenant.Namespace, secretName, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return false, nil
+		}
+		return err == nil, err
+	}, ctx.Done())
+	if err == wait.ErrWaitTimeout {
+		return fmt.Errorf("timed out waiting for secret %s: %w", secretName, ctx.Err())
+	}
+	if err != nil {
+		return err
+	}
+
+	ingresses := clients.dynamic.Resource(ingressGVR).Namespace(tenant.Namespace)
+	ingress, err := ingresses.Get(ctx, tenantIngressName(tenant.Name), metav1.GetOptions{})
+	switch {
+	case err == nil:
+		var hosts []string
+		for _, name := range dnsNames {
+			if !strings.HasSuffix(name, ".svc."+operator.ClusterDomain) {
+				hosts = append(hosts, name)
+			}
+		}
+		tls := []interface{}{map[string]interface{}{
+			"hosts":      stringsToInterfaces(hosts),
+			"secretName": secretName,
+		}}
+		if err := unstructured.SetNestedSlice(ingress.Object, tls, "spec", "tls"); err != nil {
+			return err
+		}
+		_, err = ingresses.Update(ctx, ingress, metav1.UpdateOptions{})
+		return err
+	case apierrors.IsNotFound(err) && !servicesIncluded:
+		return fmt.Errorf("ingress %s not found, certificate %s is only valid for it", tenantIngressName(tenant.Name), secretName)
+	case apierrors.IsNotFound(err):
+		// the secret is a kubernetes.io/tls one, ca.crt is only set by some issuers
+		patch, err := json.Marshal(map[string]interface{}{
+			"spec": map[string]interface{}{
+				"requestAutoCert": false,
+				"externalCertSecret": &operator.LocalCertificateReference{
+					Name: secretName,
+					Type: string(corev1.SecretTypeTLS),
+				},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		_, err = clients.operator.MinIOInstancePatch(ctx, tenant.Namespace, tenant.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	default:
+		return err
+	}
+}
+
+// certificateIntegrationStatus translates the status of a Certificate, it's ready while the Ready condition is true
+func certificateIntegrationStatus(cert *certmanagerv1.Certificate) *models.IntegrationStatus {
+	certStatus := &models.CertificateStatus{
+		Name:     cert.Name,
+		Secret:   cert.Spec.SecretName,
+		Issuer:   fmt.Sprintf("%s/%s", cert.Spec.IssuerRef.Kind, cert.Spec.IssuerRef.Name),
+		DNSNames: cert.Spec.DNSNames,
+	}
+	if cert.Status.NotBefore != nil {
+		certStatus.NotBefore = strfmt.DateTime(cert.Status.NotBefore.Time)
+	}
+	if cert.Status.NotAfter != nil {
+		certStatus.NotAfter = strfmt.DateTime(cert.Status.NotAfter.Time)
+	}
+	if cert.Status.RenewalTime != nil {
+		certStatus.RenewalTime = strfmt.DateTime(cert.Status.RenewalTime.Time)
+	}
+	status := &models.IntegrationStatus{
+		State:       models.IntegrationStatusStatePending,
+		Message:     fmt.Sprintf("certificate %s is being issued", cert.Name),
+		Certificate: certStatus,
+	}
+	for _, condition := range cert.Status.Conditions {
+		if condition.Type != certmanagerv1.CertificateConditionReady {
+			continue
+		}
+		if condition.Message != "" {
+			status.Message = condition.Message
+		}
+		if condition.Status == certmanagerv1.ConditionTrue {
+			certStatus.Ready = true
+			status.State = models.IntegrationStatusStateReady
+		}
+	}
+	if certStatus.Ready && cert.Status.NotAfter != nil && cert.Status.NotAfter.Time.Before(time.Now()) {
+		certStatus.Ready = false
+		status.State = models.IntegrationStatusStateError
+		status.Message = fmt.Sprintf("certificate %s expired on %s", cert.Name, cert.Status.NotAfter.Time.Format(time.RFC3339))
+	}
+	return status
+}
+
+func stringsToInterfaces(values []string) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, value := range values {
+		result[i] = value
+	}
+	return result
+}
@@ -0,0 +1,287 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/minio/m3/models"
+	certmanagerv1 "github.com/minio/m3/pkg/apis/certmanager/v1"
+	fakeclientset "github.com/minio/m3/pkg/clientgen/clientset/versioned/fake"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+)
+
+func certManagerTestTenant() *operator.MinIOInstance {
+	return &operator.MinIOInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-1", Namespace: "tenants", UID: "1234"},
+		Spec: operator.MinIOInstanceSpec{
+			ServiceName: "tenant-1",
+			MCS:         &operator.MCSConfig{Replicas: 1},
+		},
+	}
+}
+
+func Test_certManagerIntegration(t *testing.T) {
+	tenant := certManagerTestTenant()
+	certs := fakeclientset.NewSimpleClientset()
+	integration := &certManagerIntegration{
+		issuer: certmanagerv1.IssuerReference{Name: "letsencrypt", Kind: "ClusterIssuer"},
+		hosts: &ingressConfig{
+			domainTemplate:        "{{.Tenant}}.example.com",
+			consoleDomainTemplate: "console.{{.Domain}}",
+		},
+		ingress: true,
+		newClients: func(token string) (*certManagerClients, error) {
+			return &certManagerClients{certs: certs, k8s: k8sClientMock{}}, nil
+		},
+	}
+	ctx := context.Background()
+	req := &IntegrationRequest{Tenant: tenant}
+
+	dnsNames, err := integration.dnsNames(tenant)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"tenant-1.example.com", "console.tenant-1.example.com"}; !reflect.DeepEqual(dnsNames, want) {
+		t.Errorf("dnsNames() = %v, want %v", dnsNames, want)
+	}
+	// without the ingress integration MinIO serves the certificate to m3 and to the other nodes
+	integration.ingress = false
+	serviceNames, err := integration.dnsNames(tenant)
+	if want := append(dnsNames, "tenant-1.tenants.svc."+operator.ClusterDomain, "*.tenant-1-hl-svc.tenants.svc."+operator.ClusterDomain); err != nil || !reflect.DeepEqual(serviceNames, want) {
+		t.Errorf("dnsNames() = %v, %v, want %v", serviceNames, err, want)
+	}
+	integration.ingress = true
+	cert, err := createTenantCertificate(ctx, certs, tenant, dnsNames, integration.issuer)
+	if err != nil {
+		t.Fatalf("createTenantCertificate() error = %v", err)
+	}
+	if cert.Spec.SecretName != "tenant-1-tls" || cert.Spec.IssuerRef.Name != "letsencrypt" || len(cert.OwnerReferences) != 1 {
+		t.Errorf("createTenantCertificate() = %+v", cert)
+	}
+	if status := integration.Status(ctx, req); status.State != models.IntegrationStatusStatePending {
+		t.Errorf("Status() = %v, want %v", status.State, models.IntegrationStatusStatePending)
+	}
+
+	k8sClientDeleteSecretMock = existingResources("tenant-1-tls")
+	removed, err := integration.TenantDeleted(ctx, req)
+	if err != nil || len(removed) != 2 {
+		t.Errorf("TenantDeleted() = %v, %v", removed, err)
+	}
+	if status := integration.Status(ctx, req); status.State != models.IntegrationStatusStateError {
+		t.Errorf("Status() = %v, want %v", status.State, models.IntegrationStatusStateError)
+	}
+
+	// tenants exposed through a load balancer don't get a certificate
+	lbTenant := certManagerTestTenant()
+	lbTenant.Annotations = map[string]string{m3ExposureAnnotation: models.CreateTenantRequestExposureLoadBalancer}
+	lbReq := &IntegrationRequest{Tenant: lbTenant}
+	if err := integration.TenantCreated(ctx, lbReq); err != nil {
+		t.Errorf("TenantCreated() error = %v", err)
+	}
+	if certs, _ := certs.CertmanagerV1().Certificates("tenants").List(ctx, metav1.ListOptions{}); len(certs.Items) != 0 {
+		t.Errorf("TenantCreated() created %d certificates for a load-balancer tenant", len(certs.Items))
+	}
+	if status := integration.Status(ctx, lbReq); status != nil {
+		t.Errorf("Status() = %+v, want nil", status)
+	}
+}
+
+func Test_useTenantCertificate(t *testing.T) {
+	tenant := certManagerTestTenant()
+	dnsNames := append([]string{"tenant-1.example.com"}, tenantServiceDNSNames(tenant)...)
+	tenantIngress := func() runtime.Object {
+		ingress, _, err := newTenantIngress(tenant, &ingressConfig{
+			domainTemplate:        "{{.Tenant}}.example.com",
+			consoleDomainTemplate: "console.{{.Domain}}",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ingress
+	}
+	secretFound := func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Secret, error) {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: name}}, nil
+	}
+	tests := []struct {
+		name        string
+		getSecret   func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Secret, error)
+		objects     []runtime.Object
+		hostsOnly   bool
+		wantIngress bool
+		wantPatch   bool
+		wantErr     bool
+	}{
+		{
+			name:        "Tenant exposed through an Ingress",
+			getSecret:   secretFound,
+			objects:     []runtime.Object{tenantIngress()},
+			wantIngress: true,
+		},
+		{
+			name:      "Tenant without Ingress",
+			getSecret: secretFound,
+			wantPatch: true,
+		},
+		{
+			name:      "Tenant without Ingress and a certificate of its hosts",
+			getSecret: secretFound,
+			hostsOnly: true,
+			wantErr:   true,
+		},
+		{
+			name: "Certificate never issued",
+			getSecret: func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Secret, error) {
+				return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClientGetSecretMock = tt.getSecret
+			var patch []byte
+			opClientMinioInstancePatchMock = func(ctx context.Context, namespace string, instanceName string, pt types.PatchType, data []byte, options metav1.PatchOptions) (*operator.MinIOInstance, error) {
+				patch = data
+				return tenant, nil
+			}
+			clients := &certManagerClients{
+				k8s:      k8sClientMock{},
+				dynamic:  fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), tt.objects...),
+				operator: opClientMock{},
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+			defer cancel()
+
+			names := dnsNames
+			if tt.hostsOnly {
+				names = dnsNames[:1]
+			}
+			err := useTenantCertificate(ctx, clients, tenant, names, !tt.hostsOnly, 10*time.Millisecond)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("useTenantCertificate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantIngress {
+				ingress, err := clients.dynamic.Resource(ingressGVR).Namespace(tenant.Namespace).Get(ctx, tenantIngressName(tenant.Name), metav1.GetOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+				// the names of the services aren't hosts of the ingress
+				tls, _, _ := unstructured.NestedSlice(ingress.Object, "spec", "tls")
+				if len(tls) != 1 || tls[0].(map[string]interface{})["secretName"] != "tenant-1-tls" ||
+					!reflect.DeepEqual(tls[0].(map[string]interface{})["hosts"], []interface{}{"tenant-1.example.com"}) {
+					t.Errorf("useTenantCertificate() ingress tls = %v", tls)
+				}
+			}
+			if (patch != nil) != tt.wantPatch {
+				t.Fatalf("useTenantCertificate() patched the tenant = %v, want %v", patch != nil, tt.wantPatch)
+			}
+			if tt.wantPatch {
+				var minInst operator.MinIOInstance
+				if err := json.Unmarshal(patch, &minInst); err != nil {
+					t.Fatal(err)
+				}
+				if minInst.Spec.ExternalCertSecret == nil || minInst.Spec.ExternalCertSecret.Name != "tenant-1-tls" ||
+					minInst.Spec.ExternalCertSecret.Type != "kubernetes.io/tls" {
+					t.Errorf("useTenantCertificate() patch = %s", patch)
+				}
+			}
+		})
+	}
+}
+
+func Test_certificateIntegrationStatus(t *testing.T) {
+	notAfter := metav1.NewTime(time.Now().Add(30 * 24 * time.Hour))
+	expired := metav1.NewTime(time.Now().Add(-time.Hour))
+	certificate := func(ready certmanagerv1.ConditionStatus, message string, notAfter *metav1.Time) *certmanagerv1.Certificate {
+		cert := &certmanagerv1.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenant-1-tls"},
+			Spec: certmanagerv1.CertificateSpec{
+				SecretName: "tenant-1-tls",
+				IssuerRef:  certmanagerv1.IssuerReference{Name: "letsencrypt", Kind: "ClusterIssuer"},
+			},
+			Status: certmanagerv1.CertificateStatus{NotAfter: notAfter},
+		}
+		if ready != "" {
+			cert.Status.Conditions = []certmanagerv1.CertificateCondition{
+				{Type: certmanagerv1.CertificateConditionReady, Status: ready, Message: message},
+			}
+		}
+		return cert
+	}
+	tests := []struct {
+		name        string
+		cert        *certmanagerv1.Certificate
+		wantState   string
+		wantReady   bool
+		wantMessage string
+	}{
+		{
+			name:      "Not processed yet",
+			cert:      certificate("", "", nil),
+			wantState: models.IntegrationStatusStatePending,
+		},
+		{
+			name:        "Issuing",
+			cert:        certificate(certmanagerv1.ConditionFalse, "Issuing certificate as Secret does not exist", nil),
+			wantState:   models.IntegrationStatusStatePending,
+			wantMessage: "Issuing certificate as Secret does not exist",
+		},
+		{
+			name:        "Ready",
+			cert:        certificate(certmanagerv1.ConditionTrue, "Certificate is up to date and has not expired", &notAfter),
+			wantState:   models.IntegrationStatusStateReady,
+			wantReady:   true,
+			wantMessage: "Certificate is up to date and has not expired",
+		},
+		{
+			name:        "Expired",
+			cert:        certificate(certmanagerv1.ConditionTrue, "Certificate is up to date and has not expired", &expired),
+			wantState:   models.IntegrationStatusStateError,
+			wantMessage: "certificate tenant-1-tls expired on " + expired.Time.Format(time.RFC3339),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := certificateIntegrationStatus(tt.cert)
+			if got.State != tt.wantState {
+				t.Errorf("certificateIntegrationStatus() state = %v, want %v", got.State, tt.wantState)
+			}
+			if got.Certificate.Ready != tt.wantReady {
+				t.Errorf("certificateIntegrationStatus() ready = %v, want %v", got.Certificate.Ready, tt.wantReady)
+			}
+			if tt.wantMessage != "" && got.Message != tt.wantMessage {
+				t.Errorf("certificateIntegrationStatus() message = %v, want %v", got.Message, tt.wantMessage)
+			}
+			if got.Certificate.Issuer != "ClusterIssuer/letsencrypt" {
+				t.Errorf("certificateIntegrationStatus() issuer = %v", got.Certificate.Issuer)
+			}
+		})
+	}
+}
@@ -0,0 +1,336 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/models"
+	gatewayv1 "github.com/minio/m3/pkg/apis/gateway/v1"
+	"github.com/minio/m3/pkg/clientgen/clientset/versioned"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// gatewayIntegrationName enables the Gateway API integration on M3_INTEGRATIONS
+const gatewayIntegrationName = "gateway"
+
+func init() {
+	RegisterIntegration(gatewayIntegrationName, func() (Integration, error) {
+		config, err := getGatewayConfig()
+		if err != nil {
+			return nil, err
+		}
+		return &gatewayIntegration{
+			config: config,
+			newClient: func(token string) (versioned.Interface, error) {
+				return versioned.NewForConfig(cluster.GetK8sConfig(token))
+			},
+		}, nil
+	})
+}
+
+// gatewayConfig describes how tenants are exposed through the HTTPRoutes attached to a Gateway
+type gatewayConfig struct {
+	name string
+	// namespace of the Gateway, the namespace of the tenant when empty
+	namespace             string
+	sectionName           string
+	domainTemplate        string
+	consoleDomainTemplate string
+	tls                   bool
+}
+
+// getGatewayConfig returns the exposure configuration set through the M3_GATEWAY_* env variables
+func getGatewayConfig() (*gatewayConfig, error) {
+	config := &gatewayConfig{
+		name:                  getGatewayName(),
+		namespace:             getGatewayNamespace(),
+		sectionName:           getGatewaySectionName(),
+		domainTemplate:        getGatewayDomainTemplate(),
+		consoleDomainTemplate: getGatewayConsoleDomainTemplate(),
+		tls:                   getGatewayTLS(),
+	}
+	if config.name == "" {
+		return nil, errors.New(M3GatewayName + " is required by the gateway integration")
+	}
+	return config, nil
+}
+
+// hosts renders the hostnames of the tenant
+func (c *gatewayConfig) hosts(tenant *operator.MinIOInstance) (*tenantHosts, error) {
+	hosts, err := renderTenantHosts(c.domainTemplate, c.consoleDomainTemplate, tenant.Name, tenant.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !tenant.HasMCSEnabled() {
+		hosts.Console = ""
+	}
+	return hosts, nil
+}
+
+// gatewayName returns the namespaced name of the Gateway the routes of the tenant are attached to
+func (c *gatewayConfig) gatewayName(tenant *operator.MinIOInstance) string {
+	namespace := c.namespace
+	if namespace == "" {
+		namespace = tenant.Namespace
+	}
+	return namespace + "/" + c.name
+}
+
+// parentRef returns the reference to the Gateway set on every route
+func (c *gatewayConfig) parentRef() gatewayv1.ParentReference {
+	ref := gatewayv1.ParentReference{Name: c.name}
+	if c.namespace != "" {
+		namespace := c.namespace
+		ref.Namespace = &namespace
+	}
+	if c.sectionName != "" {
+		sectionName := c.sectionName
+		ref.SectionName = &sectionName
+	}
+	return ref
+}
+
+// newTenantHTTPRoute returns the route sending the requests for the hostname to the service of the tenant, it's
+// owned by the MinIOInstance so it's garbage collected with it
+func (c *gatewayConfig) newTenantHTTPRoute(tenant *operator.MinIOInstance, name, hostname, service string, port int32) *gatewayv1.HTTPRoute {
+	pathType := gatewayv1.PathMatchPathPrefix
+	path := "/"
+	return &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       tenant.Namespace,
+			Labels:          map[string]string{m3TenantLabel: tenant.Name},
+			OwnerReferences: tenant.OwnerRef(),
+		},
+		Spec: gatewayv1.HTTPRouteSpec{
+			ParentRefs: []gatewayv1.ParentReference{c.parentRef()},
+			Hostnames:  []gatewayv1.Hostname{gatewayv1.Hostname(hostname)},
+			Rules: []gatewayv1.HTTPRouteRule{
+				{
+					Matches:     []gatewayv1.HTTPRouteMatch{{Path: &gatewayv1.HTTPPathMatch{Type: &pathType, Value: &path}}},
+					BackendRefs: []gatewayv1.HTTPBackendRef{{Name: service, Port: &port}},
+				},
+			},
+		},
+	}
+}
+
+// newTenantHTTPRoutes returns the route of the S3 service of the tenant and, when enabled, the one of its console
+func (c *gatewayConfig) newTenantHTTPRoutes(tenant *operator.MinIOInstance) ([]*gatewayv1.HTTPRoute, error) {
+	hosts, err := c.hosts(tenant)
+	if err != nil {
+		return nil, err
+	}
+	serviceName := tenant.MinIOCIServiceName()
+	if serviceName == "" {
+		serviceName = tenant.Name
+	}
+	routes := []*gatewayv1.HTTPRoute{
+		c.newTenantHTTPRoute(tenant, tenantHTTPRouteName(tenant.Name), hosts.Domain, serviceName, operator.MinIOPort),
+	}
+	if tenant.HasMCSEnabled() {
+		routes = append(routes, c.newTenantHTTPRoute(tenant, tenantMCSHTTPRouteName(tenant.Name), hosts.Console, tenant.MCSCIServiceName(), operator.MCSPort))
+	}
+	return routes, nil
+}
+
+// exposedByGateway returns true for the tenants created with the gateway exposure and for the ones left to the
+// integrations enabled on m3
+func exposedByGateway(tenant *operator.MinIOInstance) bool {
+	return tenantExposure(tenant) == models.CreateTenantRequestExposureGateway || exposedByIntegrations(tenant)
+}
+
+// gatewayIntegration exposes the tenants through HTTPRoutes attached to the configured Gateway, one for the S3
+// hostname and one for the console hostname, tenants created with the load-balancer exposure are skipped
+type gatewayIntegration struct {
+	NoopIntegration
+	config    *gatewayConfig
+	newClient func(token string) (versioned.Interface, error)
+}
+
+// Name implements Integration
+func (g *gatewayIntegration) Name() string {
+	return gatewayIntegrationName
+}
+
+// TenantCreated implements Integration
+func (g *gatewayIntegration) TenantCreated(ctx context.Context, req *IntegrationRequest) error {
+	if !exposedByGateway(req.Tenant) {
+		return nil
+	}
+	client, err := g.newClient(req.Token)
+	if err != nil {
+		return err
+	}
+	routes, err := g.config.newTenantHTTPRoutes(req.Tenant)
+	if err != nil {
+		return err
+	}
+	for _, route := range routes {
+		if _, err := client.GatewayV1().HTTPRoutes(req.Tenant.Namespace).Create(ctx, route, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TenantDeleted implements Integration, the routes are owned by the MinIOInstance but they are removed right
+// away so the Gateway stops routing to the tenant
+func (g *gatewayIntegration) TenantDeleted(ctx context.Context, req *IntegrationRequest) ([]*models.RemovedResource, error) {
+	if !exposedByGateway(req.Tenant) {
+		return nil, nil
+	}
+	client, err := g.newClient(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	var removed []*models.RemovedResource
+	var errs []error
+	for _, name := range []string{tenantHTTPRouteName(req.Tenant.Name), tenantMCSHTTPRouteName(req.Tenant.Name)} {
+		removed, errs = trackRemoved(removed, errs, "HTTPRoute", name,
+			client.GatewayV1().HTTPRoutes(req.Tenant.Namespace).Delete(ctx, name, metav1.DeleteOptions{}))
+	}
+	return removed, utilerrors.NewAggregate(errs)
+}
+
+// TenantHosts implements tenantHostsProvider
+func (g *gatewayIntegration) TenantHosts(tenant *operator.MinIOInstance) (string, string, error) {
+	if !exposedByGateway(tenant) {
+		return "", "", nil
+	}
+	hosts, err := g.config.hosts(tenant)
+	if err != nil {
+		return "", "", err
+	}
+	return hosts.Domain, hosts.Console, nil
+}
+
+// TenantS3Endpoint implements tenantHostsProvider, the listener of the Gateway serves the default port of its
+// scheme
+func (g *gatewayIntegration) TenantS3Endpoint(tenant *operator.MinIOInstance) (string, bool, error) {
+	s3Host, _, err := g.TenantHosts(tenant)
+	return s3Host, g.config.tls, err
+}
+
+// Status implements Integration, it reports the conditions set on the routes by the controller of the Gateway,
+// the integration is ready once the Gateway accepted every route
+func (g *gatewayIntegration) Status(ctx context.Context, req *IntegrationRequest) *models.IntegrationStatus {
+	if !exposedByGateway(req.Tenant) {
+		return nil
+	}
+	client, err := g.newClient(req.Token)
+	if err != nil {
+		return &models.IntegrationStatus{State: models.IntegrationStatusStateError, Message: err.Error()}
+	}
+	names := []string{tenantHTTPRouteName(req.Tenant.Name)}
+	if req.Tenant.HasMCSEnabled() {
+		names = append(names, tenantMCSHTTPRouteName(req.Tenant.Name))
+	}
+	gateway := g.config.gatewayName(req.Tenant)
+	status := &models.IntegrationStatus{State: models.IntegrationStatusStateReady}
+	var pending, rejected []string
+	for _, name := range names {
+		route, err := client.GatewayV1().HTTPRoutes(req.Tenant.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return &models.IntegrationStatus{State: models.IntegrationStatusStateError, Message: fmt.Sprintf("httproute %s not found", name)}
+			}
+			return &models.IntegrationStatus{State: models.IntegrationStatusStateError, Message: err.Error()}
+		}
+		routeStatus := httpRouteStatus(route, gateway, g.config)
+		status.Routes = append(status.Routes, routeStatus)
+		switch accepted := httpRouteAccepted(route, g.config); {
+		case accepted == nil:
+			pending = append(pending, name)
+		case accepted.Status != gatewayv1.ConditionTrue:
+			rejected = append(rejected, fmt.Sprintf("%s: %s", name, accepted.Message))
+		}
+	}
+	switch {
+	case len(rejected) > 0:
+		status.State = models.IntegrationStatusStateError
+		status.Message = fmt.Sprintf("gateway %s didn't accept %s", gateway, strings.Join(rejected, ", "))
+	case len(pending) > 0:
+		status.State = models.IntegrationStatusStatePending
+		status.Message = fmt.Sprintf("waiting for gateway %s to accept %s", gateway, strings.Join(pending, ", "))
+	}
+	return status
+}
+
+// httpRouteParentStatus returns the status written on the route by the controller of the configured Gateway
+func httpRouteParentStatus(route *gatewayv1.HTTPRoute, config *gatewayConfig) *gatewayv1.RouteParentStatus {
+	for i, parent := range route.Status.Parents {
+		namespace := route.Namespace
+		if parent.ParentRef.Namespace != nil {
+			namespace = *parent.ParentRef.Namespace
+		}
+		if config.namespace != "" && namespace != config.namespace {
+			continue
+		}
+		if parent.ParentRef.Name == config.name {
+			return &route.Status.Parents[i]
+		}
+	}
+	return nil
+}
+
+// httpRouteAccepted returns the Accepted condition of the route on the configured Gateway, nil until the
+// controller of the Gateway reports it
+func httpRouteAccepted(route *gatewayv1.HTTPRoute, config *gatewayConfig) *gatewayv1.Condition {
+	parent := httpRouteParentStatus(route, config)
+	if parent == nil {
+		return nil
+	}
+	for i, condition := range parent.Conditions {
+		if condition.Type == string(gatewayv1.RouteConditionAccepted) {
+			return &parent.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// httpRouteStatus returns the status of the route reported on the tenant info
+func httpRouteStatus(route *gatewayv1.HTTPRoute, gateway string, config *gatewayConfig) *models.RouteStatus {
+	status := &models.RouteStatus{Name: route.Name, Gateway: gateway}
+	for _, hostname := range route.Spec.Hostnames {
+		status.Hostnames = append(status.Hostnames, string(hostname))
+	}
+	if accepted := httpRouteAccepted(route, config); accepted != nil {
+		status.Accepted = accepted.Status == gatewayv1.ConditionTrue
+	}
+	if parent := httpRouteParentStatus(route, config); parent != nil {
+		for _, condition := range parent.Conditions {
+			status.Conditions = append(status.Conditions, &models.RouteCondition{
+				Type:               condition.Type,
+				Status:             string(condition.Status),
+				Reason:             condition.Reason,
+				Message:            condition.Message,
+				LastTransitionTime: strfmt.DateTime(condition.LastTransitionTime.Time),
+			})
+		}
+	}
+	return status
+}
@@ -0,0 +1,171 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/minio/m3/models"
+	gatewayv1 "github.com/minio/m3/pkg/apis/gateway/v1"
+	"github.com/minio/m3/pkg/clientgen/clientset/versioned"
+	fakeclientset "github.com/minio/m3/pkg/clientgen/clientset/versioned/fake"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_gatewayIntegration(t *testing.T) {
+	config := &gatewayConfig{
+		name:                  "public",
+		namespace:             "gateways",
+		sectionName:           "https",
+		domainTemplate:        "{{.Tenant}}.{{.Namespace}}.example.com",
+		consoleDomainTemplate: "console.{{.Domain}}",
+		tls:                   true,
+	}
+	tenant := &operator.MinIOInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-1", Namespace: "tenants", UID: "1234"},
+		Spec:       operator.MinIOInstanceSpec{ServiceName: "tenant-1", MCS: &operator.MCSConfig{Replicas: 2}},
+	}
+	client := fakeclientset.NewSimpleClientset()
+	integration := &gatewayIntegration{
+		config: config,
+		newClient: func(token string) (versioned.Interface, error) {
+			return client, nil
+		},
+	}
+	req := &IntegrationRequest{Tenant: tenant}
+	if err := integration.TenantCreated(context.Background(), req); err != nil {
+		t.Fatalf("TenantCreated() error = %v", err)
+	}
+
+	wantHosts := map[string]string{
+		"tenant-1-route":     "tenant-1.tenants.example.com",
+		"tenant-1-mcs-route": "console.tenant-1.tenants.example.com",
+	}
+	routes, err := client.GatewayV1().HTTPRoutes("tenants").List(context.Background(), metav1.ListOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(routes.Items) != 2 {
+		t.Fatalf("TenantCreated() created %d routes, want 2", len(routes.Items))
+	}
+	for _, route := range routes.Items {
+		if len(route.Spec.Hostnames) != 1 || string(route.Spec.Hostnames[0]) != wantHosts[route.Name] {
+			t.Errorf("route %s hostnames = %v, want %s", route.Name, route.Spec.Hostnames, wantHosts[route.Name])
+		}
+		parent := route.Spec.ParentRefs[0]
+		if parent.Name != "public" || *parent.Namespace != "gateways" || *parent.SectionName != "https" {
+			t.Errorf("route %s parent = %+v", route.Name, parent)
+		}
+		if len(route.OwnerReferences) != 1 {
+			t.Errorf("route %s should be owned by the tenant", route.Name)
+		}
+	}
+	if s3Host, consoleHost, _ := integration.TenantHosts(tenant); s3Host != wantHosts["tenant-1-route"] || consoleHost != wantHosts["tenant-1-mcs-route"] {
+		t.Errorf("TenantHosts() = %s, %s", s3Host, consoleHost)
+	}
+	if endpoint, secure, _ := integration.TenantS3Endpoint(tenant); endpoint != wantHosts["tenant-1-route"] || !secure {
+		t.Errorf("TenantS3Endpoint() = %s, %v", endpoint, secure)
+	}
+
+	// the routes are pending until the controller of the gateway reports them
+	if status := integration.Status(context.Background(), req); status.State != models.IntegrationStatusStatePending || len(status.Routes) != 2 {
+		t.Errorf("Status() = %+v, want pending with 2 routes", status)
+	}
+
+	setAccepted := func(name string, accepted gatewayv1.ConditionStatus, message string) {
+		route, err := client.GatewayV1().HTTPRoutes("tenants").Get(context.Background(), name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		namespace := "gateways"
+		route.Status.Parents = []gatewayv1.RouteParentStatus{{
+			ParentRef:      gatewayv1.ParentReference{Name: "public", Namespace: &namespace},
+			ControllerName: "example.com/gateway-controller",
+			Conditions: []gatewayv1.Condition{
+				{Type: string(gatewayv1.RouteConditionAccepted), Status: accepted, Reason: "Accepted", Message: message},
+				{Type: string(gatewayv1.RouteConditionResolvedRefs), Status: gatewayv1.ConditionTrue, Reason: "ResolvedRefs"},
+			},
+		}}
+		if _, err := client.GatewayV1().HTTPRoutes("tenants").UpdateStatus(context.Background(), route, metav1.UpdateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	setAccepted("tenant-1-route", gatewayv1.ConditionTrue, "")
+	setAccepted("tenant-1-mcs-route", gatewayv1.ConditionFalse, "hostname not allowed by the listener")
+	status := integration.Status(context.Background(), req)
+	if status.State != models.IntegrationStatusStateError {
+		t.Errorf("Status() state = %s, want error", status.State)
+	}
+	for _, route := range status.Routes {
+		if route.Accepted != (route.Name == "tenant-1-route") || len(route.Conditions) != 2 || route.Gateway != "gateways/public" {
+			t.Errorf("Status() route = %+v", route)
+		}
+	}
+	setAccepted("tenant-1-mcs-route", gatewayv1.ConditionTrue, "")
+	if status := integration.Status(context.Background(), req); status.State != models.IntegrationStatusStateReady {
+		t.Errorf("Status() state = %s, want ready", status.State)
+	}
+
+	removed, err := integration.TenantDeleted(context.Background(), req)
+	if err != nil {
+		t.Fatalf("TenantDeleted() error = %v", err)
+	}
+	if len(removed) != 2 {
+		t.Errorf("TenantDeleted() removed %d routes, want 2", len(removed))
+	}
+}
+
+func Test_exposedByGateway(t *testing.T) {
+	tests := []struct {
+		exposure string
+		want     bool
+	}{
+		{exposure: "", want: true},
+		{exposure: models.CreateTenantRequestExposureGateway, want: true},
+		{exposure: models.CreateTenantRequestExposureLoadBalancer, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.exposure, func(t *testing.T) {
+			tenant := &operator.MinIOInstance{ObjectMeta: metav1.ObjectMeta{Name: "tenant-1", Namespace: "tenants"}}
+			if tt.exposure != "" {
+				tenant.Annotations = map[string]string{m3ExposureAnnotation: tt.exposure}
+			}
+			if got := exposedByGateway(tenant); got != tt.want {
+				t.Errorf("exposedByGateway() = %v, want %v", got, tt.want)
+			}
+			if tt.want {
+				return
+			}
+			integration := &gatewayIntegration{config: &gatewayConfig{name: "public"}, newClient: func(token string) (versioned.Interface, error) {
+				t.Fatal("the tenant isn't exposed by the gateway")
+				return nil, nil
+			}}
+			req := &IntegrationRequest{Tenant: tenant}
+			if err := integration.TenantCreated(context.Background(), req); err != nil {
+				t.Errorf("TenantCreated() error = %v", err)
+			}
+			if removed, err := integration.TenantDeleted(context.Background(), req); err != nil || len(removed) != 0 {
+				t.Errorf("TenantDeleted() = %v, %v", removed, err)
+			}
+			if status := integration.Status(context.Background(), req); status != nil {
+				t.Errorf("Status() = %+v, want nil", status)
+			}
+		})
+	}
+}
@@ -0,0 +1,508 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/models"
+	gkev1beta2 "github.com/minio/m3/pkg/apis/networking.gke.io/v1beta2"
+	gkeClientset "github.com/minio/m3/pkg/clientgen/clientset/versioned"
+	gkeInformers "github.com/minio/m3/pkg/clientgen/informers/externalversions"
+	gkeListers "github.com/minio/m3/pkg/clientgen/listers/networking.gke.io/v1beta2"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/pkg/tracing"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	extensionsBeta1 "k8s.io/api/extensions/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+)
+
+// gkeIntegrationName enables the GKE integration on M3_INTEGRATIONS
+const gkeIntegrationName = "gke"
+
+const (
+	// gkeIngressName shared Ingress where the GKE integration adds the rules of every tenant
+	gkeIngressName = "mkube-ingress"
+	// gkeManagedCertificatesAnnotation lists the ManagedCertificates used by the GKE Ingress
+	gkeManagedCertificatesAnnotation = "networking.gke.io/managed-certificates"
+	// gkeCertificateActive is the status of a ManagedCertificate, and of its domains, once provisioned
+	gkeCertificateActive = "Active"
+)
+
+func init() {
+	RegisterIntegration(gkeIntegrationName, newGKEIntegration)
+}
+
+// gkeIntegration exposes the tenants on the shared GKE Ingress, mkube-ingress, through NodePort services
+// and a ManagedCertificate with the tenant domains, tenants created with another exposure are skipped. The
+// ManagedCertificates are cached through an informer running with m3's service account
+type gkeIntegration struct {
+	NoopIntegration
+	certificates       gkeListers.ManagedCertificateLister
+	certificatesSynced cache.InformerSynced
+	startInformers     func(stopCh <-chan struct{})
+	// stuckAfter is how long a certificate can be provisioning before it's reported as stuck
+	stuckAfter time.Duration
+	// domainTemplate and consoleDomainTemplate render the hosts of the tenants on the shared Ingress
+	domainTemplate        string
+	consoleDomainTemplate string
+}
+
+func newGKEIntegration() (Integration, error) {
+	stuckAfter, err := getGKECertificateStuckAfter()
+	if err != nil {
+		return nil, err
+	}
+	domainTemplate, consoleDomainTemplate := getGKEDomainTemplate(), getGKEConsoleDomainTemplate()
+	// render the templates once so a broken template is reported when the integration is configured
+	if _, err := renderTenantHosts(domainTemplate, consoleDomainTemplate, "tenant", "namespace"); err != nil {
+		return nil, err
+	}
+	client, err := gkeClientset.NewForConfig(cluster.GetK8sConfig(cluster.GetServiceAccountToken()))
+	if err != nil {
+		return nil, err
+	}
+	factory := gkeInformers.NewSharedInformerFactory(client, 10*time.Minute)
+	informer := factory.Networking().V1beta2().ManagedCertificates()
+	return &gkeIntegration{
+		certificates:          informer.Lister(),
+		certificatesSynced:    informer.Informer().HasSynced,
+		startInformers:        factory.Start,
+		stuckAfter:            stuckAfter,
+		domainTemplate:        domainTemplate,
+		consoleDomainTemplate: consoleDomainTemplate,
+	}, nil
+}
+
+// Run implements integrationRunner, it fills the ManagedCertificates cache
+func (g *gkeIntegration) Run(stopCh <-chan struct{}) error {
+	g.startInformers(stopCh)
+	return nil
+}
+
+// Name implements Integration
+func (g *gkeIntegration) Name() string {
+	return gkeIntegrationName
+}
+
+// TenantCreated implements Integration
+func (g *gkeIntegration) TenantCreated(ctx context.Context, req *IntegrationRequest) error {
+	if !exposedByIntegrations(req.Tenant) {
+		return nil
+	}
+	hosts, err := renderTenantHosts(g.domainTemplate, g.consoleDomainTemplate, req.Tenant.Name, req.Tenant.Namespace)
+	if err != nil {
+		return err
+	}
+	clientset, err := cluster.K8sClient(req.Token)
+	if err != nil {
+		return err
+	}
+	return createGKEResources(ctx, clientset, req.Tenant, req.Token, hosts)
+}
+
+// TenantDeleted implements Integration
+func (g *gkeIntegration) TenantDeleted(ctx context.Context, req *IntegrationRequest) ([]*models.RemovedResource, error) {
+	clientset, err := cluster.K8sClient(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	k8sClient := &k8sClient{
+		client: clientset,
+	}
+	mkClientSet, err := gkeClientset.NewForConfig(cluster.GetK8sConfig(req.Token))
+	if err != nil {
+		return nil, err
+	}
+	return deleteGKEResources(ctx, k8sClient, mkClientSet, req.Tenant.Namespace, req.Tenant.Name)
+}
+
+// Status implements Integration, the integration is ready once the ManagedCertificate is active. The certificate
+// is read from the cache and from the api server, with the user token, until the cache is synced
+func (g *gkeIntegration) Status(ctx context.Context, req *IntegrationRequest) *models.IntegrationStatus {
+	if !exposedByIntegrations(req.Tenant) {
+		return nil
+	}
+	name := tenantManagedCertificateName(req.Tenant.Name)
+	var cert *gkev1beta2.ManagedCertificate
+	var err error
+	if g.certificatesSynced != nil && g.certificatesSynced() {
+		cert, err = g.certificates.ManagedCertificates(req.Tenant.Namespace).Get(name)
+	} else {
+		var mkClientSet *gkeClientset.Clientset
+		mkClientSet, err = gkeClientset.NewForConfig(cluster.GetK8sConfig(req.Token))
+		if err == nil {
+			cert, err = mkClientSet.NetworkingV1beta2().ManagedCertificates(req.Tenant.Namespace).Get(ctx, name, metav1.GetOptions{})
+		}
+	}
+	if err != nil {
+		return &models.IntegrationStatus{State: models.IntegrationStatusStateError, Message: err.Error()}
+	}
+	return managedCertificateIntegrationStatus(cert, time.Now(), g.stuckAfter)
+}
+
+// managedCertificateIntegrationStatus translates the status of a ManagedCertificate including the provisioning
+// status of every domain, a certificate stuck provisioning is reported as an error
+func managedCertificateIntegrationStatus(cert *gkev1beta2.ManagedCertificate, now time.Time, stuckAfter time.Duration) *models.IntegrationStatus {
+	certStatus := &models.CertificateStatus{
+		Name:     cert.Name,
+		Status:   cert.Status.CertificateStatus,
+		DNSNames: cert.Spec.Domains,
+		Domains:  managedCertificateDomains(cert),
+		Ready:    cert.Status.CertificateStatus == gkeCertificateActive,
+	}
+	if expireTime, err := time.Parse(time.RFC3339, cert.Status.ExpireTime); err == nil {
+		certStatus.NotAfter = strfmt.DateTime(expireTime)
+	}
+	status := &models.IntegrationStatus{Certificate: certStatus}
+	switch stuck, reason := managedCertificateStuck(cert, now, stuckAfter); {
+	case certStatus.Ready:
+		status.State = models.IntegrationStatusStateReady
+	case stuck:
+		status.State = models.IntegrationStatusStateError
+		status.Message = fmt.Sprintf("certificate %s is stuck: %s", cert.Name, reason)
+	default:
+		status.State = models.IntegrationStatusStatePending
+		status.Message = fmt.Sprintf("certificate %s is %s", cert.Name, managedCertificateState(cert))
+	}
+	return status
+}
+
+func managedCertificateDomains(cert *gkev1beta2.ManagedCertificate) []*models.DomainCertificateStatus {
+	var domains []*models.DomainCertificateStatus
+	for _, domain := range cert.Status.DomainStatus {
+		domains = append(domains, &models.DomainCertificateStatus{Domain: domain.Domain, Status: domain.Status})
+	}
+	return domains
+}
+
+// managedCertificateState returns the status of the certificate, Provisioning until GKE reports it
+func managedCertificateState(cert *gkev1beta2.ManagedCertificate) string {
+	if cert.Status.CertificateStatus == "" {
+		return "Provisioning"
+	}
+	return cert.Status.CertificateStatus
+}
+
+// managedCertificateStuck returns true and the reason if the certificate won't be provisioned without an action,
+// that's when GKE reports the certificate or one of its domains as failed (ie: FailedNotVisible when the domain
+// doesn't point to the load balancer) or when it's been provisioning for longer than stuckAfter
+func managedCertificateStuck(cert *gkev1beta2.ManagedCertificate, now time.Time, stuckAfter time.Duration) (bool, string) {
+	if cert.Status.CertificateStatus == gkeCertificateActive {
+		return false, ""
+	}
+	if strings.Contains(cert.Status.CertificateStatus, "Failed") {
+		return true, fmt.Sprintf("certificate is %s", cert.Status.CertificateStatus)
+	}
+	for _, domain := range cert.Status.DomainStatus {
+		if strings.HasPrefix(domain.Status, "Failed") {
+			return true, fmt.Sprintf("domain %s is %s", domain.Domain, domain.Status)
+		}
+	}
+	if created := cert.CreationTimestamp.Time; !created.IsZero() && now.Sub(created) > stuckAfter {
+		return true, fmt.Sprintf("provisioning for more than %s", stuckAfter)
+	}
+	return false, ""
+}
+
+// createGKEResources creates the NodePort services, the ManagedCertificate and the mkube-ingress rules of a tenant
+// for its S3 and console hosts
+func createGKEResources(ctx context.Context, clientset *kubernetes.Clientset, minInst *operator.MinIOInstance, k8sToken string, hosts *tenantHosts) (err error) {
+	tenantName := minInst.Name
+	namespace := minInst.Namespace
+	ctx, span := tracing.Start(ctx, "createGKEResources", attribute.String("namespace", namespace), attribute.String("tenant", tenantName))
+	defer func() { tracing.End(span, err) }()
+
+	// wait for the first pod to be created
+	_, waitSpan := tracing.Start(ctx, "createGKEResources.waitForPod")
+	doneCh := make(chan struct{})
+	factory := informers.NewSharedInformerFactory(clientset, 0)
+
+	// the timeout and the informer race to close doneCh
+	var closeOnce sync.Once
+	closeDone := func() { closeOnce.Do(func() { close(doneCh) }) }
+
+	go func() {
+		time.Sleep(time.Second * 15)
+		closeDone()
+	}()
+
+	podInformer := factory.Core().V1().Pods().Informer()
+	podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			pod := obj.(*corev1.Pod)
+			// monitor for pods with v1.min.io/instance annotation
+			if strings.HasPrefix(pod.Name, tenantName) {
+				closeDone()
+			}
+		},
+	})
+
+	go podInformer.Run(doneCh)
+	//block until the informer exits
+	<-doneCh
+	waitSpan.End()
+	logger.FromContext(ctx).Debug("informer closed")
+
+	tenantDomain := hosts.Domain
+	tenantMcsDomain := hosts.Console
+
+	// customization for demo, add the ingress for this new tenant
+	// create ManagedCertificate
+	manCertName := tenantManagedCertificateName(tenantName)
+	managedCert := gkev1beta2.ManagedCertificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            manCertName,
+			Labels:          map[string]string{m3TenantLabel: tenantName},
+			OwnerReferences: minInst.OwnerRef(),
+		},
+		Spec: gkev1beta2.ManagedCertificateSpec{
+			Domains: []string{
+				tenantDomain,
+				tenantMcsDomain,
+			},
+		},
+		Status: gkev1beta2.ManagedCertificateStatus{
+			DomainStatus: []gkev1beta2.DomainStatus{},
+		},
+	}
+
+	mkClientSet, err := gkeClientset.NewForConfig(cluster.GetK8sConfig(k8sToken))
+	if err != nil {
+		return err
+	}
+
+	_, err = mkClientSet.NetworkingV1beta2().ManagedCertificates(namespace).Create(ctx, &managedCert, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	// get a nodeport port for this tenant and create a nodeport for it
+	tenantNodePort := 9000
+
+	targetPort := intstr.IntOrString{
+		Type:   intstr.Int,
+		IntVal: 9000,
+	}
+
+	tenantNpSvc := tenantNodePortName(tenantName)
+	npSvc := corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            tenantNpSvc,
+			Labels:          map[string]string{m3TenantLabel: tenantName},
+			OwnerReferences: minInst.OwnerRef(),
+		},
+		Spec: corev1.ServiceSpec{
+
+			Selector: map[string]string{
+				"v1.min.io/instance": tenantName,
+			},
+			Type: corev1.ServiceTypeNodePort,
+			Ports: []corev1.ServicePort{
+				{
+					Protocol:   corev1.ProtocolTCP,
+					Port:       int32(tenantNodePort),
+					TargetPort: targetPort,
+				},
+			},
+		},
+	}
+
+	_, err = clientset.CoreV1().Services(namespace).Create(ctx, &npSvc, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+
+	//NOW FOR MCS
+	// create mcsManagedCertificate
+
+	// get a nodeport port for this tenant and create a nodeport for it
+	tenantMcsNodePort := 9090
+
+	targetMcsPort := intstr.IntOrString{
+		Type:   intstr.Int,
+		IntVal: 9090,
+	}
+
+	tenantMcsnpMcsSvc := tenantMCSNodePortName(tenantName)
+	npMcsSvc := corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            tenantMcsnpMcsSvc,
+			Labels:          map[string]string{m3TenantLabel: tenantName},
+			OwnerReferences: minInst.OwnerRef(),
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{
+				"v1.min.io/mcs": fmt.Sprintf("%s-mcs", tenantName),
+			},
+			Type: corev1.ServiceTypeNodePort,
+			Ports: []corev1.ServicePort{
+				{
+					Protocol:   corev1.ProtocolTCP,
+					Port:       int32(tenantMcsNodePort),
+					TargetPort: targetMcsPort,
+				},
+			},
+		},
+	}
+
+	_, err = clientset.CoreV1().Services(namespace).Create(ctx, &npMcsSvc, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	// udpate ingress with this new service
+	m3Ingress, err := clientset.ExtensionsV1beta1().Ingresses(namespace).Get(ctx, gkeIngressName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	certsInIngress := m3Ingress.ObjectMeta.Annotations[gkeManagedCertificatesAnnotation]
+	allCerts := strings.Split(certsInIngress, ",")
+	allCerts = append(allCerts, manCertName)
+	m3Ingress.ObjectMeta.Annotations[gkeManagedCertificatesAnnotation] = strings.Join(allCerts, ",")
+
+	tenantNodePortIoS := intstr.IntOrString{
+		Type:   intstr.Int,
+		IntVal: int32(tenantNodePort),
+	}
+
+	tenantMcsNodePortIoS := intstr.IntOrString{
+		Type:   intstr.Int,
+		IntVal: int32(tenantMcsNodePort),
+	}
+
+	m3Ingress.Spec.Rules = append(m3Ingress.Spec.Rules, extensionsBeta1.IngressRule{
+		Host: tenantDomain,
+		IngressRuleValue: extensionsBeta1.IngressRuleValue{
+			HTTP: &extensionsBeta1.HTTPIngressRuleValue{
+				Paths: []extensionsBeta1.HTTPIngressPath{
+					{
+						Backend: extensionsBeta1.IngressBackend{
+							ServiceName: tenantNpSvc,
+							ServicePort: tenantNodePortIoS,
+						},
+					},
+				},
+			},
+		},
+	})
+	m3Ingress.Spec.Rules = append(m3Ingress.Spec.Rules, extensionsBeta1.IngressRule{
+		Host: tenantMcsDomain,
+		IngressRuleValue: extensionsBeta1.IngressRuleValue{
+			HTTP: &extensionsBeta1.HTTPIngressRuleValue{
+				Paths: []extensionsBeta1.HTTPIngressPath{
+					{
+						Backend: extensionsBeta1.IngressBackend{
+							ServiceName: tenantMcsnpMcsSvc,
+							ServicePort: tenantMcsNodePortIoS,
+						},
+					},
+				},
+			},
+		},
+	})
+
+	_, err = clientset.ExtensionsV1beta1().Ingresses(namespace).Update(ctx, m3Ingress, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// deleteGKEResources removes the NodePort services and the ManagedCertificate of a tenant and its rules from the
+// shared GKE Ingress, resources that don't exist are skipped
+func deleteGKEResources(ctx context.Context, client K8sClient, gkeClient gkeClientset.Interface, namespace, tenant string) ([]*models.RemovedResource, error) {
+	var removed []*models.RemovedResource
+	var errs []error
+	for _, name := range []string{tenantNodePortName(tenant), tenantMCSNodePortName(tenant)} {
+		removed, errs = trackRemoved(removed, errs, "Service", name, client.deleteService(ctx, namespace, name, metav1.DeleteOptions{}))
+	}
+	certName := tenantManagedCertificateName(tenant)
+	removed, errs = trackRemoved(removed, errs, "ManagedCertificate", certName,
+		gkeClient.NetworkingV1beta2().ManagedCertificates(namespace).Delete(ctx, certName, metav1.DeleteOptions{}))
+
+	rules, err := removeTenantIngressRules(ctx, client, namespace, tenant)
+	if err != nil && !apierrors.IsNotFound(err) {
+		errs = append(errs, fmt.Errorf("error removing the rules of %s: %v", gkeIngressName, err))
+	}
+	removed = append(removed, rules...)
+	return removed, utilerrors.NewAggregate(errs)
+}
+
+// removeTenantIngressRules removes the rules pointing to the tenant services and its certificate from the shared GKE Ingress
+func removeTenantIngressRules(ctx context.Context, client K8sClient, namespace, tenant string) ([]*models.RemovedResource, error) {
+	ingress, err := client.getIngress(ctx, namespace, gkeIngressName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	var removed []*models.RemovedResource
+	tenantServices := map[string]bool{tenantNodePortName(tenant): true, tenantMCSNodePortName(tenant): true}
+	var rules []extensionsBeta1.IngressRule
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP != nil && len(rule.HTTP.Paths) > 0 && tenantServices[rule.HTTP.Paths[0].Backend.ServiceName] {
+			removed = append(removed, &models.RemovedResource{
+				Kind:   "IngressRule",
+				Name:   gkeIngressName,
+				Detail: fmt.Sprintf("host %s", rule.Host),
+			})
+			continue
+		}
+		rules = append(rules, rule)
+	}
+
+	certName := tenantManagedCertificateName(tenant)
+	var certs []string
+	for _, cert := range strings.Split(ingress.Annotations[gkeManagedCertificatesAnnotation], ",") {
+		if cert == certName {
+			removed = append(removed, &models.RemovedResource{
+				Kind:   "IngressAnnotation",
+				Name:   gkeIngressName,
+				Detail: fmt.Sprintf("%s %s", gkeManagedCertificatesAnnotation, cert),
+			})
+			continue
+		}
+		if cert != "" {
+			certs = append(certs, cert)
+		}
+	}
+
+	if len(removed) == 0 {
+		return nil, nil
+	}
+	ingress.Spec.Rules = rules
+	if ingress.Annotations != nil {
+		ingress.Annotations[gkeManagedCertificatesAnnotation] = strings.Join(certs, ",")
+	}
+	if _, err := client.updateIngress(ctx, namespace, ingress, metav1.UpdateOptions{}); err != nil {
+		return nil, err
+	}
+	return removed, nil
+}
@@ -0,0 +1,150 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	gkev1beta2 "github.com/minio/m3/pkg/apis/networking.gke.io/v1beta2"
+	fakeGKEClientset "github.com/minio/m3/pkg/clientgen/clientset/versioned/fake"
+	extensionsBeta1 "k8s.io/api/extensions/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// gkeIngressRule returns a rule of the shared GKE ingress pointing to service
+func gkeIngressRule(host, service string) extensionsBeta1.IngressRule {
+	return extensionsBeta1.IngressRule{
+		Host: host,
+		IngressRuleValue: extensionsBeta1.IngressRuleValue{
+			HTTP: &extensionsBeta1.HTTPIngressRuleValue{
+				Paths: []extensionsBeta1.HTTPIngressPath{
+					{Backend: extensionsBeta1.IngressBackend{ServiceName: service, ServicePort: intstr.FromInt(9000)}},
+				},
+			},
+		},
+	}
+}
+
+func Test_deleteGKEResources(t *testing.T) {
+	gkeIngress := func() *extensionsBeta1.Ingress {
+		return &extensionsBeta1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        gkeIngressName,
+				Annotations: map[string]string{gkeManagedCertificatesAnnotation: "m3-cert,tenant-1-cert,tenant-2-cert"},
+			},
+			Spec: extensionsBeta1.IngressSpec{
+				Rules: []extensionsBeta1.IngressRule{
+					gkeIngressRule("m3.cloud.min.dev", "m3"),
+					gkeIngressRule("tenant-1.cloud.min.dev", "tenant-1-np"),
+					gkeIngressRule("console.tenant-1.cloud.min.dev", "tenant-1-mcs-np"),
+					gkeIngressRule("tenant-2.cloud.min.dev", "tenant-2-np"),
+				},
+			},
+		}
+	}
+	managedCert := &gkev1beta2.ManagedCertificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-1-cert", Namespace: "default"},
+	}
+
+	tests := []struct {
+		name        string
+		services    func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error
+		ingress     func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*extensionsBeta1.Ingress, error)
+		objects     []runtime.Object
+		wantRemoved int
+		wantRules   []string
+		wantCerts   string
+		wantErr     bool
+	}{
+		{
+			name:     "Tenant created with the GKE integration",
+			services: existingResources("tenant-1-np", "tenant-1-mcs-np"),
+			ingress: func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*extensionsBeta1.Ingress, error) {
+				return gkeIngress(), nil
+			},
+			objects: []runtime.Object{managedCert},
+			// 2 services, the certificate, 2 rules and the annotation entry
+			wantRemoved: 6,
+			wantRules:   []string{"m3.cloud.min.dev", "tenant-2.cloud.min.dev"},
+			wantCerts:   "m3-cert,tenant-2-cert",
+		},
+		{
+			name:     "Tenant created without the GKE integration",
+			services: existingResources(),
+			ingress: func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*extensionsBeta1.Ingress, error) {
+				return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+			},
+			wantRemoved: 0,
+		},
+		{
+			name: "Error deleting a service",
+			services: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return apierrors.NewForbidden(schema.GroupResource{Resource: "services"}, name, errors.New("not allowed"))
+			},
+			ingress: func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*extensionsBeta1.Ingress, error) {
+				return nil, apierrors.NewNotFound(schema.GroupResource{}, name)
+			},
+			wantRemoved: 0,
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClientDeleteServiceMock = tt.services
+			k8sClientGetIngressMock = tt.ingress
+			var updated *extensionsBeta1.Ingress
+			k8sClientUpdateIngressMock = func(ctx context.Context, namespace string, ingress *extensionsBeta1.Ingress, opts metav1.UpdateOptions) (*extensionsBeta1.Ingress, error) {
+				updated = ingress
+				return ingress, nil
+			}
+			gkeClient := fakeGKEClientset.NewSimpleClientset(tt.objects...)
+
+			removed, err := deleteGKEResources(context.Background(), k8sClientMock{}, gkeClient, "default", "tenant-1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("deleteGKEResources() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(removed) != tt.wantRemoved {
+				t.Errorf("deleteGKEResources() removed = %v, want %v", len(removed), tt.wantRemoved)
+			}
+			if tt.wantRules == nil {
+				if updated != nil {
+					t.Errorf("deleteGKEResources() shouldn't update the ingress")
+				}
+				return
+			}
+			if updated == nil {
+				t.Fatalf("deleteGKEResources() should update the ingress")
+			}
+			var hosts []string
+			for _, rule := range updated.Spec.Rules {
+				hosts = append(hosts, rule.Host)
+			}
+			if len(hosts) != len(tt.wantRules) || hosts[0] != tt.wantRules[0] || hosts[1] != tt.wantRules[1] {
+				t.Errorf("deleteGKEResources() rules = %v, want %v", hosts, tt.wantRules)
+			}
+			if certs := updated.Annotations[gkeManagedCertificatesAnnotation]; certs != tt.wantCerts {
+				t.Errorf("deleteGKEResources() certificates = %v, want %v", certs, tt.wantCerts)
+			}
+		})
+	}
+}
@@ -0,0 +1,308 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/models"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+)
+
+const (
+	// loadBalancerIntegrationName is always enabled, it only exposes the tenants created with the
+	// load-balancer exposure
+	loadBalancerIntegrationName = "load-balancer"
+	// m3ExposureAnnotation is set on the MinIOInstance when the tenant was created with an exposure other
+	// than the default one
+	m3ExposureAnnotation = "m3.min.io/exposure"
+	// externalDNSHostnameAnnotation asks external-dns to publish the address of the service under the hostname
+	externalDNSHostnameAnnotation = "external-dns.alpha.kubernetes.io/hostname"
+)
+
+func init() {
+	RegisterIntegration(loadBalancerIntegrationName, func() (Integration, error) {
+		config, err := getLoadBalancerConfig()
+		if err != nil {
+			return nil, err
+		}
+		return &loadBalancerIntegration{
+			config: config,
+			newClient: func(token string) (kubernetes.Interface, error) {
+				return cluster.K8sClient(token)
+			},
+		}, nil
+	})
+}
+
+// tenantExposure returns how the tenant was asked to be exposed when it was created
+func tenantExposure(tenant *operator.MinIOInstance) string {
+	if exposure := tenant.Annotations[m3ExposureAnnotation]; exposure != "" {
+		return exposure
+	}
+	return models.CreateTenantRequestExposureDefault
+}
+
+// exposedByIntegrations returns true for the tenants left to be exposed by the integrations enabled on m3,
+// ie: the ingress and gke ones
+func exposedByIntegrations(tenant *operator.MinIOInstance) bool {
+	return tenantExposure(tenant) == models.CreateTenantRequestExposureDefault
+}
+
+// loadBalancerConfig describes how tenants are exposed through LoadBalancer services
+type loadBalancerConfig struct {
+	// domainTemplate is optional, when set the hostnames are annotated for external-dns
+	domainTemplate        string
+	consoleDomainTemplate string
+	annotations           map[string]string
+}
+
+// getLoadBalancerConfig returns the exposure configuration set through the M3_LOAD_BALANCER_* env variables
+func getLoadBalancerConfig() (*loadBalancerConfig, error) {
+	annotations, err := getLoadBalancerAnnotations()
+	if err != nil {
+		return nil, err
+	}
+	return &loadBalancerConfig{
+		domainTemplate:        getLoadBalancerDomainTemplate(),
+		consoleDomainTemplate: getLoadBalancerConsoleDomainTemplate(),
+		annotations:           annotations,
+	}, nil
+}
+
+// hosts renders the hostnames of the tenant, nil if no domain template is configured
+func (c *loadBalancerConfig) hosts(tenant *operator.MinIOInstance) (*tenantHosts, error) {
+	if c.domainTemplate == "" {
+		return nil, nil
+	}
+	hosts, err := renderTenantHosts(c.domainTemplate, c.consoleDomainTemplate, tenant.Name, tenant.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if !tenant.HasMCSEnabled() {
+		hosts.Console = ""
+	}
+	return hosts, nil
+}
+
+// tenantLoadBalancer is a LoadBalancer service exposing one of the endpoints of a tenant
+type tenantLoadBalancer struct {
+	endpoint string
+	service  string
+	port     int32
+	selector map[string]string
+	hostname string
+}
+
+// tenantLoadBalancers returns the services exposing the S3 endpoint of the tenant and, when enabled, its console
+func (c *loadBalancerConfig) tenantLoadBalancers(tenant *operator.MinIOInstance) ([]tenantLoadBalancer, error) {
+	hosts, err := c.hosts(tenant)
+	if err != nil {
+		return nil, err
+	}
+	s3 := tenantLoadBalancer{
+		endpoint: "s3",
+		service:  tenantLoadBalancerName(tenant.Name),
+		port:     operator.MinIOPort,
+		selector: tenant.MinIOPodLabels(),
+	}
+	if hosts != nil {
+		s3.hostname = hosts.Domain
+	}
+	loadBalancers := []tenantLoadBalancer{s3}
+	if tenant.HasMCSEnabled() {
+		console := tenantLoadBalancer{
+			endpoint: "console",
+			service:  tenantMCSLoadBalancerName(tenant.Name),
+			port:     operator.MCSPort,
+			selector: tenant.MCSPodLabels(),
+		}
+		if hosts != nil {
+			console.hostname = hosts.Console
+		}
+		loadBalancers = append(loadBalancers, console)
+	}
+	return loadBalancers, nil
+}
+
+// newLoadBalancerService returns the service of an endpoint of the tenant, it's owned by the MinIOInstance so
+// it's garbage collected with it
+func (c *loadBalancerConfig) newLoadBalancerService(tenant *operator.MinIOInstance, lb tenantLoadBalancer) *corev1.Service {
+	annotations := make(map[string]string)
+	for k, v := range c.annotations {
+		annotations[k] = v
+	}
+	if lb.hostname != "" {
+		annotations[externalDNSHostnameAnnotation] = lb.hostname
+	}
+	return &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            lb.service,
+			Namespace:       tenant.Namespace,
+			Labels:          map[string]string{m3TenantLabel: tenant.Name},
+			Annotations:     annotations,
+			OwnerReferences: tenant.OwnerRef(),
+		},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeLoadBalancer,
+			Selector: lb.selector,
+			Ports: []corev1.ServicePort{
+				{
+					Name:       lb.endpoint,
+					Protocol:   corev1.ProtocolTCP,
+					Port:       lb.port,
+					TargetPort: intstr.FromInt(int(lb.port)),
+				},
+			},
+		},
+	}
+}
+
+// loadBalancerIntegration exposes the tenants created with the load-balancer exposure through LoadBalancer
+// services, one for S3 and one for the console, ie: on bare-metal clusters running MetalLB
+type loadBalancerIntegration struct {
+	NoopIntegration
+	config    *loadBalancerConfig
+	newClient func(token string) (kubernetes.Interface, error)
+}
+
+// Name implements Integration
+func (l *loadBalancerIntegration) Name() string {
+	return loadBalancerIntegrationName
+}
+
+// TenantCreated implements Integration
+func (l *loadBalancerIntegration) TenantCreated(ctx context.Context, req *IntegrationRequest) error {
+	if tenantExposure(req.Tenant) != models.CreateTenantRequestExposureLoadBalancer {
+		return nil
+	}
+	client, err := l.newClient(req.Token)
+	if err != nil {
+		return err
+	}
+	loadBalancers, err := l.config.tenantLoadBalancers(req.Tenant)
+	if err != nil {
+		return err
+	}
+	for _, lb := range loadBalancers {
+		service := l.config.newLoadBalancerService(req.Tenant, lb)
+		if _, err := client.CoreV1().Services(req.Tenant.Namespace).Create(ctx, service, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TenantDeleted implements Integration, the services are owned by the MinIOInstance but they are removed right
+// away to release the external ips
+func (l *loadBalancerIntegration) TenantDeleted(ctx context.Context, req *IntegrationRequest) ([]*models.RemovedResource, error) {
+	if tenantExposure(req.Tenant) != models.CreateTenantRequestExposureLoadBalancer {
+		return nil, nil
+	}
+	client, err := l.newClient(req.Token)
+	if err != nil {
+		return nil, err
+	}
+	var removed []*models.RemovedResource
+	var errs []error
+	for _, name := range []string{tenantLoadBalancerName(req.Tenant.Name), tenantMCSLoadBalancerName(req.Tenant.Name)} {
+		removed, errs = trackRemoved(removed, errs, "Service", name,
+			client.CoreV1().Services(req.Tenant.Namespace).Delete(ctx, name, metav1.DeleteOptions{}))
+	}
+	return removed, utilerrors.NewAggregate(errs)
+}
+
+// TenantHosts implements tenantHostsProvider, hosts are only known when a domain template is configured
+func (l *loadBalancerIntegration) TenantHosts(tenant *operator.MinIOInstance) (string, string, error) {
+	if tenantExposure(tenant) != models.CreateTenantRequestExposureLoadBalancer {
+		return "", "", nil
+	}
+	hosts, err := l.config.hosts(tenant)
+	if err != nil || hosts == nil {
+		return "", "", err
+	}
+	return hosts.Domain, hosts.Console, nil
+}
+
+// TenantS3Endpoint implements tenantHostsProvider, the service forwards the port of MinIO so the tenant is served
+// as it is inside the cluster
+func (l *loadBalancerIntegration) TenantS3Endpoint(tenant *operator.MinIOInstance) (string, bool, error) {
+	s3Host, _, err := l.TenantHosts(tenant)
+	if err != nil || s3Host == "" {
+		return "", false, err
+	}
+	_, secure := tenantServiceEndpoint(tenant)
+	return net.JoinHostPort(s3Host, strconv.Itoa(int(operator.MinIOPort))), secure, nil
+}
+
+// Status implements Integration, it reports the external ip and hostname of every service, the integration is
+// ready once every service got an external address
+func (l *loadBalancerIntegration) Status(ctx context.Context, req *IntegrationRequest) *models.IntegrationStatus {
+	if tenantExposure(req.Tenant) != models.CreateTenantRequestExposureLoadBalancer {
+		return nil
+	}
+	client, err := l.newClient(req.Token)
+	if err != nil {
+		return &models.IntegrationStatus{State: models.IntegrationStatusStateError, Message: err.Error()}
+	}
+	loadBalancers, err := l.config.tenantLoadBalancers(req.Tenant)
+	if err != nil {
+		return &models.IntegrationStatus{State: models.IntegrationStatusStateError, Message: err.Error()}
+	}
+	status := &models.IntegrationStatus{State: models.IntegrationStatusStateReady}
+	var pending []string
+	for _, lb := range loadBalancers {
+		service, err := client.CoreV1().Services(req.Tenant.Namespace).Get(ctx, lb.service, metav1.GetOptions{})
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return &models.IntegrationStatus{State: models.IntegrationStatusStateError, Message: fmt.Sprintf("service %s not found", lb.service)}
+			}
+			return &models.IntegrationStatus{State: models.IntegrationStatusStateError, Message: err.Error()}
+		}
+		endpoint := &models.TenantEndpoint{
+			Name:     lb.endpoint,
+			Service:  lb.service,
+			Port:     lb.port,
+			Hostname: service.Annotations[externalDNSHostnameAnnotation],
+		}
+		if ingress := service.Status.LoadBalancer.Ingress; len(ingress) > 0 {
+			endpoint.ExternalIP = ingress[0].IP
+			if endpoint.Hostname == "" {
+				endpoint.Hostname = ingress[0].Hostname
+			}
+		} else {
+			pending = append(pending, lb.service)
+		}
+		status.Endpoints = append(status.Endpoints, endpoint)
+	}
+	if len(pending) > 0 {
+		status.State = models.IntegrationStatusStatePending
+		status.Message = fmt.Sprintf("waiting for an external address on %s", strings.Join(pending, ", "))
+	}
+	return status
+}
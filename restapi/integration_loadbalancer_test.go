@@ -0,0 +1,163 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"testing"
+
+	"github.com/minio/m3/models"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+// loadBalancerTenant returns a tenant created with the given exposure
+func loadBalancerTenant(exposure string, mcs bool) *operator.MinIOInstance {
+	minInst := &operator.MinIOInstance{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "tenant-1",
+			Namespace:   "tenants",
+			UID:         "1234",
+			Annotations: map[string]string{m3ExposureAnnotation: exposure},
+		},
+	}
+	if mcs {
+		minInst.Spec.MCS = &operator.MCSConfig{Replicas: 2}
+	}
+	return minInst
+}
+
+func Test_loadBalancerIntegration(t *testing.T) {
+	tests := []struct {
+		name          string
+		tenant        *operator.MinIOInstance
+		config        *loadBalancerConfig
+		wantServices  []string
+		wantHostnames map[string]string
+		wantS3Host    string
+	}{
+		{
+			name:   "S3 and console with external-dns hostnames",
+			tenant: loadBalancerTenant(models.CreateTenantRequestExposureLoadBalancer, true),
+			config: &loadBalancerConfig{
+				domainTemplate:        "{{.Tenant}}.{{.Namespace}}.example.com",
+				consoleDomainTemplate: "console.{{.Domain}}",
+				annotations:           map[string]string{"metallb.universe.tf/address-pool": "public"},
+			},
+			wantServices: []string{"tenant-1-lb", "tenant-1-mcs-lb"},
+			wantHostnames: map[string]string{
+				"tenant-1-lb":     "tenant-1.tenants.example.com",
+				"tenant-1-mcs-lb": "console.tenant-1.tenants.example.com",
+			},
+			wantS3Host: "tenant-1.tenants.example.com",
+		},
+		{
+			name:         "S3 without hostname",
+			tenant:       loadBalancerTenant(models.CreateTenantRequestExposureLoadBalancer, false),
+			config:       &loadBalancerConfig{},
+			wantServices: []string{"tenant-1-lb"},
+		},
+		{
+			name:   "Tenant exposed by the other integrations",
+			tenant: loadBalancerTenant("", true),
+			config: &loadBalancerConfig{domainTemplate: "{{.Tenant}}.example.com"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := fake.NewSimpleClientset()
+			integration := &loadBalancerIntegration{
+				config: tt.config,
+				newClient: func(token string) (kubernetes.Interface, error) {
+					return client, nil
+				},
+			}
+			req := &IntegrationRequest{Tenant: tt.tenant}
+			if err := integration.TenantCreated(context.Background(), req); err != nil {
+				t.Fatalf("TenantCreated() error = %v", err)
+			}
+			services, err := client.CoreV1().Services("tenants").List(context.Background(), metav1.ListOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(services.Items) != len(tt.wantServices) {
+				t.Fatalf("TenantCreated() created %d services, want %d", len(services.Items), len(tt.wantServices))
+			}
+			for _, service := range services.Items {
+				if service.Spec.Type != corev1.ServiceTypeLoadBalancer {
+					t.Errorf("service %s type = %s, want LoadBalancer", service.Name, service.Spec.Type)
+				}
+				if got := service.Annotations[externalDNSHostnameAnnotation]; got != tt.wantHostnames[service.Name] {
+					t.Errorf("service %s hostname = %q, want %q", service.Name, got, tt.wantHostnames[service.Name])
+				}
+				for k, v := range tt.config.annotations {
+					if service.Annotations[k] != v {
+						t.Errorf("service %s annotation %s = %q, want %q", service.Name, k, service.Annotations[k], v)
+					}
+				}
+				if len(service.OwnerReferences) != 1 {
+					t.Errorf("service %s should be owned by the tenant", service.Name)
+				}
+			}
+			if s3Host, _, _ := integration.TenantHosts(tt.tenant); s3Host != tt.wantS3Host {
+				t.Errorf("TenantHosts() = %q, want %q", s3Host, tt.wantS3Host)
+			}
+			// the service listens on the port of MinIO, without tls since the tenants don't set it up
+			if endpoint, secure, _ := integration.TenantS3Endpoint(tt.tenant); (tt.wantS3Host != "" && endpoint != tt.wantS3Host+":9000") || secure {
+				t.Errorf("TenantS3Endpoint() = %q, %v", endpoint, secure)
+			}
+			if len(tt.wantServices) == 0 {
+				if status := integration.Status(context.Background(), req); status != nil {
+					t.Errorf("Status() = %v, want nil", status)
+				}
+				return
+			}
+
+			// services are pending until they get an external address
+			if status := integration.Status(context.Background(), req); status.State != models.IntegrationStatusStatePending {
+				t.Errorf("Status() state = %s, want pending", status.State)
+			}
+			for i, name := range tt.wantServices {
+				service, _ := client.CoreV1().Services("tenants").Get(context.Background(), name, metav1.GetOptions{})
+				service.Status.LoadBalancer.Ingress = []corev1.LoadBalancerIngress{{IP: "10.0.0." + string(rune('1'+i))}}
+				if _, err := client.CoreV1().Services("tenants").UpdateStatus(context.Background(), service, metav1.UpdateOptions{}); err != nil {
+					t.Fatal(err)
+				}
+			}
+			status := integration.Status(context.Background(), req)
+			if status.State != models.IntegrationStatusStateReady || len(status.Endpoints) != len(tt.wantServices) {
+				t.Fatalf("Status() = %v, want ready with %d endpoints", status, len(tt.wantServices))
+			}
+			for _, endpoint := range status.Endpoints {
+				if endpoint.ExternalIP == "" || endpoint.Hostname != tt.wantHostnames[endpoint.Service] {
+					t.Errorf("Status() endpoint = %+v", endpoint)
+				}
+			}
+
+			removed, err := integration.TenantDeleted(context.Background(), req)
+			if err != nil {
+				t.Fatalf("TenantDeleted() error = %v", err)
+			}
+			if len(removed) != len(tt.wantServices) {
+				t.Errorf("TenantDeleted() removed %d services, want %d", len(removed), len(tt.wantServices))
+			}
+		})
+	}
+}
@@ -1,4 +1,4 @@
-// This file is part of MinIO Console Server
+// This file is part of MinIO Kubernetes Cloud
 // Copyright (c) 2020 MinIO, Inc.
 //
 // This program is free software: you can redistribute it and/or modify
@@ -19,211 +19,315 @@ package restapi
 import (
 	"context"
 	"fmt"
-	"log"
+	"os"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/minio/m3/cluster"
-	gkev1beta2 "github.com/minio/m3/pkg/apis/networking.gke.io/v1beta2"
-	gkeClientset "github.com/minio/m3/pkg/clientgen/clientset/versioned"
-	corev1 "k8s.io/api/core/v1"
-	extensionsBeta1 "k8s.io/api/extensions/v1beta1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/intstr"
-	"k8s.io/client-go/informers"
-	"k8s.io/client-go/kubernetes"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/pkg/logger"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	operatorInformers "github.com/minio/minio-operator/pkg/client/informers/externalversions"
+	"github.com/minio/minio/pkg/env"
+	"github.com/sirupsen/logrus"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
 )
 
-func gkeIntegration(clientset *kubernetes.Clientset, tenantName string, namespace string, k8sToken string) error {
-	// wait for the first pod to be created
-	doneCh := make(chan struct{})
-	factory := informers.NewSharedInformerFactory(clientset, 0)
+// M3Integrations comma separated list of the integrations enabled, ie: ingress,cert-manager
+const M3Integrations = "M3_INTEGRATIONS"
 
-	informerClosed := false
+// IntegrationRequest is the tenant an integration hook is called for and the token of the user that
+// triggered it, hooks called by m3 on its own (status changes) get m3's service account token
+type IntegrationRequest struct {
+	Tenant *operator.MinIOInstance
+	Token  string
+}
 
-	go func() {
-		time.Sleep(time.Second * 15)
-		if !informerClosed {
-			informerClosed = true
-			close(doneCh)
-		}
-	}()
-
-	podInformer := factory.Core().V1().Pods().Informer()
-	podInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
-		AddFunc: func(obj interface{}) {
-			pod := obj.(*corev1.Pod)
-			// monitor for pods with v1.min.io/instance annotation
-			if strings.HasPrefix(pod.Name, tenantName) {
-				if !informerClosed {
-					informerClosed = true
-					close(doneCh)
-				}
-			}
-		},
-	})
+// Integration extends the lifecycle of the tenants, ie: to expose them or to register them on an external
+// system. Hooks are called after the MinIOInstance is created, updated or deleted and whenever the state
+// reported by the operator changes, an error on the created hook fails the tenant creation.
+type Integration interface {
+	// Name identifies the integration on M3_INTEGRATIONS and on the tenant info
+	Name() string
+	TenantCreated(ctx context.Context, req *IntegrationRequest) error
+	TenantUpdated(ctx context.Context, req *IntegrationRequest) error
+	// TenantDeleted removes the resources created by the integration and returns them
+	TenantDeleted(ctx context.Context, req *IntegrationRequest) ([]*models.RemovedResource, error)
+	TenantStatusChanged(ctx context.Context, req *IntegrationRequest, previousState string) error
+	// Status reports whether the integration is working for the tenant
+	Status(ctx context.Context, req *IntegrationRequest) *models.IntegrationStatus
+}
 
-	go podInformer.Run(doneCh)
-	//block until the informer exits
-	<-doneCh
-	log.Println("informer closed")
+// NoopIntegration implements every hook doing nothing so integrations only implement the hooks they need
+type NoopIntegration struct{}
 
-	tenantDomain := fmt.Sprintf("%s.cloud.min.dev", tenantName)
-	tenantMcsDomain := fmt.Sprintf("console.%s.cloud.min.dev", tenantName)
+// TenantCreated implements Integration
+func (NoopIntegration) TenantCreated(ctx context.Context, req *IntegrationRequest) error {
+	return nil
+}
 
-	// customization for demo, add the ingress for this new tenant
-	// create ManagedCertificate
-	manCertName := fmt.Sprintf("%s-cert", tenantName)
-	managedCert := gkev1beta2.ManagedCertificate{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: manCertName,
-		},
-		Spec: gkev1beta2.ManagedCertificateSpec{
-			Domains: []string{
-				tenantDomain,
-				tenantMcsDomain,
-			},
-		},
-		Status: gkev1beta2.ManagedCertificateStatus{
-			DomainStatus: []gkev1beta2.DomainStatus{},
-		},
-	}
+// TenantUpdated implements Integration
+func (NoopIntegration) TenantUpdated(ctx context.Context, req *IntegrationRequest) error {
+	return nil
+}
 
-	mkClientSet, err := gkeClientset.NewForConfig(cluster.GetK8sConfig(k8sToken))
-	if err != nil {
-		return err
-	}
+// TenantDeleted implements Integration
+func (NoopIntegration) TenantDeleted(ctx context.Context, req *IntegrationRequest) ([]*models.RemovedResource, error) {
+	return nil, nil
+}
 
-	_, err = mkClientSet.NetworkingV1beta2().ManagedCertificates(namespace).Create(context.Background(), &managedCert, metav1.CreateOptions{})
-	if err != nil {
-		return err
-	}
+// TenantStatusChanged implements Integration
+func (NoopIntegration) TenantStatusChanged(ctx context.Context, req *IntegrationRequest, previousState string) error {
+	return nil
+}
 
-	// get a nodeport port for this tenant and create a nodeport for it
-	tenantNodePort := 9000
+// Status implements Integration, it returns nil so the integration is not reported on the tenant info
+func (NoopIntegration) Status(ctx context.Context, req *IntegrationRequest) *models.IntegrationStatus {
+	return nil
+}
+
+// tenantHostsProvider is implemented by the integrations exposing the tenants, the hosts are
+// returned when the tenant is created and the S3 endpoint, with the port and scheme it's served on,
+// is used to presign urls
+type tenantHostsProvider interface {
+	TenantHosts(tenant *operator.MinIOInstance) (s3Host, consoleHost string, err error)
+	TenantS3Endpoint(tenant *operator.MinIOInstance) (endpoint string, secure bool, err error)
+}
+
+// integrationRunner is implemented by the integrations doing work in the background, ie: keeping a cache of the
+// resources they manage, Run must not block
+type integrationRunner interface {
+	Run(stopCh <-chan struct{}) error
+}
 
-	targetPort := intstr.IntOrString{
-		Type:   intstr.Int,
-		IntVal: 9000,
+var integrationsRegistry = struct {
+	sync.Mutex
+	factories map[string]func() (Integration, error)
+}{factories: make(map[string]func() (Integration, error))}
+
+// RegisterIntegration makes an integration available to be enabled through M3_INTEGRATIONS, it's meant
+// to be called from init()
+func RegisterIntegration(name string, factory func() (Integration, error)) {
+	integrationsRegistry.Lock()
+	defer integrationsRegistry.Unlock()
+	if _, ok := integrationsRegistry.factories[name]; ok {
+		panic(fmt.Sprintf("integration %s registered twice", name))
 	}
+	integrationsRegistry.factories[name] = factory
+}
 
-	tenantNpSvc := fmt.Sprintf("%s-np", tenantName)
-	npSvc := corev1.Service{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: tenantNpSvc,
-		},
-		Spec: corev1.ServiceSpec{
-
-			Selector: map[string]string{
-				"v1.min.io/instance": tenantName,
-			},
-			Type: corev1.ServiceTypeNodePort,
-			Ports: []corev1.ServicePort{
-				{
-					Protocol:   corev1.ProtocolTCP,
-					Port:       int32(tenantNodePort),
-					TargetPort: targetPort,
-				},
-			},
-		},
+// newIntegrations returns the named integrations in the given order, integrations that can't be configured are
+// skipped and reported on the returned error
+func newIntegrations(names []string) ([]Integration, error) {
+	integrationsRegistry.Lock()
+	defer integrationsRegistry.Unlock()
+	var integrations []Integration
+	var errs []error
+	for _, name := range names {
+		factory, ok := integrationsRegistry.factories[name]
+		if !ok {
+			var available []string
+			for name := range integrationsRegistry.factories {
+				available = append(available, name)
+			}
+			sort.Strings(available)
+			errs = append(errs, fmt.Errorf("unknown integration %s, available integrations: %s", name, strings.Join(available, ",")))
+			continue
+		}
+		integration, err := factory()
+		if err != nil {
+			errs = append(errs, fmt.Errorf("error configuring integration %s: %v", name, err))
+			continue
+		}
+		integrations = append(integrations, integration)
 	}
+	return integrations, utilerrors.NewAggregate(errs)
+}
 
-	_, err = clientset.CoreV1().Services(namespace).Create(context.Background(), &npSvc, metav1.CreateOptions{})
-	if err != nil {
-		return err
+// getEnabledIntegrationNames returns the integrations enabled through M3_INTEGRATIONS, GKE_INTEGRATION and
+// M3_INGRESS are still honored to enable the gke and ingress integrations
+func getEnabledIntegrationNames() []string {
+	var names []string
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+	for _, name := range strings.Split(env.Get(M3Integrations, ""), ",") {
+		add(strings.ToLower(strings.TrimSpace(name)))
 	}
+	if getIngressEnabled() {
+		add(ingressIntegrationName)
+	}
+	if os.Getenv("GKE_INTEGRATION") != "" {
+		add(gkeIntegrationName)
+	}
+	return names
+}
+
+var (
+	enabledIntegrationsOnce sync.Once
+	enabledIntegrationsList []Integration
+	enabledIntegrationsErr  error
+)
 
-	//NOW FOR MCS
-	// create mcsManagedCertificate
+// getEnabledIntegrations returns the integrations enabled on this m3, they are configured once, the error reports
+// the integrations that couldn't be configured
+func getEnabledIntegrations() ([]Integration, error) {
+	enabledIntegrationsOnce.Do(func() {
+		names := getEnabledIntegrationNames()
+		// tenants ask for the load-balancer exposure on creation so its integration is always enabled
+		if !sets.NewString(names...).Has(loadBalancerIntegrationName) {
+			names = append(names, loadBalancerIntegrationName)
+		}
+		enabledIntegrationsList, enabledIntegrationsErr = newIntegrations(names)
+	})
+	return enabledIntegrationsList, enabledIntegrationsErr
+}
+
+// getWorkingIntegrations returns the enabled integrations that could be configured, the broken ones are logged
+// and skipped so they don't break the requests that only read or clean up what the integrations manage
+func getWorkingIntegrations(ctx context.Context) []Integration {
+	integrations, err := getEnabledIntegrations()
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("skipping integrations that can't be configured")
+	}
+	return integrations
+}
 
-	// get a nodeport port for this tenant and create a nodeport for it
-	tenantMcsNodePort := 9090
+// integrationLogger returns the logger of the hooks of an integration
+func integrationLogger(ctx context.Context, integration Integration) *logrus.Entry {
+	return logger.FromContext(ctx).WithField("integration", integration.Name())
+}
 
-	targetMcsPort := intstr.IntOrString{
-		Type:   intstr.Int,
-		IntVal: 9090,
+// runTenantCreatedHooks calls the created hook of every integration, it stops on the first failure
+func runTenantCreatedHooks(ctx context.Context, integrations []Integration, req *IntegrationRequest) error {
+	for _, integration := range integrations {
+		if err := integration.TenantCreated(ctx, req); err != nil {
+			return fmt.Errorf("integration %s: %v", integration.Name(), err)
+		}
 	}
+	return nil
+}
 
-	tenantMcsnpMcsSvc := fmt.Sprintf("%s-mcs-np", tenantName)
-	npMcsSvc := corev1.Service{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: tenantMcsnpMcsSvc,
-		},
-		Spec: corev1.ServiceSpec{
-			Selector: map[string]string{
-				"v1.min.io/mcs": fmt.Sprintf("%s-mcs", tenantName),
-			},
-			Type: corev1.ServiceTypeNodePort,
-			Ports: []corev1.ServicePort{
-				{
-					Protocol:   corev1.ProtocolTCP,
-					Port:       int32(tenantMcsNodePort),
-					TargetPort: targetMcsPort,
-				},
-			},
-		},
+// runTenantUpdatedHooks calls the updated hook of every integration, failures are logged
+func runTenantUpdatedHooks(ctx context.Context, integrations []Integration, req *IntegrationRequest) {
+	for _, integration := range integrations {
+		if err := integration.TenantUpdated(ctx, req); err != nil {
+			integrationLogger(ctx, integration).WithError(err).Error("error running the tenant updated hook")
+		}
 	}
+}
 
-	_, err = clientset.CoreV1().Services(namespace).Create(context.Background(), &npMcsSvc, metav1.CreateOptions{})
-	if err != nil {
-		return err
+// runTenantDeletedHooks calls the deleted hook of every integration, all the hooks are called even if
+// some of them fail, the resources removed by all of them are returned
+func runTenantDeletedHooks(ctx context.Context, integrations []Integration, req *IntegrationRequest) ([]*models.RemovedResource, error) {
+	var removed []*models.RemovedResource
+	var errs []error
+	for _, integration := range integrations {
+		resources, err := integration.TenantDeleted(ctx, req)
+		removed = append(removed, resources...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("integration %s: %v", integration.Name(), err))
+		}
 	}
-	// udpate ingress with this new service
-	m3Ingress, err := clientset.ExtensionsV1beta1().Ingresses(namespace).Get(context.Background(), "mkube-ingress", metav1.GetOptions{})
-	if err != nil {
-		return err
+	return removed, utilerrors.NewAggregate(errs)
+}
+
+// runTenantStatusChangedHooks calls the status changed hook of every integration, failures are logged
+func runTenantStatusChangedHooks(ctx context.Context, integrations []Integration, req *IntegrationRequest, previousState string) {
+	for _, integration := range integrations {
+		if err := integration.TenantStatusChanged(ctx, req, previousState); err != nil {
+			integrationLogger(ctx, integration).WithError(err).Error("error running the tenant status changed hook")
+		}
 	}
+}
 
-	certsInIngress := m3Ingress.ObjectMeta.Annotations["networking.gke.io/managed-certificates"]
-	allCerts := strings.Split(certsInIngress, ",")
-	allCerts = append(allCerts, manCertName)
-	m3Ingress.ObjectMeta.Annotations["networking.gke.io/managed-certificates"] = strings.Join(allCerts, ",")
+// getIntegrationsStatus returns the status reported by every integration for the tenant
+func getIntegrationsStatus(ctx context.Context, integrations []Integration, req *IntegrationRequest) []*models.IntegrationStatus {
+	var statuses []*models.IntegrationStatus
+	for _, integration := range integrations {
+		if status := integration.Status(ctx, req); status != nil {
+			if status.Name == "" {
+				status.Name = integration.Name()
+			}
+			statuses = append(statuses, status)
+		}
+	}
+	return statuses
+}
 
-	tenantNodePortIoS := intstr.IntOrString{
-		Type:   intstr.Int,
-		IntVal: int32(tenantNodePort),
+// getTenantHosts returns the hosts where the tenant is exposed by the first integration exposing it
+func getTenantHosts(integrations []Integration, tenant *operator.MinIOInstance) (s3Host, consoleHost string, err error) {
+	for _, integration := range integrations {
+		if provider, ok := integration.(tenantHostsProvider); ok {
+			s3Host, consoleHost, err = provider.TenantHosts(tenant)
+			if err != nil || s3Host != "" {
+				return s3Host, consoleHost, err
+			}
+		}
 	}
+	return "", "", nil
+}
 
-	tenantMcsNodePortIoS := intstr.IntOrString{
-		Type:   intstr.Int,
-		IntVal: int32(tenantMcsNodePort),
+// getTenantS3Endpoint returns the S3 endpoint of the first integration exposing the tenant
+func getTenantS3Endpoint(integrations []Integration, tenant *operator.MinIOInstance) (endpoint string, secure bool, err error) {
+	for _, integration := range integrations {
+		if provider, ok := integration.(tenantHostsProvider); ok {
+			endpoint, secure, err = provider.TenantS3Endpoint(tenant)
+			if err != nil || endpoint != "" {
+				return endpoint, secure, err
+			}
+		}
 	}
+	return "", false, nil
+}
 
-	m3Ingress.Spec.Rules = append(m3Ingress.Spec.Rules, extensionsBeta1.IngressRule{
-		Host: tenantDomain,
-		IngressRuleValue: extensionsBeta1.IngressRuleValue{
-			HTTP: &extensionsBeta1.HTTPIngressRuleValue{
-				Paths: []extensionsBeta1.HTTPIngressPath{
-					{
-						Backend: extensionsBeta1.IngressBackend{
-							ServiceName: tenantNpSvc,
-							ServicePort: tenantNodePortIoS,
-						},
-					},
-				},
-			},
-		},
-	})
-	m3Ingress.Spec.Rules = append(m3Ingress.Spec.Rules, extensionsBeta1.IngressRule{
-		Host: tenantMcsDomain,
-		IngressRuleValue: extensionsBeta1.IngressRuleValue{
-			HTTP: &extensionsBeta1.HTTPIngressRuleValue{
-				Paths: []extensionsBeta1.HTTPIngressPath{
-					{
-						Backend: extensionsBeta1.IngressBackend{
-							ServiceName: tenantMcsnpMcsSvc,
-							ServicePort: tenantMcsNodePortIoS,
-						},
-					},
-				},
-			},
-		},
-	})
+// startIntegrations runs the integrations working in the background until stopCh is closed
+func startIntegrations(integrations []Integration, stopCh <-chan struct{}) error {
+	var errs []error
+	for _, integration := range integrations {
+		if runner, ok := integration.(integrationRunner); ok {
+			if err := runner.Run(stopCh); err != nil {
+				errs = append(errs, fmt.Errorf("integration %s: %v", integration.Name(), err))
+			}
+		}
+	}
+	return utilerrors.NewAggregate(errs)
+}
 
-	_, err = clientset.ExtensionsV1beta1().Ingresses(namespace).Update(context.Background(), m3Ingress, metav1.UpdateOptions{})
+// watchTenantStates calls onChange whenever the operator changes the state of a tenant, the tenants are
+// watched with m3's service account until stopCh is closed
+func watchTenantStates(stopCh <-chan struct{}, onChange func(ctx context.Context, tenant *operator.MinIOInstance, previousState string)) error {
+	opClient, err := cluster.OperatorClient(cluster.GetServiceAccountToken())
 	if err != nil {
 		return err
 	}
+	factory := operatorInformers.NewSharedInformerFactory(opClient, 10*time.Minute)
+	informer := factory.Operator().V1().MinIOInstances().Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldInst, ok := oldObj.(*operator.MinIOInstance)
+			if !ok {
+				return
+			}
+			newInst, ok := newObj.(*operator.MinIOInstance)
+			if !ok || oldInst.Status.CurrentState == newInst.Status.CurrentState {
+				return
+			}
+			ctx := logger.WithFields(context.Background(), logrus.Fields{
+				logger.FieldNamespace: newInst.Namespace,
+				logger.FieldTenant:    newInst.Name,
+			})
+			onChange(ctx, newInst, oldInst.Status.CurrentState)
+		},
+	})
+	go informer.Run(stopCh)
 	return nil
 }
@@ -0,0 +1,254 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/minio/m3/models"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+)
+
+// testIntegration records the hooks called on it and fails them when err is set
+type testIntegration struct {
+	NoopIntegration
+	name    string
+	err     error
+	calls   *[]string
+	removed []*models.RemovedResource
+	status  *models.IntegrationStatus
+}
+
+func (i *testIntegration) Name() string {
+	return i.name
+}
+
+func (i *testIntegration) TenantCreated(ctx context.Context, req *IntegrationRequest) error {
+	*i.calls = append(*i.calls, i.name+".created")
+	return i.err
+}
+
+func (i *testIntegration) TenantDeleted(ctx context.Context, req *IntegrationRequest) ([]*models.RemovedResource, error) {
+	*i.calls = append(*i.calls, i.name+".deleted")
+	return i.removed, i.err
+}
+
+func (i *testIntegration) Status(ctx context.Context, req *IntegrationRequest) *models.IntegrationStatus {
+	return i.status
+}
+
+func Test_newIntegrations(t *testing.T) {
+	RegisterIntegration("test-integration", func() (Integration, error) {
+		return &testIntegration{name: "test-integration"}, nil
+	})
+	RegisterIntegration("test-broken-integration", func() (Integration, error) {
+		return nil, errors.New("missing configuration")
+	})
+	tests := []struct {
+		name      string
+		names     []string
+		wantNames []string
+		wantErr   bool
+	}{
+		{
+			name:      "Registered integrations in order",
+			names:     []string{"test-integration", "gke"},
+			wantNames: []string{"test-integration", "gke"},
+		},
+		{
+			name: "No integrations",
+		},
+		{
+			name:      "Unknown integration skipped",
+			names:     []string{"test-integration", "unknown"},
+			wantNames: []string{"test-integration"},
+			wantErr:   true,
+		},
+		{
+			name:      "Integration configuration error skipped",
+			names:     []string{"test-broken-integration", "test-integration"},
+			wantNames: []string{"test-integration"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			integrations, err := newIntegrations(tt.names)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("newIntegrations() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var names []string
+			for _, integration := range integrations {
+				names = append(names, integration.Name())
+			}
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Errorf("newIntegrations() = %v, want %v", names, tt.wantNames)
+			}
+		})
+	}
+}
+
+func Test_getEnabledIntegrationNames(t *testing.T) {
+	tests := []struct {
+		name string
+		env  map[string]string
+		want []string
+	}{
+		{
+			name: "Nothing enabled",
+		},
+		{
+			name: "Enabled through M3_INTEGRATIONS",
+			env:  map[string]string{M3Integrations: " Ingress, gke,,"},
+			want: []string{"ingress", "gke"},
+		},
+		{
+			name: "Enabled through the legacy variables",
+			env:  map[string]string{M3Ingress: "on", "GKE_INTEGRATION": "true"},
+			want: []string{"ingress", "gke"},
+		},
+		{
+			name: "Enabled twice",
+			env:  map[string]string{M3Integrations: "gke", "GKE_INTEGRATION": "true"},
+			want: []string{"gke"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{M3Integrations, M3Ingress, "GKE_INTEGRATION"} {
+				os.Setenv(name, tt.env[name])
+				defer os.Unsetenv(name)
+			}
+			if got := getEnabledIntegrationNames(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("getEnabledIntegrationNames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_runTenantHooks(t *testing.T) {
+	req := &IntegrationRequest{Tenant: tenantReference("default", "tenant-1")}
+	var calls []string
+	first := &testIntegration{name: "first", calls: &calls, err: errors.New("first failed"),
+		removed: []*models.RemovedResource{{Kind: "Service", Name: "tenant-1-first"}}}
+	second := &testIntegration{name: "second", calls: &calls,
+		removed: []*models.RemovedResource{{Kind: "Service", Name: "tenant-1-second"}}}
+	integrations := []Integration{first, second}
+
+	// the created hooks stop on the first failure
+	if err := runTenantCreatedHooks(context.Background(), integrations, req); err == nil {
+		t.Errorf("runTenantCreatedHooks() should fail")
+	}
+	if want := []string{"first.created"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("runTenantCreatedHooks() calls = %v, want %v", calls, want)
+	}
+
+	// the deleted hooks are called even if some of them fail
+	calls = nil
+	removed, err := runTenantDeletedHooks(context.Background(), integrations, req)
+	if err == nil {
+		t.Errorf("runTenantDeletedHooks() should fail")
+	}
+	if want := []string{"first.deleted", "second.deleted"}; !reflect.DeepEqual(calls, want) {
+		t.Errorf("runTenantDeletedHooks() calls = %v, want %v", calls, want)
+	}
+	if len(removed) != 2 {
+		t.Errorf("runTenantDeletedHooks() removed = %v, want 2", len(removed))
+	}
+}
+
+func Test_getIntegrationsStatus(t *testing.T) {
+	integrations := []Integration{
+		&testIntegration{name: "ready", status: &models.IntegrationStatus{State: models.IntegrationStatusStateReady}},
+		&testIntegration{name: "silent"},
+		&testIntegration{name: "pending", status: &models.IntegrationStatus{State: models.IntegrationStatusStatePending, Message: "waiting"}},
+	}
+	statuses := getIntegrationsStatus(context.Background(), integrations, &IntegrationRequest{Tenant: tenantReference("default", "tenant-1")})
+	if len(statuses) != 2 {
+		t.Fatalf("getIntegrationsStatus() = %v, want 2 statuses", len(statuses))
+	}
+	if statuses[0].Name != "ready" || statuses[1].Name != "pending" || statuses[1].Message != "waiting" {
+		t.Errorf("getIntegrationsStatus() = %v, %v", statuses[0], statuses[1])
+	}
+}
+
+func Test_ingressIntegration(t *testing.T) {
+	tenant := &operator.MinIOInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-1", Namespace: "tenants", UID: "1234"},
+		Spec:       operator.MinIOInstanceSpec{ServiceName: "tenant-1"},
+	}
+	client := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme())
+	integration := &ingressIntegration{
+		config: &ingressConfig{
+			domainTemplate:        "{{.Tenant}}.{{.Namespace}}.example.com",
+			consoleDomainTemplate: "console.{{.Domain}}",
+		},
+		newClient: func(token string) (dynamic.Interface, error) {
+			return client, nil
+		},
+	}
+	req := &IntegrationRequest{Tenant: tenant}
+	ctx := context.Background()
+
+	if err := integration.TenantCreated(ctx, req); err != nil {
+		t.Fatalf("TenantCreated() error = %v", err)
+	}
+	s3Host, consoleHost, err := getTenantHosts([]Integration{integration}, tenant)
+	if err != nil || s3Host != "tenant-1.tenants.example.com" || consoleHost != "" {
+		t.Errorf("getTenantHosts() = %v, %v, %v", s3Host, consoleHost, err)
+	}
+	// without a certificate the ingress controller serves plain http
+	if endpoint, secure, err := getTenantS3Endpoint([]Integration{integration}, tenant); err != nil || endpoint != "tenant-1.tenants.example.com" || secure {
+		t.Errorf("getTenantS3Endpoint() = %v, %v, %v", endpoint, secure, err)
+	}
+	if status := integration.Status(ctx, req); status.State != models.IntegrationStatusStatePending {
+		t.Errorf("Status() = %v, want %v", status.State, models.IntegrationStatusStatePending)
+	}
+
+	// the ingress controller assigns an address
+	ingresses := client.Resource(ingressGVR).Namespace(tenant.Namespace)
+	ingress, err := ingresses.Get(ctx, tenantIngressName(tenant.Name), metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("ingress not created: %v", err)
+	}
+	_ = unstructured.SetNestedSlice(ingress.Object, []interface{}{map[string]interface{}{"ip": "10.0.0.1"}}, "status", "loadBalancer", "ingress")
+	if _, err := ingresses.Update(ctx, ingress, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	if status := integration.Status(ctx, req); status.State != models.IntegrationStatusStateReady {
+		t.Errorf("Status() = %v, want %v", status.State, models.IntegrationStatusStateReady)
+	}
+
+	removed, err := integration.TenantDeleted(ctx, req)
+	if err != nil || len(removed) != 1 {
+		t.Errorf("TenantDeleted() = %v, %v", removed, err)
+	}
+	// deleting it again is not an error
+	removed, err = integration.TenantDeleted(ctx, req)
+	if err != nil || len(removed) != 0 {
+		t.Errorf("TenantDeleted() = %v, %v", removed, err)
+	}
+}
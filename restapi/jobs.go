@@ -0,0 +1,532 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/restapi/operations"
+	"github.com/minio/m3/restapi/operations/admin_api"
+	"github.com/minio/minio-go/v6/pkg/s3utils"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	"github.com/sirupsen/logrus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// m3JobActionLabel identifies the mc jobs and the action they run
+	m3JobActionLabel = "m3.min.io/job-action"
+	// jobContainerName is the name of the container running mc on the pods of the jobs
+	jobContainerName = "mc"
+	// tenantAlias and targetAlias are the mc aliases of the tenant and of the target tenant of a mirror
+	tenantAlias = "tenant"
+	targetAlias = "target"
+	// jobCAsPath is where the certificates of the tenants using an external certificate are mounted on the jobs
+	jobCAsPath = "/etc/m3/cas"
+	// jobConfigDir is the config dir of mc on the jobs, the CAs it trusts are copied to its certs/CAs
+	jobConfigDir = "/tmp/mc"
+)
+
+// jobResource identifies the mc jobs on the errors returned to the users
+var jobResource = schema.GroupResource{Group: "batch", Resource: "jobs"}
+
+func registerJobHandlers(api *operations.M3API) {
+	// List Jobs
+	api.AdminAPIListTenantJobsHandler = admin_api.ListTenantJobsHandlerFunc(func(params admin_api.ListTenantJobsParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getListTenantJobsResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewListTenantJobsDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewListTenantJobsOK().WithPayload(resp)
+	})
+	// Create Job
+	api.AdminAPICreateTenantJobHandler = admin_api.CreateTenantJobHandlerFunc(func(params admin_api.CreateTenantJobParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getCreateTenantJobResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewCreateTenantJobDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewCreateTenantJobCreated().WithPayload(resp)
+	})
+	// Job Info
+	api.AdminAPITenantJobInfoHandler = admin_api.TenantJobInfoHandlerFunc(func(params admin_api.TenantJobInfoParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getTenantJobInfoResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewTenantJobInfoDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewTenantJobInfoOK().WithPayload(resp)
+	})
+	// Job Logs
+	api.AdminAPITenantJobLogsHandler = admin_api.TenantJobLogsHandlerFunc(func(params admin_api.TenantJobLogsParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getTenantJobLogsResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewTenantJobLogsDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewTenantJobLogsOK().WithPayload(resp)
+	})
+	// Delete Job
+	api.AdminAPIDeleteTenantJobHandler = admin_api.DeleteTenantJobHandlerFunc(func(params admin_api.DeleteTenantJobParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		if err := getDeleteTenantJobResponse(ctx, string(*principal), params); err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewDeleteTenantJobDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewDeleteTenantJobNoContent()
+	})
+}
+
+// tenantJobName returns a new name for a job of the tenant, names are kept under 63 characters since they're
+// used as the job-name label of the pods
+func tenantJobName(tenant, action string) string {
+	prefix := fmt.Sprintf("%s-mc-%s", tenant, action)
+	if len(prefix) > 57 {
+		prefix = strings.TrimRight(prefix[:57], "-")
+	}
+	return fmt.Sprintf("%s-%s", prefix, RandomLowerCaseCharString(5))
+}
+
+// jobAliasEnv returns the environment of the mc alias of the tenant, the credentials are read from the secret of the
+// tenant so they're never handled by m3
+func jobAliasEnv(alias string, tenant *operator.MinIOInstance) []corev1.EnvVar {
+	secretName := tenantCredentialsSecretName(tenant)
+	prefix := strings.ToUpper(alias)
+	endpoint, secure := tenantServiceEndpoint(tenant)
+	scheme := "http"
+	if secure {
+		scheme = "https"
+	}
+	secretKeyRef := func(key string) *corev1.EnvVarSource {
+		return &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+			Key:                  key,
+		}}
+	}
+	return []corev1.EnvVar{
+		{Name: prefix + "_URL", Value: fmt.Sprintf("%s://%s", scheme, endpoint)},
+		{Name: prefix + "_ACCESS_KEY", ValueFrom: secretKeyRef("accesskey")},
+		{Name: prefix + "_SECRET_KEY", ValueFrom: secretKeyRef("secretkey")},
+	}
+}
+
+// jobScript returns the shell script run by the jobs, the aliases are set with mc alias set since the keys can
+// include characters that aren't valid on the userinfo of MC_HOST_<alias>, and mc trusts the CA of the cluster,
+// signing the auto cert of the tenants, along with the certificates mounted on jobCAsPath
+func jobScript(aliases []string) string {
+	lines := []string{
+		"set -e",
+		fmt.Sprintf("mkdir -p %s/certs/CAs", jobConfigDir),
+		fmt.Sprintf("if [ -f %[1]s ]; then cp %[1]s %[2]s/certs/CAs/cluster.crt; fi", serviceAccountCAFile, jobConfigDir),
+		fmt.Sprintf("if [ -d %[1]s ]; then cp %[1]s/* %[2]s/certs/CAs/; fi", jobCAsPath, jobConfigDir),
+	}
+	for _, alias := range aliases {
+		prefix := strings.ToUpper(alias)
+		lines = append(lines, fmt.Sprintf(`mc --config-dir %s alias set %s "$%s_URL" "$%s_ACCESS_KEY" "$%s_SECRET_KEY" > /dev/null`,
+			jobConfigDir, alias, prefix, prefix, prefix))
+	}
+	lines = append(lines, fmt.Sprintf(`exec mc --config-dir %s "$@"`, jobConfigDir))
+	return strings.Join(lines, "\n")
+}
+
+// jobCAVolume returns the volume with the certificates of the tenants using an external certificate, the CA and the
+// certificate of kubernetes.io/tls secrets are both mounted since the CA is only set by some issuers, nil when the
+// tenants use auto cert or no tls
+func jobCAVolume(aliases map[string]*operator.MinIOInstance) *corev1.Volume {
+	var sources []corev1.VolumeProjection
+	for _, alias := range []string{tenantAlias, targetAlias} {
+		tenant, ok := aliases[alias]
+		if !ok || !tenant.RequiresExternalCertSetup() {
+			continue
+		}
+		keys := []string{"public.crt"}
+		if tenant.Spec.ExternalCertSecret.Type == string(corev1.SecretTypeTLS) {
+			keys = []string{"ca.crt", corev1.TLSCertKey}
+		}
+		for _, key := range keys {
+			sources = append(sources, corev1.VolumeProjection{Secret: &corev1.SecretProjection{
+				LocalObjectReference: corev1.LocalObjectReference{Name: tenant.Spec.ExternalCertSecret.Name},
+				Items:                []corev1.KeyToPath{{Key: key, Path: alias + "-" + key}},
+				Optional:             swag.Bool(len(keys) > 1),
+			}})
+		}
+	}
+	if len(sources) == 0 {
+		return nil
+	}
+	return &corev1.Volume{Name: "cas", VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: sources}}}
+}
+
+// jobArgs returns the mc arguments of the action requested, target is the tenant a bucket is mirrored to
+func jobArgs(req *models.TenantJobRequest, tenant, target *operator.MinIOInstance) ([]string, error) {
+	var args []string
+	switch swag.StringValue(req.Action) {
+	case models.TenantJobRequestActionMirror:
+		if err := s3utils.CheckValidBucketName(req.Bucket); err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid bucket: %v", err))
+		}
+		if err := s3utils.CheckValidBucketName(req.TargetBucket); err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid target bucket: %v", err))
+		}
+		if target.Name == tenant.Name && req.TargetBucket == req.Bucket {
+			return nil, apierrors.NewBadRequest("a bucket can't be mirrored to itself")
+		}
+		args = append(args, "mirror")
+		if req.Overwrite {
+			args = append(args, "--overwrite")
+		}
+		if req.Remove {
+			args = append(args, "--remove")
+		}
+		return append(args, tenantAlias+"/"+req.Bucket, targetAlias+"/"+req.TargetBucket), nil
+	case models.TenantJobRequestActionHeal:
+		path := tenantAlias
+		if req.Bucket != "" {
+			if err := s3utils.CheckValidBucketName(req.Bucket); err != nil {
+				return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid bucket: %v", err))
+			}
+			path += "/" + req.Bucket
+			if prefix := strings.TrimLeft(req.Prefix, "/"); prefix != "" {
+				path += "/" + prefix
+			}
+		} else if req.Prefix != "" {
+			return nil, apierrors.NewBadRequest("a prefix can only be healed within a bucket")
+		}
+		args = append(args, "admin", "heal")
+		if req.Recursive {
+			args = append(args, "--recursive")
+		}
+		if req.DryRun {
+			args = append(args, "--dry-run")
+		}
+		return append(args, path), nil
+	case models.TenantJobRequestActionAdminInfo:
+		return append(args, "admin", "info", tenantAlias), nil
+	}
+	return nil, apierrors.NewBadRequest(fmt.Sprintf("unsupported action %q", swag.StringValue(req.Action)))
+}
+
+// newTenantJob returns the job running the action requested against the tenant, the job is owned by the tenant so
+// it's removed along with it and it's never retried since the actions aren't expected to be run twice
+func newTenantJob(req *models.TenantJobRequest, tenant, target *operator.MinIOInstance, image string, deadline time.Duration) (*batchv1.Job, error) {
+	action := swag.StringValue(req.Action)
+	args, err := jobArgs(req, tenant, target)
+	if err != nil {
+		return nil, err
+	}
+	aliases := map[string]*operator.MinIOInstance{tenantAlias: tenant}
+	if action == models.TenantJobRequestActionMirror {
+		aliases[targetAlias] = target
+	}
+	var names []string
+	var env []corev1.EnvVar
+	for _, alias := range []string{tenantAlias, targetAlias} {
+		if minInst, ok := aliases[alias]; ok {
+			names = append(names, alias)
+			env = append(env, jobAliasEnv(alias, minInst)...)
+		}
+	}
+	container := corev1.Container{
+		Name:  jobContainerName,
+		Image: image,
+		// the arguments are passed to the script as "$@", mc is $0
+		Command: []string{"/bin/sh", "-c", jobScript(names), "mc"},
+		Args:    args,
+		Env:     env,
+	}
+	var volumes []corev1.Volume
+	if volume := jobCAVolume(aliases); volume != nil {
+		volumes = append(volumes, *volume)
+		container.VolumeMounts = []corev1.VolumeMount{{Name: volume.Name, MountPath: jobCAsPath, ReadOnly: true}}
+	}
+	jobLabels := map[string]string{
+		m3TenantLabel:    tenant.Name,
+		m3JobActionLabel: action,
+	}
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            tenantJobName(tenant.Name, action),
+			Namespace:       tenant.Namespace,
+			Labels:          jobLabels,
+			OwnerReferences: tenant.OwnerRef(),
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:          swag.Int32(0),
+			ActiveDeadlineSeconds: swag.Int64(int64(deadline / time.Second)),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: jobLabels},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers:    []corev1.Container{container},
+					Volumes:       volumes,
+				},
+			},
+		},
+	}, nil
+}
+
+// formatJobTime returns the time in RFC3339, empty when it's not set
+func formatJobTime(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// tenantJobModel returns the job with the status reported by kubernetes and the exit code of mc, pod is the last
+// pod of the job, nil when it's unknown
+func tenantJobModel(job *batchv1.Job, pod *corev1.Pod) *models.TenantJob {
+	resp := &models.TenantJob{
+		Name:      job.Name,
+		Action:    job.Labels[m3JobActionLabel],
+		Status:    models.TenantJobStatusPending,
+		Created:   formatJobTime(&job.CreationTimestamp),
+		Started:   formatJobTime(job.Status.StartTime),
+		Completed: formatJobTime(job.Status.CompletionTime),
+	}
+	for _, container := range job.Spec.Template.Spec.Containers {
+		if container.Name == jobContainerName {
+			// the script setting up the aliases isn't part of the command requested
+			resp.Command = append([]string{"mc"}, container.Args...)
+		}
+	}
+	switch {
+	case job.Status.Succeeded > 0:
+		resp.Status = models.TenantJobStatusSucceeded
+	case job.Status.Failed > 0:
+		resp.Status = models.TenantJobStatusFailed
+	case job.Status.Active > 0:
+		resp.Status = models.TenantJobStatusRunning
+	}
+	for _, condition := range job.Status.Conditions {
+		// jobs stopped by the deadline are failed without a failed pod
+		if condition.Type == batchv1.JobFailed && condition.Status == corev1.ConditionTrue {
+			resp.Status = models.TenantJobStatusFailed
+			resp.Message = condition.Message
+		}
+	}
+	if pod != nil {
+		for _, status := range pod.Status.ContainerStatuses {
+			if status.Name == jobContainerName && status.State.Terminated != nil {
+				resp.ExitCode = swag.Int32(status.State.Terminated.ExitCode)
+				if resp.Message == "" && status.State.Terminated.ExitCode != 0 {
+					resp.Message = status.State.Terminated.Reason
+				}
+			}
+		}
+	}
+	return resp
+}
+
+// getTenantJob returns the job, the jobs not created by m3 for the tenant aren't returned
+func getTenantJob(ctx context.Context, client K8sClient, namespace, tenantName, name string) (*batchv1.Job, error) {
+	job, err := client.getJob(ctx, namespace, name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	if job.Labels[m3TenantLabel] != tenantName || job.Labels[m3JobActionLabel] == "" {
+		return nil, apierrors.NewNotFound(jobResource, name)
+	}
+	return job, nil
+}
+
+// getJobPod returns the last pod created for the job, nil when there's none yet
+func getJobPod(ctx context.Context, client K8sClient, job *batchv1.Job) (*corev1.Pod, error) {
+	pods, err := client.listPods(ctx, job.Namespace, metav1.ListOptions{
+		LabelSelector: labels.SelectorFromSet(labels.Set{"job-name": job.Name}).String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	var last *corev1.Pod
+	for i := range pods.Items {
+		pod := &pods.Items[i]
+		if last == nil || last.CreationTimestamp.Before(&pod.CreationTimestamp) {
+			last = pod
+		}
+	}
+	return last, nil
+}
+
+// createTenantJob launches the job, a bucket can only be mirrored to tenants of the same namespace since the job
+// reads the credentials of both tenants from their secrets
+func createTenantJob(ctx context.Context, opClient OperatorClient, client K8sClient, namespace, tenantName string, req *models.TenantJobRequest, image string, deadline time.Duration) (*models.TenantJob, error) {
+	tenant, err := opClient.MinIOInstanceGet(ctx, namespace, tenantName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	target := tenant
+	if swag.StringValue(req.Action) == models.TenantJobRequestActionMirror && req.TargetTenant != "" && req.TargetTenant != tenantName {
+		if target, err = opClient.MinIOInstanceGet(ctx, namespace, req.TargetTenant, metav1.GetOptions{}); err != nil {
+			return nil, err
+		}
+	}
+	job, err := newTenantJob(req, tenant, target, image, deadline)
+	if err != nil {
+		return nil, err
+	}
+	created, err := client.createJob(ctx, namespace, job, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return tenantJobModel(created, nil), nil
+}
+
+// listTenantJobs returns the jobs of the tenant, the most recent first
+func listTenantJobs(ctx context.Context, client K8sClient, namespace, tenantName string) (*models.ListTenantJobsResponse, error) {
+	selector := labels.SelectorFromSet(labels.Set{m3TenantLabel: tenantName}).String() + "," + m3JobActionLabel
+	jobs, err := client.listJobs(ctx, namespace, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(jobs.Items, func(i, j int) bool {
+		return jobs.Items[j].CreationTimestamp.Before(&jobs.Items[i].CreationTimestamp)
+	})
+	resp := &models.ListTenantJobsResponse{Jobs: []*models.TenantJob{}}
+	for i := range jobs.Items {
+		resp.Jobs = append(resp.Jobs, tenantJobModel(&jobs.Items[i], nil))
+	}
+	return resp, nil
+}
+
+// tenantJobInfo returns the job with the exit code of mc once it finished
+func tenantJobInfo(ctx context.Context, client K8sClient, namespace, tenantName, name string) (*models.TenantJob, error) {
+	job, err := getTenantJob(ctx, client, namespace, tenantName, name)
+	if err != nil {
+		return nil, err
+	}
+	pod, err := getJobPod(ctx, client, job)
+	if err != nil {
+		return nil, err
+	}
+	return tenantJobModel(job, pod), nil
+}
+
+// tenantJobLogs returns the output of mc, it's empty until the pod of the job starts
+func tenantJobLogs(ctx context.Context, client K8sClient, namespace, tenantName, name string, tailLines *int64) (*models.TenantJobLogs, error) {
+	job, err := getTenantJob(ctx, client, namespace, tenantName, name)
+	if err != nil {
+		return nil, err
+	}
+	pod, err := getJobPod(ctx, client, job)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.TenantJobLogs{}
+	if pod == nil || pod.Status.Phase == corev1.PodPending {
+		return resp, nil
+	}
+	resp.Pod = pod.Name
+	logs, err := client.getPodLogs(ctx, namespace, pod.Name, &corev1.PodLogOptions{Container: jobContainerName, TailLines: tailLines})
+	if err != nil {
+		return nil, err
+	}
+	resp.Logs = string(logs)
+	return resp, nil
+}
+
+// deleteTenantJob removes the job along with its pods, running jobs are stopped
+func deleteTenantJob(ctx context.Context, client K8sClient, namespace, tenantName, name string) error {
+	if _, err := getTenantJob(ctx, client, namespace, tenantName, name); err != nil {
+		return err
+	}
+	propagation := metav1.DeletePropagationBackground
+	return client.deleteJob(ctx, namespace, name, metav1.DeleteOptions{PropagationPolicy: &propagation})
+}
+
+func getCreateTenantJobResponse(ctx context.Context, token string, params admin_api.CreateTenantJobParams) (*models.TenantJob, error) {
+	image, err := cluster.GetMCImage()
+	if err != nil {
+		return nil, err
+	}
+	deadline, err := getJobDeadline()
+	if err != nil {
+		return nil, err
+	}
+	opClientClientSet, err := cluster.OperatorClient(token)
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := cluster.K8sClient(token)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := createTenantJob(ctx, &operatorClient{client: opClientClientSet}, &k8sClient{client: clientset}, params.Namespace, params.Tenant, params.Body, *image, deadline)
+	if err != nil {
+		return nil, err
+	}
+	command := strings.Join(resp.Command, " ")
+	logger.FromContext(ctx).WithFields(logrus.Fields{"job": resp.Name, "command": command}).Info("mc job created")
+	getTenantEventRecorder().record(ctx, token, tenantReference(params.Namespace, params.Tenant), corev1.EventTypeNormal, eventReasonTenantJobCreated,
+		"Job %s created to run %s", resp.Name, command)
+	return resp, nil
+}
+
+func getListTenantJobsResponse(ctx context.Context, token string, params admin_api.ListTenantJobsParams) (*models.ListTenantJobsResponse, error) {
+	clientset, err := cluster.K8sClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return listTenantJobs(ctx, &k8sClient{client: clientset}, params.Namespace, params.Tenant)
+}
+
+func getTenantJobInfoResponse(ctx context.Context, token string, params admin_api.TenantJobInfoParams) (*models.TenantJob, error) {
+	clientset, err := cluster.K8sClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return tenantJobInfo(ctx, &k8sClient{client: clientset}, params.Namespace, params.Tenant, params.Job)
+}
+
+func getTenantJobLogsResponse(ctx context.Context, token string, params admin_api.TenantJobLogsParams) (*models.TenantJobLogs, error) {
+	clientset, err := cluster.K8sClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return tenantJobLogs(ctx, &k8sClient{client: clientset}, params.Namespace, params.Tenant, params.Job, params.TailLines)
+}
+
+func getDeleteTenantJobResponse(ctx context.Context, token string, params admin_api.DeleteTenantJobParams) error {
+	clientset, err := cluster.K8sClient(token)
+	if err != nil {
+		return err
+	}
+	if err := deleteTenantJob(ctx, &k8sClient{client: clientset}, params.Namespace, params.Tenant, params.Job); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).WithField("job", params.Job).Info("mc job removed")
+	return nil
+}
@@ -0,0 +1,404 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	v1 "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var k8sClientCreateJobMock func(ctx context.Context, namespace string, job *batchv1.Job, opts metav1.CreateOptions) (*batchv1.Job, error)
+var k8sClientGetJobMock func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*batchv1.Job, error)
+var k8sClientListJobsMock func(ctx context.Context, namespace string, opts metav1.ListOptions) (*batchv1.JobList, error)
+var k8sClientDeleteJobMock func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error
+var k8sClientListPodsMock func(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.PodList, error)
+var k8sClientGetPodLogsMock func(ctx context.Context, namespace, name string, opts *corev1.PodLogOptions) ([]byte, error)
+
+func (c k8sClientMock) createJob(ctx context.Context, namespace string, job *batchv1.Job, opts metav1.CreateOptions) (*batchv1.Job, error) {
+	return k8sClientCreateJobMock(ctx, namespace, job, opts)
+}
+
+func (c k8sClientMock) getJob(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*batchv1.Job, error) {
+	return k8sClientGetJobMock(ctx, namespace, name, opts)
+}
+
+func (c k8sClientMock) listJobs(ctx context.Context, namespace string, opts metav1.ListOptions) (*batchv1.JobList, error) {
+	return k8sClientListJobsMock(ctx, namespace, opts)
+}
+
+func (c k8sClientMock) deleteJob(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+	return k8sClientDeleteJobMock(ctx, namespace, name, opts)
+}
+
+func (c k8sClientMock) listPods(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.PodList, error) {
+	return k8sClientListPodsMock(ctx, namespace, opts)
+}
+
+func (c k8sClientMock) getPodLogs(ctx context.Context, namespace, name string, opts *corev1.PodLogOptions) ([]byte, error) {
+	return k8sClientGetPodLogsMock(ctx, namespace, name, opts)
+}
+
+func jobTenant(name string, secure bool) *v1.MinIOInstance {
+	tenant := &v1.MinIOInstance{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "ns-1", UID: types.UID("uid-" + name)}}
+	tenant.Spec.RequestAutoCert = secure
+	return tenant
+}
+
+func jobEnv(job *batchv1.Job) map[string]corev1.EnvVar {
+	env := map[string]corev1.EnvVar{}
+	for _, value := range job.Spec.Template.Spec.Containers[0].Env {
+		env[value.Name] = value
+	}
+	return env
+}
+
+func Test_newTenantJob(t *testing.T) {
+	tenant := jobTenant("tenant-1", true)
+	target := jobTenant("tenant-2", false)
+	tests := []struct {
+		name     string
+		req      *models.TenantJobRequest
+		target   *v1.MinIOInstance
+		wantArgs []string
+		wantCode int64
+	}{
+		{
+			name:     "Admin info",
+			req:      &models.TenantJobRequest{Action: swag.String("admin-info")},
+			wantArgs: []string{"admin", "info", "tenant"},
+		},
+		{
+			name:     "Heal a prefix",
+			req:      &models.TenantJobRequest{Action: swag.String("heal"), Bucket: "photos", Prefix: "/raw/", Recursive: true, DryRun: true},
+			wantArgs: []string{"admin", "heal", "--recursive", "--dry-run", "tenant/photos/raw/"},
+		},
+		{
+			name:     "Heal the tenant",
+			req:      &models.TenantJobRequest{Action: swag.String("heal")},
+			wantArgs: []string{"admin", "heal", "tenant"},
+		},
+		{
+			name:     "Mirror to another tenant",
+			req:      &models.TenantJobRequest{Action: swag.String("mirror"), Bucket: "photos", TargetBucket: "photos", Overwrite: true, Remove: true},
+			target:   target,
+			wantArgs: []string{"mirror", "--overwrite", "--remove", "tenant/photos", "target/photos"},
+		},
+		{
+			name:     "Mirror to itself",
+			req:      &models.TenantJobRequest{Action: swag.String("mirror"), Bucket: "photos", TargetBucket: "photos"},
+			wantCode: 400,
+		},
+		{
+			name:     "Mirror without target bucket",
+			req:      &models.TenantJobRequest{Action: swag.String("mirror"), Bucket: "photos"},
+			wantCode: 400,
+		},
+		{
+			name:     "Heal a prefix without bucket",
+			req:      &models.TenantJobRequest{Action: swag.String("heal"), Prefix: "raw/"},
+			wantCode: 400,
+		},
+		{
+			name:     "Unsupported action",
+			req:      &models.TenantJobRequest{Action: swag.String("rm")},
+			wantCode: 400,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := tt.target
+			if target == nil {
+				target = tenant
+			}
+			job, err := newTenantJob(tt.req, tenant, target, "minio/mc:latest", time.Hour)
+			if tt.wantCode != 0 {
+				if apiErr := prepareError(context.Background(), err); apiErr == nil || apiErr.Code != tt.wantCode {
+					t.Errorf("newTenantJob() error = %v, want code %d", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("newTenantJob() error = %v", err)
+			}
+			container := job.Spec.Template.Spec.Containers[0]
+			if !reflect.DeepEqual(container.Args, tt.wantArgs) || container.Image != "minio/mc:latest" {
+				t.Errorf("newTenantJob() args = %v, want %v", container.Args, tt.wantArgs)
+			}
+			// the keys are only expanded by the shell so they can include any character
+			script := container.Command[2]
+			if container.Command[0] != "/bin/sh" || container.Command[3] != "mc" ||
+				!strings.Contains(script, `alias set tenant "$TENANT_URL" "$TENANT_ACCESS_KEY" "$TENANT_SECRET_KEY"`) ||
+				strings.Contains(script, "alias set target") != (tt.target != nil) || !strings.HasSuffix(script, `exec mc --config-dir /tmp/mc "$@"`) {
+				t.Errorf("newTenantJob() command = %v", container.Command)
+			}
+			if len(job.Spec.Template.Spec.Volumes) != 0 {
+				t.Errorf("newTenantJob() volumes = %+v, want none with auto cert", job.Spec.Template.Spec.Volumes)
+			}
+			if *job.Spec.BackoffLimit != 0 || *job.Spec.ActiveDeadlineSeconds != 3600 || job.Spec.Template.Spec.RestartPolicy != corev1.RestartPolicyNever {
+				t.Errorf("newTenantJob() spec = %+v", job.Spec)
+			}
+			if job.Labels[m3TenantLabel] != "tenant-1" || job.Labels[m3JobActionLabel] != *tt.req.Action || len(job.OwnerReferences) != 1 ||
+				!strings.HasPrefix(job.Name, "tenant-1-mc-"+*tt.req.Action+"-") {
+				t.Errorf("newTenantJob() metadata = %+v", job.ObjectMeta)
+			}
+			env := jobEnv(job)
+			endpoint, _ := tenantServiceEndpoint(tenant)
+			if env["TENANT_URL"].Value != "https://"+endpoint ||
+				env["TENANT_SECRET_KEY"].ValueFrom.SecretKeyRef.Name != "tenant-1-secret" || env["TENANT_SECRET_KEY"].ValueFrom.SecretKeyRef.Key != "secretkey" {
+				t.Errorf("newTenantJob() env = %+v", env)
+			}
+			if _, ok := env["TARGET_URL"]; ok != (tt.target != nil) {
+				t.Errorf("newTenantJob() target alias set = %v", ok)
+			}
+			if tt.target != nil {
+				targetEndpoint, _ := tenantServiceEndpoint(target)
+				if env["TARGET_URL"].Value != "http://"+targetEndpoint ||
+					env["TARGET_ACCESS_KEY"].ValueFrom.SecretKeyRef.Name != "tenant-2-secret" {
+					t.Errorf("newTenantJob() target env = %+v", env)
+				}
+			}
+		})
+	}
+
+	// the names are valid label values
+	long := jobTenant(strings.Repeat("t", 60), false)
+	job, err := newTenantJob(&models.TenantJobRequest{Action: swag.String("admin-info")}, long, long, "minio/mc:latest", time.Hour)
+	if err != nil || len(job.Name) > 63 {
+		t.Errorf("newTenantJob() name = %s, %v", job.Name, err)
+	}
+}
+
+func Test_jobCAVolume(t *testing.T) {
+	tenant := jobTenant("tenant-1", false)
+	tenant.Spec.ExternalCertSecret = &v1.LocalCertificateReference{Name: "tenant-1-tls", Type: "kubernetes.io/tls"}
+	target := jobTenant("tenant-2", false)
+	target.Spec.ExternalCertSecret = &v1.LocalCertificateReference{Name: "tenant-2-certs"}
+
+	job, err := newTenantJob(&models.TenantJobRequest{Action: swag.String("mirror"), Bucket: "photos", TargetBucket: "photos"},
+		tenant, target, "minio/mc:latest", time.Hour)
+	if err != nil {
+		t.Fatalf("newTenantJob() error = %v", err)
+	}
+	volumes := job.Spec.Template.Spec.Volumes
+	if len(volumes) != 1 || volumes[0].Projected == nil {
+		t.Fatalf("newTenantJob() volumes = %+v", volumes)
+	}
+	var paths []string
+	for _, source := range volumes[0].Projected.Sources {
+		paths = append(paths, source.Secret.Name+":"+source.Secret.Items[0].Key+":"+source.Secret.Items[0].Path)
+	}
+	want := []string{"tenant-1-tls:ca.crt:tenant-ca.crt", "tenant-1-tls:tls.crt:tenant-tls.crt", "tenant-2-certs:public.crt:target-public.crt"}
+	if !reflect.DeepEqual(paths, want) {
+		t.Errorf("newTenantJob() certificates = %v, want %v", paths, want)
+	}
+	mounts := job.Spec.Template.Spec.Containers[0].VolumeMounts
+	if len(mounts) != 1 || mounts[0].Name != volumes[0].Name || mounts[0].MountPath != jobCAsPath {
+		t.Errorf("newTenantJob() mounts = %+v", mounts)
+	}
+}
+
+func Test_tenantJobModel(t *testing.T) {
+	started := metav1.NewTime(time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC))
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-1-mc-heal-abcde", Labels: map[string]string{m3JobActionLabel: "heal"}},
+		Spec: batchv1.JobSpec{Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{
+			{Name: jobContainerName, Command: []string{"mc"}, Args: []string{"admin", "heal", "tenant"}},
+		}}}},
+	}
+	terminated := func(code int32, reason string) *corev1.Pod {
+		return &corev1.Pod{Status: corev1.PodStatus{ContainerStatuses: []corev1.ContainerStatus{
+			{Name: jobContainerName, State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: code, Reason: reason}}},
+		}}}
+	}
+	tests := []struct {
+		name        string
+		status      batchv1.JobStatus
+		pod         *corev1.Pod
+		wantStatus  string
+		wantCode    *int32
+		wantMessage string
+	}{
+		{
+			name:       "Pending",
+			wantStatus: "pending",
+		},
+		{
+			name:       "Running",
+			status:     batchv1.JobStatus{Active: 1, StartTime: &started},
+			pod:        &corev1.Pod{},
+			wantStatus: "running",
+		},
+		{
+			name:       "Succeeded",
+			status:     batchv1.JobStatus{Succeeded: 1, StartTime: &started, CompletionTime: &started},
+			pod:        terminated(0, "Completed"),
+			wantStatus: "succeeded",
+			wantCode:   swag.Int32(0),
+		},
+		{
+			name:        "Failed",
+			status:      batchv1.JobStatus{Failed: 1, StartTime: &started},
+			pod:         terminated(1, "Error"),
+			wantStatus:  "failed",
+			wantCode:    swag.Int32(1),
+			wantMessage: "Error",
+		},
+		{
+			name: "Deadline exceeded",
+			status: batchv1.JobStatus{StartTime: &started, Conditions: []batchv1.JobCondition{
+				{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "Job was active longer than specified deadline"},
+			}},
+			wantStatus:  "failed",
+			wantMessage: "Job was active longer than specified deadline",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := job.DeepCopy()
+			job.Status = tt.status
+			got := tenantJobModel(job, tt.pod)
+			if got.Status != tt.wantStatus || !reflect.DeepEqual(got.ExitCode, tt.wantCode) || got.Message != tt.wantMessage {
+				t.Errorf("tenantJobModel() = %+v", got)
+			}
+			if got.Action != "heal" || strings.Join(got.Command, " ") != "mc admin heal tenant" {
+				t.Errorf("tenantJobModel() command = %v", got.Command)
+			}
+			if tt.status.StartTime != nil && got.Started != "2020-05-01T10:00:00Z" {
+				t.Errorf("tenantJobModel() started = %s", got.Started)
+			}
+		})
+	}
+}
+
+func Test_tenantJobs(t *testing.T) {
+	ctx := context.Background()
+	jobs := map[string]*batchv1.Job{}
+	opClientMinioInstanceGetMock = func(ctx context.Context, namespace string, instanceName string, options metav1.GetOptions) (*v1.MinIOInstance, error) {
+		if instanceName != "tenant-1" {
+			return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "minioinstances"}, instanceName)
+		}
+		return jobTenant(instanceName, false), nil
+	}
+	k8sClientCreateJobMock = func(ctx context.Context, namespace string, job *batchv1.Job, opts metav1.CreateOptions) (*batchv1.Job, error) {
+		jobs[job.Name] = job
+		return job, nil
+	}
+	k8sClientGetJobMock = func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*batchv1.Job, error) {
+		if job, ok := jobs[name]; ok {
+			return job, nil
+		}
+		return nil, apierrors.NewNotFound(jobResource, name)
+	}
+	k8sClientListJobsMock = func(ctx context.Context, namespace string, opts metav1.ListOptions) (*batchv1.JobList, error) {
+		if opts.LabelSelector != "m3.min.io/tenant=tenant-1,m3.min.io/job-action" {
+			t.Errorf("listJobs() selector = %s", opts.LabelSelector)
+		}
+		list := &batchv1.JobList{}
+		for _, job := range jobs {
+			list.Items = append(list.Items, *job)
+		}
+		return list, nil
+	}
+	var deleteOpts metav1.DeleteOptions
+	k8sClientDeleteJobMock = func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+		deleteOpts = opts
+		delete(jobs, name)
+		return nil
+	}
+	k8sClientListPodsMock = func(ctx context.Context, namespace string, opts metav1.ListOptions) (*corev1.PodList, error) {
+		job := jobs[strings.TrimPrefix(opts.LabelSelector, "job-name=")]
+		if job == nil || job.Status.Failed == 0 {
+			return &corev1.PodList{}, nil
+		}
+		first := metav1.NewTime(time.Now().Add(-time.Minute))
+		return &corev1.PodList{Items: []corev1.Pod{
+			{ObjectMeta: metav1.ObjectMeta{Name: job.Name + "-new", CreationTimestamp: metav1.Now()}, Status: corev1.PodStatus{
+				Phase: corev1.PodFailed,
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: jobContainerName, State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: 1, Reason: "Error"}}},
+				},
+			}},
+			{ObjectMeta: metav1.ObjectMeta{Name: job.Name + "-old", CreationTimestamp: first}, Status: corev1.PodStatus{Phase: corev1.PodFailed}},
+		}}, nil
+	}
+	k8sClientGetPodLogsMock = func(ctx context.Context, namespace, name string, opts *corev1.PodLogOptions) ([]byte, error) {
+		if !strings.HasSuffix(name, "-new") || opts.Container != jobContainerName || swag.Int64Value(opts.TailLines) != 10 {
+			t.Errorf("getPodLogs() pod %s, options %+v", name, opts)
+		}
+		return []byte("mc: <ERROR> Unable to get heal status.\n"), nil
+	}
+
+	req := &models.TenantJobRequest{Action: swag.String("heal"), Bucket: "photos"}
+	job, err := createTenantJob(ctx, opClientMock{}, k8sClientMock{}, "ns-1", "tenant-1", req, "minio/mc:latest", time.Hour)
+	if err != nil {
+		t.Fatalf("createTenantJob() error = %v", err)
+	}
+	if job.Status != "pending" || strings.Join(job.Command, " ") != "mc admin heal tenant/photos" {
+		t.Errorf("createTenantJob() = %+v", job)
+	}
+	_, err = createTenantJob(ctx, opClientMock{}, k8sClientMock{}, "ns-1", "tenant-1",
+		&models.TenantJobRequest{Action: swag.String("mirror"), Bucket: "photos", TargetTenant: "tenant-3", TargetBucket: "photos"}, "minio/mc:latest", time.Hour)
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != 404 {
+		t.Errorf("createTenantJob() to a missing tenant error = %v", err)
+	}
+
+	list, err := listTenantJobs(ctx, k8sClientMock{}, "ns-1", "tenant-1")
+	if err != nil || len(list.Jobs) != 1 || list.Jobs[0].Name != job.Name {
+		t.Errorf("listTenantJobs() = %+v, %v", list, err)
+	}
+
+	// the logs are empty until the job has a pod
+	logs, err := tenantJobLogs(ctx, k8sClientMock{}, "ns-1", "tenant-1", job.Name, swag.Int64(10))
+	if err != nil || logs.Logs != "" || logs.Pod != "" {
+		t.Errorf("tenantJobLogs() = %+v, %v", logs, err)
+	}
+	jobs[job.Name].Status = batchv1.JobStatus{Failed: 1}
+	info, err := tenantJobInfo(ctx, k8sClientMock{}, "ns-1", "tenant-1", job.Name)
+	if err != nil || info.Status != "failed" || swag.Int32Value(info.ExitCode) != 1 || info.ExitCode == nil {
+		t.Errorf("tenantJobInfo() = %+v, %v", info, err)
+	}
+	logs, err = tenantJobLogs(ctx, k8sClientMock{}, "ns-1", "tenant-1", job.Name, swag.Int64(10))
+	if err != nil || logs.Pod != job.Name+"-new" || !strings.Contains(logs.Logs, "Unable to get heal status") {
+		t.Errorf("tenantJobLogs() = %+v, %v", logs, err)
+	}
+
+	// the jobs of other tenants aren't returned
+	_, err = tenantJobInfo(ctx, k8sClientMock{}, "ns-1", "tenant-2", job.Name)
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != 404 {
+		t.Errorf("tenantJobInfo() of another tenant error = %v", err)
+	}
+	if err := deleteTenantJob(ctx, k8sClientMock{}, "ns-1", "tenant-2", job.Name); err == nil || len(jobs) != 1 {
+		t.Errorf("deleteTenantJob() of another tenant error = %v", err)
+	}
+	if err := deleteTenantJob(ctx, k8sClientMock{}, "ns-1", "tenant-1", job.Name); err != nil || len(jobs) != 0 ||
+		deleteOpts.PropagationPolicy == nil || *deleteOpts.PropagationPolicy != metav1.DeletePropagationBackground {
+		t.Errorf("deleteTenantJob() error = %v, options %+v", err, deleteOpts)
+	}
+}
@@ -19,7 +19,12 @@ package restapi
 import (
 	"context"
 
+	"github.com/minio/m3/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
+	extensionsBeta1 "k8s.io/api/extensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 )
@@ -29,6 +34,22 @@ import (
 // that are used within this project.
 type K8sClient interface {
 	getResourceQuota(ctx context.Context, namespace, resource string, opts metav1.GetOptions) (*v1.ResourceQuota, error)
+	getSecret(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*v1.Secret, error)
+	createSecret(ctx context.Context, namespace string, secret *v1.Secret, opts metav1.CreateOptions) (*v1.Secret, error)
+	updateSecret(ctx context.Context, namespace string, secret *v1.Secret, opts metav1.UpdateOptions) (*v1.Secret, error)
+	deleteSecret(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error
+	deleteService(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error
+	getIngress(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*extensionsBeta1.Ingress, error)
+	updateIngress(ctx context.Context, namespace string, ingress *extensionsBeta1.Ingress, opts metav1.UpdateOptions) (*extensionsBeta1.Ingress, error)
+	createSelfSubjectAccessReview(ctx context.Context, review *authorizationv1.SelfSubjectAccessReview, opts metav1.CreateOptions) (*authorizationv1.SelfSubjectAccessReview, error)
+	getNamespace(ctx context.Context, name string, opts metav1.GetOptions) (*v1.Namespace, error)
+	listNamespaces(ctx context.Context, opts metav1.ListOptions) (*v1.NamespaceList, error)
+	createJob(ctx context.Context, namespace string, job *batchv1.Job, opts metav1.CreateOptions) (*batchv1.Job, error)
+	getJob(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*batchv1.Job, error)
+	listJobs(ctx context.Context, namespace string, opts metav1.ListOptions) (*batchv1.JobList, error)
+	deleteJob(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error
+	listPods(ctx context.Context, namespace string, opts metav1.ListOptions) (*v1.PodList, error)
+	getPodLogs(ctx context.Context, namespace, name string, opts *v1.PodLogOptions) ([]byte, error)
 }
 
 // Interface implementation
@@ -38,6 +59,104 @@ type k8sClient struct {
 	client *kubernetes.Clientset
 }
 
-func (c *k8sClient) getResourceQuota(ctx context.Context, namespace, resource string, opts metav1.GetOptions) (*v1.ResourceQuota, error) {
+func (c *k8sClient) getResourceQuota(ctx context.Context, namespace, resource string, opts metav1.GetOptions) (_ *v1.ResourceQuota, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.getResourceQuota", attribute.String("namespace", namespace), attribute.String("resource_quota", resource))
+	defer func() { tracing.End(span, err) }()
 	return c.client.CoreV1().ResourceQuotas(namespace).Get(ctx, resource, opts)
 }
+
+func (c *k8sClient) getSecret(ctx context.Context, namespace, name string, opts metav1.GetOptions) (_ *v1.Secret, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.getSecret", attribute.String("namespace", namespace), attribute.String("secret", name))
+	defer func() { tracing.End(span, err) }()
+	return c.client.CoreV1().Secrets(namespace).Get(ctx, name, opts)
+}
+
+func (c *k8sClient) createSecret(ctx context.Context, namespace string, secret *v1.Secret, opts metav1.CreateOptions) (_ *v1.Secret, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.createSecret", attribute.String("namespace", namespace), attribute.String("secret", secret.Name))
+	defer func() { tracing.End(span, err) }()
+	return c.client.CoreV1().Secrets(namespace).Create(ctx, secret, opts)
+}
+
+func (c *k8sClient) updateSecret(ctx context.Context, namespace string, secret *v1.Secret, opts metav1.UpdateOptions) (_ *v1.Secret, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.updateSecret", attribute.String("namespace", namespace), attribute.String("secret", secret.Name))
+	defer func() { tracing.End(span, err) }()
+	return c.client.CoreV1().Secrets(namespace).Update(ctx, secret, opts)
+}
+
+func (c *k8sClient) deleteSecret(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) (err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.deleteSecret", attribute.String("namespace", namespace), attribute.String("secret", name))
+	defer func() { tracing.End(span, err) }()
+	return c.client.CoreV1().Secrets(namespace).Delete(ctx, name, opts)
+}
+
+func (c *k8sClient) deleteService(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) (err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.deleteService", attribute.String("namespace", namespace), attribute.String("service", name))
+	defer func() { tracing.End(span, err) }()
+	return c.client.CoreV1().Services(namespace).Delete(ctx, name, opts)
+}
+
+func (c *k8sClient) getIngress(ctx context.Context, namespace, name string, opts metav1.GetOptions) (_ *extensionsBeta1.Ingress, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.getIngress", attribute.String("namespace", namespace), attribute.String("ingress", name))
+	defer func() { tracing.End(span, err) }()
+	return c.client.ExtensionsV1beta1().Ingresses(namespace).Get(ctx, name, opts)
+}
+
+func (c *k8sClient) updateIngress(ctx context.Context, namespace string, ingress *extensionsBeta1.Ingress, opts metav1.UpdateOptions) (_ *extensionsBeta1.Ingress, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.updateIngress", attribute.String("namespace", namespace), attribute.String("ingress", ingress.Name))
+	defer func() { tracing.End(span, err) }()
+	return c.client.ExtensionsV1beta1().Ingresses(namespace).Update(ctx, ingress, opts)
+}
+
+func (c *k8sClient) createSelfSubjectAccessReview(ctx context.Context, review *authorizationv1.SelfSubjectAccessReview, opts metav1.CreateOptions) (_ *authorizationv1.SelfSubjectAccessReview, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.createSelfSubjectAccessReview")
+	defer func() { tracing.End(span, err) }()
+	return c.client.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, opts)
+}
+
+func (c *k8sClient) getNamespace(ctx context.Context, name string, opts metav1.GetOptions) (_ *v1.Namespace, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.getNamespace", attribute.String("namespace", name))
+	defer func() { tracing.End(span, err) }()
+	return c.client.CoreV1().Namespaces().Get(ctx, name, opts)
+}
+
+func (c *k8sClient) listNamespaces(ctx context.Context, opts metav1.ListOptions) (_ *v1.NamespaceList, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.listNamespaces")
+	defer func() { tracing.End(span, err) }()
+	return c.client.CoreV1().Namespaces().List(ctx, opts)
+}
+
+func (c *k8sClient) createJob(ctx context.Context, namespace string, job *batchv1.Job, opts metav1.CreateOptions) (_ *batchv1.Job, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.createJob", attribute.String("namespace", namespace), attribute.String("job", job.Name))
+	defer func() { tracing.End(span, err) }()
+	return c.client.BatchV1().Jobs(namespace).Create(ctx, job, opts)
+}
+
+func (c *k8sClient) getJob(ctx context.Context, namespace, name string, opts metav1.GetOptions) (_ *batchv1.Job, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.getJob", attribute.String("namespace", namespace), attribute.String("job", name))
+	defer func() { tracing.End(span, err) }()
+	return c.client.BatchV1().Jobs(namespace).Get(ctx, name, opts)
+}
+
+func (c *k8sClient) listJobs(ctx context.Context, namespace string, opts metav1.ListOptions) (_ *batchv1.JobList, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.listJobs", attribute.String("namespace", namespace))
+	defer func() { tracing.End(span, err) }()
+	return c.client.BatchV1().Jobs(namespace).List(ctx, opts)
+}
+
+func (c *k8sClient) deleteJob(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) (err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.deleteJob", attribute.String("namespace", namespace), attribute.String("job", name))
+	defer func() { tracing.End(span, err) }()
+	return c.client.BatchV1().Jobs(namespace).Delete(ctx, name, opts)
+}
+
+func (c *k8sClient) listPods(ctx context.Context, namespace string, opts metav1.ListOptions) (_ *v1.PodList, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.listPods", attribute.String("namespace", namespace))
+	defer func() { tracing.End(span, err) }()
+	return c.client.CoreV1().Pods(namespace).List(ctx, opts)
+}
+
+func (c *k8sClient) getPodLogs(ctx context.Context, namespace, name string, opts *v1.PodLogOptions) (_ []byte, err error) {
+	ctx, span := tracing.Start(ctx, "K8sClient.getPodLogs", attribute.String("namespace", namespace), attribute.String("pod", name))
+	defer func() { tracing.End(span, err) }()
+	return c.client.CoreV1().Pods(namespace).GetLogs(name, opts).DoRaw(ctx)
+}
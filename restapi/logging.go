@@ -0,0 +1,96 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/sirupsen/logrus"
+)
+
+// requestIDHeader is read from incoming requests and always set on responses
+const requestIDHeader = "X-Request-ID"
+
+// maxRequestIDLength limits the size of the request ids accepted from clients
+const maxRequestIDLength = 128
+
+// requestIDMiddleware assigns an id to every request, the id provided by the client on the X-Request-ID
+// header is honored, the id is returned on the response and attached to the request logger
+func requestIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID := strings.TrimSpace(r.Header.Get(requestIDHeader))
+		if requestID == "" || len(requestID) > maxRequestIDLength {
+			requestID = uuid.New().String()
+		}
+		w.Header().Set(requestIDHeader, requestID)
+		ctx := logger.WithFields(r.Context(), logrus.Fields{logger.FieldRequestID: requestID})
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+// logRequest attaches the operation, principal, namespace and tenant of the request to its logger
+// and logs every request once it's served, it must run after routing so the route params are available
+func logRequest(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fields := logrus.Fields{}
+		if route := middleware.MatchedRouteFrom(r); route != nil {
+			if route.Operation != nil {
+				fields[logger.FieldOperation] = route.Operation.ID
+			}
+			if namespace, ok, _ := route.Params.GetOK("namespace"); ok && len(namespace) > 0 {
+				fields[logger.FieldNamespace] = namespace[0]
+			}
+			if tenant, ok, _ := route.Params.GetOK("tenant"); ok && len(tenant) > 0 {
+				fields[logger.FieldTenant] = tenant[0]
+			}
+		}
+		if token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "); token != "" {
+			fields[logger.FieldPrincipal] = principalFingerprint(token)
+		}
+		ctx := logger.WithFields(r.Context(), fields)
+		r = r.WithContext(ctx)
+
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+
+		entry := logger.FromContext(ctx).WithFields(logrus.Fields{
+			"method":   r.Method,
+			"path":     r.URL.Path,
+			"status":   recorder.status,
+			"duration": time.Since(start).String(),
+		})
+		if recorder.status >= http.StatusInternalServerError {
+			entry.Error("request failed")
+		} else {
+			entry.Info("request served")
+		}
+	})
+}
+
+// principalFingerprint identifies the caller on the logs without writing its bearer token
+func principalFingerprint(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])[:12]
+}
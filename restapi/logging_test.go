@@ -0,0 +1,68 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_requestIDMiddleware(t *testing.T) {
+	tests := []struct {
+		name      string
+		requestID string
+		wantSame  bool
+	}{
+		{
+			name:      "Request id provided by the client is kept",
+			requestID: "my-request-id",
+			wantSame:  true,
+		},
+		{
+			name:      "Request id is generated when missing",
+			requestID: "",
+			wantSame:  false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errRequestID string
+			handler := requestIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				errRequestID = prepareError(r.Context(), errors.New("something happened")).RequestID
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/tenants", nil)
+			if tt.requestID != "" {
+				req.Header.Set(requestIDHeader, tt.requestID)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			got := rec.Header().Get(requestIDHeader)
+			if got == "" {
+				t.Errorf("requestIDMiddleware() response is missing the %s header", requestIDHeader)
+			}
+			if tt.wantSame && got != tt.requestID {
+				t.Errorf("requestIDMiddleware() got = %v, want %v", got, tt.requestID)
+			}
+			if errRequestID != got {
+				t.Errorf("prepareError() request id = %v, want %v", errRequestID, got)
+			}
+		})
+	}
+}
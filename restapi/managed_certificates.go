@@ -0,0 +1,163 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/models"
+	gkev1beta2 "github.com/minio/m3/pkg/apis/networking.gke.io/v1beta2"
+	gkeListers "github.com/minio/m3/pkg/clientgen/listers/networking.gke.io/v1beta2"
+	"github.com/minio/m3/restapi/operations"
+	"github.com/minio/m3/restapi/operations/admin_api"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+func registerManagedCertificateHandlers(api *operations.M3API) {
+	// List ManagedCertificates
+	api.AdminAPIListManagedCertificatesHandler = admin_api.ListManagedCertificatesHandlerFunc(func(params admin_api.ListManagedCertificatesParams, principal *models.Principal) middleware.Responder {
+		sessionID := string(*principal)
+		resp, err := getListManagedCertificatesResponse(sessionID, params)
+		if err != nil {
+			apiErr := prepareError(params.HTTPRequest.Context(), err)
+			return admin_api.NewListManagedCertificatesDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewListManagedCertificatesOK().WithPayload(resp)
+	})
+}
+
+// getGKEIntegration returns the GKE integration if it's enabled, a not found error is returned when it isn't
+func getGKEIntegration() (*gkeIntegration, error) {
+	if !sets.NewString(getEnabledIntegrationNames()...).Has(gkeIntegrationName) {
+		return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "integrations"}, gkeIntegrationName)
+	}
+	integrations, err := getEnabledIntegrations()
+	for _, integration := range integrations {
+		if gke, ok := integration.(*gkeIntegration); ok {
+			return gke, nil
+		}
+	}
+	// the integration is enabled but it couldn't be configured
+	return nil, err
+}
+
+// authorizeListManagedCertificates checks the user can list ManagedCertificates on every namespace, the
+// certificates are listed from the cache filled with m3's service account
+func authorizeListManagedCertificates(ctx context.Context, client K8sClient) error {
+	resource := gkev1beta2.Resource("managedcertificates")
+	review, err := client.createSelfSubjectAccessReview(ctx, &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Verb:     "list",
+				Group:    resource.Group,
+				Resource: resource.Resource,
+			},
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return err
+	}
+	if !review.Status.Allowed {
+		return apierrors.NewForbidden(resource, "", errors.New(review.Status.Reason))
+	}
+	return nil
+}
+
+// managedCertificateTenant returns the tenant a ManagedCertificate was created for, certificates not created
+// by m3 are skipped
+func managedCertificateTenant(cert *gkev1beta2.ManagedCertificate) (string, bool) {
+	if tenant, ok := cert.Labels[m3TenantLabel]; ok {
+		return tenant, true
+	}
+	// certificates created before they were labeled are owned by the MinIOInstance
+	for _, owner := range cert.OwnerReferences {
+		if owner.Kind == operator.MinIOCRDResourceKind {
+			return owner.Name, true
+		}
+	}
+	return "", false
+}
+
+// listManagedCertificates returns the ManagedCertificates created by m3, sorted by namespace and name
+func listManagedCertificates(lister gkeListers.ManagedCertificateLister, stuckOnly bool, now time.Time, stuckAfter time.Duration) (*models.ListManagedCertificatesResponse, error) {
+	certs, err := lister.List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(certs, func(i, j int) bool {
+		if certs[i].Namespace != certs[j].Namespace {
+			return certs[i].Namespace < certs[j].Namespace
+		}
+		return certs[i].Name < certs[j].Name
+	})
+	resp := &models.ListManagedCertificatesResponse{}
+	for _, cert := range certs {
+		tenant, ok := managedCertificateTenant(cert)
+		if !ok {
+			continue
+		}
+		stuck, reason := managedCertificateStuck(cert, now, stuckAfter)
+		if stuckOnly && !stuck {
+			continue
+		}
+		resp.Certificates = append(resp.Certificates, &models.ManagedCertificate{
+			Namespace:   cert.Namespace,
+			Tenant:      tenant,
+			Name:        cert.Name,
+			Status:      managedCertificateState(cert),
+			Domains:     managedCertificateDomains(cert),
+			ExpireTime:  cert.Status.ExpireTime,
+			CreatedAt:   strfmt.DateTime(cert.CreationTimestamp.Time),
+			Stuck:       stuck,
+			StuckReason: reason,
+		})
+	}
+	resp.Total = int64(len(resp.Certificates))
+	return resp, nil
+}
+
+func getListManagedCertificatesResponse(token string, params admin_api.ListManagedCertificatesParams) (*models.ListManagedCertificatesResponse, error) {
+	ctx := params.HTTPRequest.Context()
+	gke, err := getGKEIntegration()
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := cluster.K8sClient(token)
+	if err != nil {
+		return nil, err
+	}
+	if err := authorizeListManagedCertificates(ctx, &k8sClient{client: clientset}); err != nil {
+		return nil, err
+	}
+	if !gke.certificatesSynced() {
+		return nil, apierrors.NewServiceUnavailable("the ManagedCertificates cache is not synced yet")
+	}
+	stuckOnly := params.Stuck != nil && *params.Stuck
+	return listManagedCertificates(gke.certificates, stuckOnly, time.Now(), gke.stuckAfter)
+}
@@ -0,0 +1,201 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/minio/m3/models"
+	gkev1beta2 "github.com/minio/m3/pkg/apis/networking.gke.io/v1beta2"
+	gkeListers "github.com/minio/m3/pkg/clientgen/listers/networking.gke.io/v1beta2"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+)
+
+var k8sClientCreateSelfSubjectAccessReviewMock func(ctx context.Context, review *authorizationv1.SelfSubjectAccessReview, opts metav1.CreateOptions) (*authorizationv1.SelfSubjectAccessReview, error)
+
+// mock function of createSelfSubjectAccessReview()
+func (c k8sClientMock) createSelfSubjectAccessReview(ctx context.Context, review *authorizationv1.SelfSubjectAccessReview, opts metav1.CreateOptions) (*authorizationv1.SelfSubjectAccessReview, error) {
+	return k8sClientCreateSelfSubjectAccessReviewMock(ctx, review, opts)
+}
+
+// managedCertificate returns a ManagedCertificate created age ago with the given status for every domain
+func managedCertificate(namespace, name string, age time.Duration, status string, domains map[string]string) *gkev1beta2.ManagedCertificate {
+	cert := &gkev1beta2.ManagedCertificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			CreationTimestamp: metav1.NewTime(time.Now().Add(-age)),
+		},
+		Status: gkev1beta2.ManagedCertificateStatus{CertificateStatus: status},
+	}
+	for domain, domainStatus := range domains {
+		cert.Spec.Domains = append(cert.Spec.Domains, domain)
+		cert.Status.DomainStatus = append(cert.Status.DomainStatus, gkev1beta2.DomainStatus{Domain: domain, Status: domainStatus})
+	}
+	return cert
+}
+
+func Test_managedCertificateStuck(t *testing.T) {
+	tests := []struct {
+		name       string
+		cert       *gkev1beta2.ManagedCertificate
+		wantStuck  bool
+		wantReason string
+	}{
+		{
+			name: "Active",
+			cert: managedCertificate("default", "tenant-1-cert", 48*time.Hour, "Active", map[string]string{"tenant-1.cloud.min.dev": "Active"}),
+		},
+		{
+			name: "Provisioning",
+			cert: managedCertificate("default", "tenant-1-cert", 10*time.Minute, "Provisioning", map[string]string{"tenant-1.cloud.min.dev": "Provisioning"}),
+		},
+		{
+			name:       "Provisioning for too long",
+			cert:       managedCertificate("default", "tenant-1-cert", 3*time.Hour, "Provisioning", map[string]string{"tenant-1.cloud.min.dev": "Provisioning"}),
+			wantStuck:  true,
+			wantReason: "provisioning for more than 2h0m0s",
+		},
+		{
+			name:       "Domain not pointing to the load balancer",
+			cert:       managedCertificate("default", "tenant-1-cert", 10*time.Minute, "Provisioning", map[string]string{"tenant-1.cloud.min.dev": "FailedNotVisible"}),
+			wantStuck:  true,
+			wantReason: "domain tenant-1.cloud.min.dev is FailedNotVisible",
+		},
+		{
+			name:       "Provisioning failed",
+			cert:       managedCertificate("default", "tenant-1-cert", 10*time.Minute, "ProvisioningFailedPermanently", nil),
+			wantStuck:  true,
+			wantReason: "certificate is ProvisioningFailedPermanently",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stuck, reason := managedCertificateStuck(tt.cert, time.Now(), 2*time.Hour)
+			if stuck != tt.wantStuck || reason != tt.wantReason {
+				t.Errorf("managedCertificateStuck() = %v %q, want %v %q", stuck, reason, tt.wantStuck, tt.wantReason)
+			}
+		})
+	}
+}
+
+func Test_managedCertificateIntegrationStatus(t *testing.T) {
+	active := managedCertificate("default", "tenant-1-cert", time.Hour, "Active", map[string]string{"tenant-1.cloud.min.dev": "Active"})
+	active.Status.ExpireTime = "2021-01-01T00:00:00Z"
+	status := managedCertificateIntegrationStatus(active, time.Now(), 2*time.Hour)
+	if status.State != models.IntegrationStatusStateReady || !status.Certificate.Ready || status.Certificate.NotAfter.String() != "2021-01-01T00:00:00.000Z" {
+		t.Errorf("managedCertificateIntegrationStatus() = %+v %+v", status, status.Certificate)
+	}
+	if len(status.Certificate.Domains) != 1 || status.Certificate.Domains[0].Status != "Active" {
+		t.Errorf("managedCertificateIntegrationStatus() domains = %v", status.Certificate.Domains)
+	}
+
+	stuck := managedCertificate("default", "tenant-1-cert", time.Hour, "", map[string]string{"tenant-1.cloud.min.dev": "FailedNotVisible"})
+	status = managedCertificateIntegrationStatus(stuck, time.Now(), 2*time.Hour)
+	if status.State != models.IntegrationStatusStateError || status.Certificate.Ready {
+		t.Errorf("managedCertificateIntegrationStatus() = %+v", status)
+	}
+
+	provisioning := managedCertificate("default", "tenant-1-cert", time.Hour, "", map[string]string{"tenant-1.cloud.min.dev": "Provisioning"})
+	status = managedCertificateIntegrationStatus(provisioning, time.Now(), 2*time.Hour)
+	if status.State != models.IntegrationStatusStatePending || status.Message != "certificate tenant-1-cert is Provisioning" {
+		t.Errorf("managedCertificateIntegrationStatus() = %+v", status)
+	}
+}
+
+func Test_listManagedCertificates(t *testing.T) {
+	labeled := managedCertificate("tenants", "tenant-2-cert", time.Hour, "Active", nil)
+	labeled.Labels = map[string]string{m3TenantLabel: "tenant-2"}
+	owned := managedCertificate("default", "tenant-1-cert", 3*time.Hour, "Provisioning", nil)
+	owned.OwnerReferences = []metav1.OwnerReference{{Kind: "MinIOInstance", Name: "tenant-1"}}
+	notOwned := managedCertificate("default", "m3-cert", time.Hour, "Active", nil)
+
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{})
+	for _, cert := range []*gkev1beta2.ManagedCertificate{labeled, owned, notOwned} {
+		if err := indexer.Add(cert); err != nil {
+			t.Fatal(err)
+		}
+	}
+	lister := gkeListers.NewManagedCertificateLister(indexer)
+
+	resp, err := listManagedCertificates(lister, false, time.Now(), 2*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Total != 2 || resp.Certificates[0].Tenant != "tenant-1" || resp.Certificates[1].Tenant != "tenant-2" {
+		t.Fatalf("listManagedCertificates() = %+v", resp.Certificates)
+	}
+	if !resp.Certificates[0].Stuck || resp.Certificates[1].Stuck {
+		t.Errorf("listManagedCertificates() stuck = %v, %v", resp.Certificates[0].Stuck, resp.Certificates[1].Stuck)
+	}
+
+	resp, err = listManagedCertificates(lister, true, time.Now(), 2*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Total != 1 || resp.Certificates[0].Name != "tenant-1-cert" {
+		t.Errorf("listManagedCertificates() stuck only = %+v", resp.Certificates)
+	}
+}
+
+func Test_authorizeListManagedCertificates(t *testing.T) {
+	tests := []struct {
+		name    string
+		allowed bool
+		wantErr bool
+	}{
+		{name: "Allowed", allowed: true},
+		{name: "Forbidden", allowed: false, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClientCreateSelfSubjectAccessReviewMock = func(ctx context.Context, review *authorizationv1.SelfSubjectAccessReview, opts metav1.CreateOptions) (*authorizationv1.SelfSubjectAccessReview, error) {
+				attrs := review.Spec.ResourceAttributes
+				if attrs.Group != "networking.gke.io" || attrs.Resource != "managedcertificates" || attrs.Verb != "list" || attrs.Namespace != "" {
+					t.Errorf("unexpected access review %+v", attrs)
+				}
+				review.Status.Allowed = tt.allowed
+				return review, nil
+			}
+			err := authorizeListManagedCertificates(context.Background(), k8sClientMock{})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("authorizeListManagedCertificates() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil && !apierrors.IsForbidden(err) {
+				t.Errorf("authorizeListManagedCertificates() error = %v, want Forbidden", err)
+			}
+		})
+	}
+}
+
+func Test_getGKEIntegrationDisabled(t *testing.T) {
+	for _, name := range []string{M3Integrations, M3Ingress, "GKE_INTEGRATION"} {
+		defer os.Setenv(name, os.Getenv(name))
+		os.Unsetenv(name)
+	}
+	_, err := getGKEIntegration()
+	if apiErr := prepareError(context.Background(), err); apiErr == nil || apiErr.Code != http.StatusNotFound {
+		t.Errorf("getGKEIntegration() error = %v, want Not Found", err)
+	}
+}
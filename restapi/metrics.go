@@ -0,0 +1,146 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/prometheus/client_golang/prometheus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// metricsPath is the path where m3 exposes its prometheus metrics, it doesn't require authentication
+const metricsPath = "/metrics"
+
+var (
+	httpRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "m3",
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Number of http requests served by operation and status code.",
+	}, []string{"operation", "code"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "m3",
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of the http requests served by operation and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "code"})
+)
+
+func init() {
+	prometheus.MustRegister(httpRequestsTotal, httpRequestDuration)
+	prometheus.MustRegister(newTenantsCollector(func() (OperatorClient, error) {
+		opClientClientSet, err := cluster.OperatorClient(cluster.GetServiceAccountToken())
+		if err != nil {
+			return nil, err
+		}
+		return &operatorClient{
+			client: opClientClientSet,
+		}, nil
+	}))
+}
+
+// statusRecorder keeps the status code written by the handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+// WriteHeader implements http.ResponseWriter
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// instrumentHandler records count and latency of every request by swagger operation id and status code,
+// it must run after routing so the matched route is available on the request
+func instrumentHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		operation := "unknown"
+		if route := middleware.MatchedRouteFrom(r); route != nil && route.Operation != nil {
+			operation = route.Operation.ID
+		}
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r)
+		code := strconv.Itoa(recorder.status)
+		httpRequestsTotal.WithLabelValues(operation, code).Inc()
+		httpRequestDuration.WithLabelValues(operation, code).Observe(time.Since(start).Seconds())
+	})
+}
+
+// tenantsCollector exports the number of tenants by state and by namespace, tenants are
+// listed on every scrape using m3's own service account
+type tenantsCollector struct {
+	newClient   func() (OperatorClient, error)
+	byState     *prometheus.Desc
+	byNamespace *prometheus.Desc
+}
+
+func newTenantsCollector(newClient func() (OperatorClient, error)) *tenantsCollector {
+	return &tenantsCollector{
+		newClient:   newClient,
+		byState:     prometheus.NewDesc("m3_tenants", "Number of tenants by state.", []string{"state"}, nil),
+		byNamespace: prometheus.NewDesc("m3_tenants_by_namespace", "Number of tenants by namespace.", []string{"namespace"}, nil),
+	}
+}
+
+// Describe implements prometheus.Collector
+func (c *tenantsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.byState
+	ch <- c.byNamespace
+}
+
+// Collect implements prometheus.Collector
+func (c *tenantsCollector) Collect(ch chan<- prometheus.Metric) {
+	opClient, err := c.newClient()
+	if err != nil {
+		logger.Log.WithError(err).Error("error getting operator client")
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	minInstances, err := opClient.MinIOInstanceList(ctx, "", metav1.ListOptions{})
+	if err != nil {
+		logger.Log.WithError(err).Error("error listing tenants")
+		return
+	}
+	byState := make(map[string]int)
+	byNamespace := make(map[string]int)
+	for _, minInst := range minInstances.Items {
+		state := minInst.Status.CurrentState
+		if state == "" {
+			state = "Unknown"
+		}
+		byState[state]++
+		byNamespace[minInst.ObjectMeta.Namespace]++
+	}
+	for state, count := range byState {
+		ch <- prometheus.MustNewConstMetric(c.byState, prometheus.GaugeValue, float64(count), state)
+	}
+	for namespace, count := range byNamespace {
+		ch <- prometheus.MustNewConstMetric(c.byNamespace, prometheus.GaugeValue, float64(count), namespace)
+	}
+}
@@ -0,0 +1,159 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	oaerrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/restapi/operations"
+	"github.com/minio/m3/restapi/operations/admin_api"
+	v1 "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_tenantsCollector(t *testing.T) {
+	opClient := opClientMock{}
+	newTenant := func(namespace, name, state string) v1.MinIOInstance {
+		return v1.MinIOInstance{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Status:     v1.MinIOInstanceStatus{CurrentState: state},
+		}
+	}
+	tests := []struct {
+		name                  string
+		mockMinioInstanceList func(ctx context.Context, namespace string, opts metav1.ListOptions) (*v1.MinIOInstanceList, error)
+		want                  string
+	}{
+		{
+			name: "Tenants grouped by state and namespace",
+			mockMinioInstanceList: func(ctx context.Context, namespace string, opts metav1.ListOptions) (*v1.MinIOInstanceList, error) {
+				return &v1.MinIOInstanceList{Items: []v1.MinIOInstance{
+					newTenant("ns-1", "tenant-1", "Ready"),
+					newTenant("ns-1", "tenant-2", "Ready"),
+					newTenant("ns-2", "tenant-3", ""),
+				}}, nil
+			},
+			want: `
+# HELP m3_tenants Number of tenants by state.
+# TYPE m3_tenants gauge
+m3_tenants{state="Ready"} 2
+m3_tenants{state="Unknown"} 1
+# HELP m3_tenants_by_namespace Number of tenants by namespace.
+# TYPE m3_tenants_by_namespace gauge
+m3_tenants_by_namespace{namespace="ns-1"} 2
+m3_tenants_by_namespace{namespace="ns-2"} 1
+`,
+		},
+		{
+			name: "Error listing tenants exports nothing",
+			mockMinioInstanceList: func(ctx context.Context, namespace string, opts metav1.ListOptions) (*v1.MinIOInstanceList, error) {
+				return nil, errors.New("something happened")
+			},
+			want: "",
+		},
+	}
+	for _, tt := range tests {
+		opClientMinioInstanceListMock = tt.mockMinioInstanceList
+		t.Run(tt.name, func(t *testing.T) {
+			collector := newTenantsCollector(func() (OperatorClient, error) {
+				return opClient, nil
+			})
+			if err := testutil.CollectAndCompare(collector, strings.NewReader(tt.want)); err != nil {
+				t.Errorf("tenantsCollector.Collect() %v", err)
+			}
+		})
+	}
+}
+
+func Test_instrumentHandler(t *testing.T) {
+	swaggerSpec, err := loads.Embedded(SwaggerJSON, FlatSwaggerJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	api := operations.NewM3API(swaggerSpec)
+	api.Logger = t.Logf
+	api.KeyAuth = func(token string, scopes []string) (*models.Principal, error) {
+		if token == "" {
+			return nil, oaerrors.New(401, "authentication token not provided")
+		}
+		prin := models.Principal(token)
+		return &prin, nil
+	}
+	api.AdminAPIListAllTenantsHandler = admin_api.ListAllTenantsHandlerFunc(func(params admin_api.ListAllTenantsParams, principal *models.Principal) middleware.Responder {
+		return admin_api.NewListAllTenantsDefault(http.StatusForbidden).WithPayload(&models.Error{Code: http.StatusForbidden})
+	})
+	handler := api.Serve(instrumentHandler)
+
+	tests := []struct {
+		name      string
+		token     string
+		wantCode  int
+		operation string
+	}{
+		{
+			name:      "Request served by the handler",
+			token:     "token",
+			wantCode:  http.StatusForbidden,
+			operation: "ListAllTenants",
+		},
+		{
+			name:      "Request rejected before the handler",
+			wantCode:  http.StatusUnauthorized,
+			operation: "ListAllTenants",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code := http.StatusText(tt.wantCode)
+			counter := httpRequestsTotal.WithLabelValues(tt.operation, strconv.Itoa(tt.wantCode))
+			before := testutil.ToFloat64(counter)
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/tenants", nil)
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			if rec.Code != tt.wantCode {
+				t.Fatalf("status = %d, want %s", rec.Code, code)
+			}
+			if got := testutil.ToFloat64(counter) - before; got != 1 {
+				t.Errorf("m3_http_requests_total{operation=%q,code=\"%d\"} increased by %v, want 1", tt.operation, tt.wantCode, got)
+			}
+		})
+	}
+
+	// the requests that didn't match a route are recorded as unknown with the status written by the handler
+	counter := httpRequestsTotal.WithLabelValues("unknown", "418")
+	before := testutil.ToFloat64(counter)
+	instrumentHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTeapot)
+	})).ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/", nil))
+	if got := testutil.ToFloat64(counter) - before; got != 1 {
+		t.Errorf(`m3_http_requests_total{operation="unknown",code="418"} increased by %v, want 1`, got)
+	}
+}
@@ -0,0 +1,421 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/pkg/tracing"
+	minio "github.com/minio/minio-go/v6"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// objectLockNotFound is the S3 error code returned when the bucket was created without object lock
+const objectLockNotFound = "ObjectLockConfigurationNotFoundError"
+
+// MinioClient interface with all functions to be implemented
+// by mock when testing, it should include all the minio-go api calls
+// done against the tenants that are used within this project.
+type MinioClient interface {
+	listBuckets(ctx context.Context) ([]minio.BucketInfo, error)
+	makeBucket(ctx context.Context, bucket, location string, objectLock bool) error
+	removeBucket(ctx context.Context, bucket string) error
+	getBucketVersioning(ctx context.Context, bucket string) (string, error)
+	setBucketVersioning(ctx context.Context, bucket string, enabled bool) error
+	getBucketObjectLockConfig(ctx context.Context, bucket string) (enabled bool, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit, err error)
+	setBucketObjectLockConfig(ctx context.Context, bucket string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) error
+	getBucketLifecycle(ctx context.Context, bucket string) (string, error)
+	setBucketLifecycle(ctx context.Context, bucket, lifecycle string) error
+	getBucketNotification(ctx context.Context, bucket string) (minio.BucketNotification, error)
+	setBucketNotification(ctx context.Context, bucket string, config minio.BucketNotification) error
+	getBucketLocation(ctx context.Context, bucket string) (string, error)
+	listObjects(ctx context.Context, bucket, prefix, delimiter, continuationToken string, maxKeys int) (minio.ListBucketV2Result, error)
+	statObject(ctx context.Context, bucket, object string) (minio.ObjectInfo, error)
+	presign(ctx context.Context, method, bucket, object string, expires time.Duration) (*url.URL, error)
+}
+
+// Interface implementation
+//
+// Define the structure of a minio client and define the functions that are actually used
+// from minio-go.
+type minioClient struct {
+	client *minio.Client
+}
+
+func (c *minioClient) listBuckets(ctx context.Context) (_ []minio.BucketInfo, err error) {
+	ctx, span := tracing.Start(ctx, "MinioClient.listBuckets")
+	defer func() { tracing.End(span, err) }()
+	return c.client.ListBucketsWithContext(ctx)
+}
+
+func (c *minioClient) makeBucket(ctx context.Context, bucket, location string, objectLock bool) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioClient.makeBucket", attribute.String("bucket", bucket), attribute.Bool("object_lock", objectLock))
+	defer func() { tracing.End(span, err) }()
+	if objectLock {
+		return c.client.MakeBucketWithObjectLockWithContext(ctx, bucket, location)
+	}
+	return c.client.MakeBucketWithContext(ctx, bucket, location)
+}
+
+func (c *minioClient) removeBucket(ctx context.Context, bucket string) (err error) {
+	_, span := tracing.Start(ctx, "MinioClient.removeBucket", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	return c.client.RemoveBucket(bucket)
+}
+
+func (c *minioClient) getBucketVersioning(ctx context.Context, bucket string) (_ string, err error) {
+	ctx, span := tracing.Start(ctx, "MinioClient.getBucketVersioning", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	config, err := c.client.GetBucketVersioningWithContext(ctx, bucket)
+	if err != nil {
+		return "", err
+	}
+	return config.Status, nil
+}
+
+func (c *minioClient) setBucketVersioning(ctx context.Context, bucket string, enabled bool) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioClient.setBucketVersioning", attribute.String("bucket", bucket), attribute.Bool("enabled", enabled))
+	defer func() { tracing.End(span, err) }()
+	if enabled {
+		return c.client.EnableVersioningWithContext(ctx, bucket)
+	}
+	return c.client.DisableVersioningWithContext(ctx, bucket)
+}
+
+func (c *minioClient) getBucketObjectLockConfig(ctx context.Context, bucket string) (enabled bool, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit, err error) {
+	_, span := tracing.Start(ctx, "MinioClient.getBucketObjectLockConfig", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	objectLock, mode, validity, unit, err := c.client.GetObjectLockConfig(bucket)
+	if err != nil {
+		// buckets created without object lock have no configuration
+		if minio.ToErrorResponse(err).Code == objectLockNotFound {
+			return false, nil, nil, nil, nil
+		}
+		return false, nil, nil, nil, err
+	}
+	return objectLock == "Enabled", mode, validity, unit, nil
+}
+
+func (c *minioClient) setBucketObjectLockConfig(ctx context.Context, bucket string, mode *minio.RetentionMode, validity *uint, unit *minio.ValidityUnit) (err error) {
+	_, span := tracing.Start(ctx, "MinioClient.setBucketObjectLockConfig", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	return c.client.SetBucketObjectLockConfig(bucket, mode, validity, unit)
+}
+
+// getBucketLifecycle returns the lifecycle configuration xml of the bucket, empty when it has no configuration
+func (c *minioClient) getBucketLifecycle(ctx context.Context, bucket string) (_ string, err error) {
+	_, span := tracing.Start(ctx, "MinioClient.getBucketLifecycle", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	return c.client.GetBucketLifecycle(bucket)
+}
+
+// setBucketLifecycle replaces the lifecycle configuration xml of the bucket, an empty configuration removes it
+func (c *minioClient) setBucketLifecycle(ctx context.Context, bucket, lifecycle string) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioClient.setBucketLifecycle", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	return c.client.SetBucketLifecycleWithContext(ctx, bucket, lifecycle)
+}
+
+func (c *minioClient) getBucketNotification(ctx context.Context, bucket string) (_ minio.BucketNotification, err error) {
+	_, span := tracing.Start(ctx, "MinioClient.getBucketNotification", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	return c.client.GetBucketNotification(bucket)
+}
+
+func (c *minioClient) setBucketNotification(ctx context.Context, bucket string, config minio.BucketNotification) (err error) {
+	ctx, span := tracing.Start(ctx, "MinioClient.setBucketNotification", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	return c.client.SetBucketNotificationWithContext(ctx, bucket, config)
+}
+
+func (c *minioClient) getBucketLocation(ctx context.Context, bucket string) (_ string, err error) {
+	_, span := tracing.Start(ctx, "MinioClient.getBucketLocation", attribute.String("bucket", bucket))
+	defer func() { tracing.End(span, err) }()
+	return c.client.GetBucketLocation(bucket)
+}
+
+// listObjects returns a page of the objects of the bucket, the objects under the delimiter are grouped on the
+// common prefixes
+func (c *minioClient) listObjects(ctx context.Context, bucket, prefix, delimiter, continuationToken string, maxKeys int) (_ minio.ListBucketV2Result, err error) {
+	_, span := tracing.Start(ctx, "MinioClient.listObjects", attribute.String("bucket", bucket), attribute.String("prefix", prefix))
+	defer func() { tracing.End(span, err) }()
+	return minio.Core{Client: c.client}.ListObjectsV2(bucket, prefix, continuationToken, false, delimiter, maxKeys, "")
+}
+
+func (c *minioClient) statObject(ctx context.Context, bucket, object string) (_ minio.ObjectInfo, err error) {
+	ctx, span := tracing.Start(ctx, "MinioClient.statObject", attribute.String("bucket", bucket), attribute.String("object", object))
+	defer func() { tracing.End(span, err) }()
+	return c.client.StatObjectWithContext(ctx, bucket, object, minio.StatObjectOptions{})
+}
+
+// presign returns a url signed with the credentials of the client, it's computed locally once the region of the
+// bucket is known
+func (c *minioClient) presign(ctx context.Context, method, bucket, object string, expires time.Duration) (_ *url.URL, err error) {
+	_, span := tracing.Start(ctx, "MinioClient.presign", attribute.String("bucket", bucket), attribute.String("method", method))
+	defer func() { tracing.End(span, err) }()
+	return c.client.Presign(method, bucket, object, expires, nil)
+}
+
+// tenantCredentials are the root credentials of a tenant
+type tenantCredentials struct {
+	accessKey string
+	secretKey string
+}
+
+// tenantCredentialsSecretName returns the name of the secret holding the root credentials of the tenant
+func tenantCredentialsSecretName(tenant *operator.MinIOInstance) string {
+	if tenant.Spec.CredsSecret != nil && tenant.Spec.CredsSecret.Name != "" {
+		return tenant.Spec.CredsSecret.Name
+	}
+	return tenantSecretName(tenant.Name)
+}
+
+// getTenantCredentials reads the root credentials of the tenant from its secret, the caller needs to be
+// allowed to get the secret so the kubernetes RBAC decides who can manage the contents of a tenant
+func getTenantCredentials(ctx context.Context, client K8sClient, tenant *operator.MinIOInstance) (*tenantCredentials, error) {
+	secretName := tenantCredentialsSecretName(tenant)
+	secret, err := client.getSecret(ctx, tenant.Namespace, secretName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	creds := &tenantCredentials{
+		accessKey: string(secret.Data["accesskey"]),
+		secretKey: string(secret.Data["secretkey"]),
+	}
+	if creds.accessKey == "" || creds.secretKey == "" {
+		return nil, fmt.Errorf("secret %s doesn't contain the credentials of tenant %s", secretName, tenant.Name)
+	}
+	return creds, nil
+}
+
+// tenantServiceEndpoint returns the host and port of the service of the tenant and whether it's served over tls
+func tenantServiceEndpoint(minInst *operator.MinIOInstance) (string, bool) {
+	minInst = minInst.DeepCopy().EnsureDefaults()
+	secure := minInst.RequiresAutoCertSetup() || minInst.RequiresExternalCertSetup()
+	return fmt.Sprintf("%s.%s.svc.%s:%d", minInst.MinIOCIServiceName(), minInst.Namespace, operator.ClusterDomain, operator.MinIOPort), secure
+}
+
+// serviceAccountCAFile is the CA of the cluster mounted in the pods, it signs the certificates requested by the
+// operator for the tenants using auto cert
+var serviceAccountCAFile = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+
+// tenantCACertificate returns the certificate of the external certificate secret of the tenant trusted by m3, the CA
+// of kubernetes.io/tls secrets when the issuer sets it or the certificate itself
+func tenantCACertificate(secret *corev1.Secret) []byte {
+	if secret.Type == corev1.SecretTypeTLS {
+		if ca := secret.Data["ca.crt"]; len(ca) > 0 {
+			return ca
+		}
+		return secret.Data[corev1.TLSCertKey]
+	}
+	return secret.Data["public.crt"]
+}
+
+// clusterCertPool returns the system roots along with the CA of the cluster, which is only mounted when m3 runs
+// inside kubernetes
+func clusterCertPool() *x509.CertPool {
+	rootCAs, err := x509.SystemCertPool()
+	if err != nil {
+		rootCAs = x509.NewCertPool()
+	}
+	if clusterCA, err := ioutil.ReadFile(serviceAccountCAFile); err == nil {
+		rootCAs.AppendCertsFromPEM(clusterCA)
+	}
+	return rootCAs
+}
+
+// tenantTLSConfig returns the tls config used to connect to the tenant, its certificate is verified with the
+// cluster roots and the CA of the external certificate of the tenant when it's configured
+func tenantTLSConfig(ctx context.Context, client K8sClient, tenant *operator.MinIOInstance) (*tls.Config, error) {
+	if !tenant.RequiresExternalCertSetup() {
+		return cachedTenantTLSConfig(nil)
+	}
+	secret, err := client.getSecret(ctx, tenant.Namespace, tenant.Spec.ExternalCertSecret.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := cachedTenantTLSConfig(tenantCACertificate(secret))
+	if err != nil {
+		return nil, fmt.Errorf("secret %s doesn't contain a certificate of tenant %s", secret.Name, tenant.Name)
+	}
+	return tlsConfig, nil
+}
+
+// the timeouts of the transports of the tenants, they also bound the minio-go calls that don't take a context
+const (
+	tenantDialTimeout           = 10 * time.Second
+	tenantTLSHandshakeTimeout   = 10 * time.Second
+	tenantResponseHeaderTimeout = 30 * time.Second
+	tenantIdleConnTimeout       = 90 * time.Second
+)
+
+// tenantTLSConfigs caches the tls configs by the CA trusted on top of the cluster roots and tenantTransports the
+// transport of every tls config, so the connections to the tenants are reused by the requests instead of being
+// left open on a new transport every time
+var (
+	tenantTransportsMu sync.Mutex
+	tenantTLSConfigs   = map[string]*tls.Config{}
+	tenantTransports   = map[*tls.Config]*http.Transport{}
+)
+
+// cachedTenantTLSConfig returns the tls config trusting the cluster roots and ca, it fails when ca doesn't contain
+// any certificate
+func cachedTenantTLSConfig(ca []byte) (*tls.Config, error) {
+	tenantTransportsMu.Lock()
+	defer tenantTransportsMu.Unlock()
+	if tlsConfig, ok := tenantTLSConfigs[string(ca)]; ok {
+		return tlsConfig, nil
+	}
+	rootCAs := clusterCertPool()
+	if ca != nil && !rootCAs.AppendCertsFromPEM(ca) {
+		return nil, errors.New("no certificate found")
+	}
+	tlsConfig := &tls.Config{RootCAs: rootCAs, MinVersion: tls.VersionTLS12}
+	tenantTLSConfigs[string(ca)] = tlsConfig
+	return tlsConfig, nil
+}
+
+// newTenantTransport returns the transport of the clients of a tenant using tlsConfig, a nil tlsConfig verifies
+// the certificates with the system roots. The transports are shared by every client using the same tls config
+func newTenantTransport(tlsConfig *tls.Config) *http.Transport {
+	tenantTransportsMu.Lock()
+	defer tenantTransportsMu.Unlock()
+	if transport, ok := tenantTransports[tlsConfig]; ok {
+		return transport
+	}
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   tenantDialTimeout,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		TLSClientConfig:       tlsConfig,
+		TLSHandshakeTimeout:   tenantTLSHandshakeTimeout,
+		ResponseHeaderTimeout: tenantResponseHeaderTimeout,
+		IdleConnTimeout:       tenantIdleConnTimeout,
+		MaxIdleConnsPerHost:   16,
+	}
+	tenantTransports[tlsConfig] = transport
+	return transport
+}
+
+// newMinioClient returns a minio-go client for the endpoint, the certificates are verified with tlsConfig
+func newMinioClient(endpoint string, secure bool, creds *tenantCredentials, tlsConfig *tls.Config) (*minio.Client, error) {
+	client, err := minio.New(endpoint, creds.accessKey, creds.secretKey, secure)
+	if err != nil {
+		return nil, err
+	}
+	client.SetCustomTransport(newTenantTransport(tlsConfig))
+	return client, nil
+}
+
+// getTenantMinioClient returns a client of the tenant service authenticated with the root credentials of the
+// tenant, both the tenant and its secret are read with the token of the caller
+func getTenantMinioClient(ctx context.Context, opClient OperatorClient, client K8sClient, namespace, tenantName string) (MinioClient, error) {
+	clients, err := getTenantClients(ctx, opClient, client, namespace, tenantName)
+	if err != nil {
+		return nil, err
+	}
+	return clients.s3, nil
+}
+
+// newTenantMinioClient returns the client of the tenant for the caller authenticated by token
+func newTenantMinioClient(ctx context.Context, token, namespace, tenantName string) (MinioClient, error) {
+	clients, err := newTenantClients(ctx, token, namespace, tenantName)
+	if err != nil {
+		return nil, err
+	}
+	return clients.s3, nil
+}
+
+// tenantRequestContext adds the tenant to the logger of a request managing the contents of the tenant
+func tenantRequestContext(ctx context.Context, namespace, tenant string) context.Context {
+	return logger.WithFields(ctx, logrus.Fields{
+		logger.FieldNamespace: namespace,
+		logger.FieldTenant:    tenant,
+	})
+}
+
+// tenantClients are the clients of a tenant used by the requests that need both the s3 and the admin api
+type tenantClients struct {
+	tenant *operator.MinIOInstance
+	k8s    K8sClient
+	s3     MinioClient
+	admin  MinioAdmin
+}
+
+// tenantClientsFor returns the s3 and admin clients of the tenant service authenticated with the root credentials
+// of the tenant, the secrets of the tenant are read with the client of the caller so only the users allowed to get
+// them can manage the tenant. Every client of a tenant is built here
+func tenantClientsFor(ctx context.Context, client K8sClient, tenant *operator.MinIOInstance) (*tenantClients, error) {
+	creds, err := getTenantCredentials(ctx, client, tenant)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := tenantTLSConfig(ctx, client, tenant)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, secure := tenantServiceEndpoint(tenant)
+	s3Client, err := newMinioClient(endpoint, secure, creds, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	admClient, err := newAdminClient(endpoint, secure, creds, tlsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &tenantClients{tenant: tenant, k8s: client, s3: &minioClient{client: s3Client}, admin: admClient}, nil
+}
+
+// getTenantClients returns the clients of a tenant, the tenant is read with the token of the caller
+func getTenantClients(ctx context.Context, opClient OperatorClient, client K8sClient, namespace, tenantName string) (*tenantClients, error) {
+	tenant, err := opClient.MinIOInstanceGet(ctx, namespace, tenantName, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	return tenantClientsFor(ctx, client, tenant)
+}
+
+// newTenantClients returns the clients of the tenant for the caller authenticated by token
+func newTenantClients(ctx context.Context, token, namespace, tenantName string) (*tenantClients, error) {
+	opClientClientSet, err := cluster.OperatorClient(token)
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := cluster.K8sClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return getTenantClients(ctx, &operatorClient{client: opClientClientSet}, &k8sClient{client: clientset}, namespace, tenantName)
+}
@@ -0,0 +1,586 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/restapi/operations"
+	"github.com/minio/m3/restapi/operations/admin_api"
+	minio "github.com/minio/minio-go/v6"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// notificationTargetsSecretKey is the key of the notification targets secret of a tenant holding the targets,
+// credentials included, as a JSON list
+const notificationTargetsSecretKey = "targets.json"
+
+// notificationTargetResource identifies the notification targets on the errors returned to the users
+var notificationTargetResource = schema.GroupResource{Group: "m3.min.io", Resource: "notificationtargets"}
+
+// notificationPasswordMask replaces the credentials of the targets returned to the users
+const notificationPasswordMask = "xxxxx"
+
+func registerNotificationHandlers(api *operations.M3API) {
+	// List Notification Targets
+	api.AdminAPIListTenantNotificationTargetsHandler = admin_api.ListTenantNotificationTargetsHandlerFunc(func(params admin_api.ListTenantNotificationTargetsParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getListTenantNotificationTargetsResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewListTenantNotificationTargetsDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewListTenantNotificationTargetsOK().WithPayload(resp)
+	})
+	// Create Notification Target
+	api.AdminAPICreateTenantNotificationTargetHandler = admin_api.CreateTenantNotificationTargetHandlerFunc(func(params admin_api.CreateTenantNotificationTargetParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getCreateTenantNotificationTargetResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewCreateTenantNotificationTargetDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewCreateTenantNotificationTargetCreated().WithPayload(resp)
+	})
+	// Update Notification Target
+	api.AdminAPIUpdateTenantNotificationTargetHandler = admin_api.UpdateTenantNotificationTargetHandlerFunc(func(params admin_api.UpdateTenantNotificationTargetParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getUpdateTenantNotificationTargetResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewUpdateTenantNotificationTargetDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewUpdateTenantNotificationTargetOK().WithPayload(resp)
+	})
+	// Delete Notification Target
+	api.AdminAPIDeleteTenantNotificationTargetHandler = admin_api.DeleteTenantNotificationTargetHandlerFunc(func(params admin_api.DeleteTenantNotificationTargetParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		if err := getDeleteTenantNotificationTargetResponse(ctx, string(*principal), params); err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewDeleteTenantNotificationTargetDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewDeleteTenantNotificationTargetNoContent()
+	})
+	// Get Bucket Notifications
+	api.AdminAPIGetTenantBucketNotificationsHandler = admin_api.GetTenantBucketNotificationsHandlerFunc(func(params admin_api.GetTenantBucketNotificationsParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getGetTenantBucketNotificationsResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewGetTenantBucketNotificationsDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewGetTenantBucketNotificationsOK().WithPayload(resp)
+	})
+	// Set Bucket Notifications
+	api.AdminAPISetTenantBucketNotificationsHandler = admin_api.SetTenantBucketNotificationsHandlerFunc(func(params admin_api.SetTenantBucketNotificationsParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getSetTenantBucketNotificationsResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewSetTenantBucketNotificationsDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewSetTenantBucketNotificationsOK().WithPayload(resp)
+	})
+	// Delete Bucket Notifications
+	api.AdminAPIDeleteTenantBucketNotificationsHandler = admin_api.DeleteTenantBucketNotificationsHandlerFunc(func(params admin_api.DeleteTenantBucketNotificationsParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		if err := getDeleteTenantBucketNotificationsResponse(ctx, string(*principal), params); err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewDeleteTenantBucketNotificationsDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewDeleteTenantBucketNotificationsNoContent()
+	})
+}
+
+// notificationTargetsSecretName returns the name of the secret holding the notification targets of the tenant
+func notificationTargetsSecretName(tenant string) string {
+	return fmt.Sprintf("%s-notification-targets", tenant)
+}
+
+// notificationTargetName returns the name of the target on the configuration of the tenant, ie: notify_webhook:1
+func notificationTargetName(targetType, id string) string {
+	return fmt.Sprintf("notify_%s:%s", targetType, id)
+}
+
+// notificationTargetArn returns the arn used by the bucket notification rules to send events to the target
+func notificationTargetArn(targetType, id string) string {
+	return fmt.Sprintf("arn:minio:sqs::%s:%s", id, targetType)
+}
+
+// loadNotificationTargets returns the notification targets stored on the secret of the tenant and the secret
+// itself, nil if it doesn't exist yet
+func loadNotificationTargets(ctx context.Context, client K8sClient, tenant *operator.MinIOInstance) ([]*models.NotificationTarget, *corev1.Secret, error) {
+	secretName := notificationTargetsSecretName(tenant.Name)
+	secret, err := client.getSecret(ctx, tenant.Namespace, secretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	var targets []*models.NotificationTarget
+	if data := secret.Data[notificationTargetsSecretKey]; len(data) > 0 {
+		if err := json.Unmarshal(data, &targets); err != nil {
+			return nil, nil, fmt.Errorf("invalid %s on secret %s: %v", notificationTargetsSecretKey, secretName, err)
+		}
+	}
+	return targets, secret, nil
+}
+
+// saveNotificationTargets stores the notification targets creating the secret if needed, the secret is owned by
+// the tenant so it's removed along with it
+func saveNotificationTargets(ctx context.Context, client K8sClient, tenant *operator.MinIOInstance, secret *corev1.Secret, targets []*models.NotificationTarget) error {
+	data, err := json.Marshal(targets)
+	if err != nil {
+		return err
+	}
+	if secret == nil {
+		_, err = client.createSecret(ctx, tenant.Namespace, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            notificationTargetsSecretName(tenant.Name),
+				OwnerReferences: tenant.OwnerRef(),
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: map[string][]byte{notificationTargetsSecretKey: data},
+		}, metav1.CreateOptions{})
+		return err
+	}
+	secret = secret.DeepCopy()
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	secret.Data[notificationTargetsSecretKey] = data
+	_, err = client.updateSecret(ctx, tenant.Namespace, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// findNotificationTarget returns the index of the target, -1 when it doesn't exist
+func findNotificationTarget(targets []*models.NotificationTarget, targetType, id string) int {
+	for i, target := range targets {
+		if swag.StringValue(target.Type) == targetType && swag.StringValue(target.ID) == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// keepNotificationCredentials keeps the stored credentials the update of a target sends back masked since they're
+// never returned to the users, the credentials sent empty are cleared
+func keepNotificationCredentials(target, stored *models.NotificationTarget) {
+	switch {
+	case target.Webhook != nil && stored.Webhook != nil:
+		keepMaskedCredential(&target.Webhook.AuthToken, stored.Webhook.AuthToken)
+	case target.Kafka != nil && stored.Kafka != nil:
+		keepMaskedCredential(&target.Kafka.SaslPassword, stored.Kafka.SaslPassword)
+	case target.Nats != nil && stored.Nats != nil:
+		keepMaskedCredential(&target.Nats.Password, stored.Nats.Password)
+		keepMaskedCredential(&target.Nats.Token, stored.Nats.Token)
+	case target.Amqp != nil && stored.Amqp != nil:
+		if target.Amqp.URL == "" {
+			target.Amqp.URL = stored.Amqp.URL
+		}
+		// the url returned to the users has its password masked, it's the stored one when it's sent back
+		u, err := url.Parse(target.Amqp.URL)
+		if err != nil || u.User == nil {
+			break
+		}
+		if password, ok := u.User.Password(); !ok || password != notificationPasswordMask {
+			break
+		}
+		if storedURL, err := url.Parse(stored.Amqp.URL); err == nil && storedURL.User != nil {
+			if password, ok := storedURL.User.Password(); ok {
+				u.User = url.UserPassword(u.User.Username(), password)
+				target.Amqp.URL = u.String()
+			}
+		}
+	}
+}
+
+// keepMaskedCredential replaces the mask with the stored credential
+func keepMaskedCredential(value *string, stored string) {
+	if *value == notificationPasswordMask {
+		*value = stored
+	}
+}
+
+// maskCredential returns the mask for the credentials that are set
+func maskCredential(value string) string {
+	if value == "" {
+		return ""
+	}
+	return notificationPasswordMask
+}
+
+// notificationTargetModel returns the target with its credentials masked
+func notificationTargetModel(target *models.NotificationTarget) *models.NotificationTarget {
+	resp := &models.NotificationTarget{
+		ID:   target.ID,
+		Type: target.Type,
+		Arn:  notificationTargetArn(swag.StringValue(target.Type), swag.StringValue(target.ID)),
+	}
+	if target.Webhook != nil {
+		resp.Webhook = &models.NotificationTargetWebhook{Endpoint: target.Webhook.Endpoint, AuthToken: maskCredential(target.Webhook.AuthToken)}
+	}
+	if target.Kafka != nil {
+		kafka := *target.Kafka
+		kafka.SaslPassword = maskCredential(kafka.SaslPassword)
+		resp.Kafka = &kafka
+	}
+	if target.Nats != nil {
+		nats := *target.Nats
+		nats.Password, nats.Token = maskCredential(nats.Password), maskCredential(nats.Token)
+		resp.Nats = &nats
+	}
+	if target.Amqp != nil {
+		amqp := *target.Amqp
+		if u, err := url.Parse(amqp.URL); err == nil && u.User != nil {
+			if _, ok := u.User.Password(); ok {
+				u.User = url.UserPassword(u.User.Username(), notificationPasswordMask)
+			}
+			amqp.URL = u.String()
+		}
+		resp.Amqp = &amqp
+	}
+	return resp
+}
+
+// validateNotificationTarget checks that the target only sets the configuration of its type, the values are
+// quoted on the configuration of the tenant so they can't include quotes
+func validateNotificationTarget(target *models.NotificationTarget) error {
+	targetType := swag.StringValue(target.Type)
+	configs := map[string]bool{
+		"webhook": target.Webhook != nil,
+		"kafka":   target.Kafka != nil,
+		"nats":    target.Nats != nil,
+		"amqp":    target.Amqp != nil,
+	}
+	for configType, set := range configs {
+		if set != (configType == targetType) {
+			return apierrors.NewBadRequest(fmt.Sprintf("%s targets only accept the %s configuration", targetType, targetType))
+		}
+	}
+	for _, kv := range notificationTargetValues(target) {
+		if strings.ContainsAny(kv[1], "\"\n\r") {
+			return apierrors.NewBadRequest(fmt.Sprintf("%s can't include quotes or line breaks", kv[0]))
+		}
+	}
+	switch targetType {
+	case "webhook":
+		return validateWebhookURL(swag.StringValue(target.Webhook.Endpoint))
+	case "amqp":
+		u, err := url.Parse(target.Amqp.URL)
+		if err != nil || (u.Scheme != "amqp" && u.Scheme != "amqps") || u.Host == "" {
+			return apierrors.NewBadRequest("an amqp or amqps url is required")
+		}
+	}
+	return nil
+}
+
+// onOff returns the value of a boolean setting on the configuration of the tenant
+func onOff(value bool) string {
+	if value {
+		return "on"
+	}
+	return "off"
+}
+
+// notificationTargetValues returns the settings of the target on the configuration of the tenant
+func notificationTargetValues(target *models.NotificationTarget) [][2]string {
+	values := [][2]string{{"enable", "on"}}
+	switch {
+	case target.Webhook != nil:
+		values = append(values, [2]string{"endpoint", swag.StringValue(target.Webhook.Endpoint)}, [2]string{"auth_token", target.Webhook.AuthToken})
+	case target.Kafka != nil:
+		values = append(values,
+			[2]string{"brokers", strings.Join(target.Kafka.Brokers, ",")},
+			[2]string{"topic", swag.StringValue(target.Kafka.Topic)},
+			[2]string{"tls", onOff(target.Kafka.TLS)},
+			[2]string{"sasl", onOff(target.Kafka.SaslUsername != "")},
+			[2]string{"sasl_username", target.Kafka.SaslUsername},
+			[2]string{"sasl_password", target.Kafka.SaslPassword})
+	case target.Nats != nil:
+		values = append(values,
+			[2]string{"address", swag.StringValue(target.Nats.Address)},
+			[2]string{"subject", swag.StringValue(target.Nats.Subject)},
+			[2]string{"username", target.Nats.Username},
+			[2]string{"password", target.Nats.Password},
+			[2]string{"token", target.Nats.Token},
+			[2]string{"tls", onOff(target.Nats.TLS)})
+	case target.Amqp != nil:
+		values = append(values,
+			[2]string{"url", target.Amqp.URL},
+			[2]string{"exchange", target.Amqp.Exchange},
+			[2]string{"exchange_type", target.Amqp.ExchangeType},
+			[2]string{"routing_key", target.Amqp.RoutingKey},
+			[2]string{"durable", onOff(target.Amqp.Durable)})
+	}
+	return values
+}
+
+// notificationTargetKV returns the configuration of the target as set through the admin api, ie:
+// notify_webhook:1 enable="on" endpoint="http://..."
+func notificationTargetKV(target *models.NotificationTarget) string {
+	kv := []string{notificationTargetName(swag.StringValue(target.Type), swag.StringValue(target.ID))}
+	for _, value := range notificationTargetValues(target) {
+		if value[1] != "" {
+			kv = append(kv, fmt.Sprintf(`%s="%s"`, value[0], value[1]))
+		}
+	}
+	return strings.Join(kv, " ")
+}
+
+// listNotificationTargets returns the notification targets of the tenant with their credentials masked
+func listNotificationTargets(ctx context.Context, client K8sClient, tenant *operator.MinIOInstance) (*models.ListNotificationTargetsResponse, error) {
+	targets, _, err := loadNotificationTargets(ctx, client, tenant)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.ListNotificationTargetsResponse{Targets: []*models.NotificationTarget{}}
+	for _, target := range targets {
+		resp.Targets = append(resp.Targets, notificationTargetModel(target))
+	}
+	return resp, nil
+}
+
+// setNotificationTarget adds or updates a notification target, it's applied to the tenant before it's stored so
+// the targets rejected by the tenant aren't kept, the tenants only load the change after a restart
+func setNotificationTarget(ctx context.Context, clients *tenantClients, target *models.NotificationTarget, create, restart bool) (*models.NotificationTargetResponse, error) {
+	targetType, id := swag.StringValue(target.Type), swag.StringValue(target.ID)
+	targets, secret, err := loadNotificationTargets(ctx, clients.k8s, clients.tenant)
+	if err != nil {
+		return nil, err
+	}
+	i := findNotificationTarget(targets, targetType, id)
+	switch {
+	case create && i >= 0:
+		return nil, apierrors.NewAlreadyExists(notificationTargetResource, targetType+"/"+id)
+	case !create && i < 0:
+		return nil, apierrors.NewNotFound(notificationTargetResource, targetType+"/"+id)
+	case !create:
+		keepNotificationCredentials(target, targets[i])
+	}
+	if err := validateNotificationTarget(target); err != nil {
+		return nil, err
+	}
+	if err := clients.admin.setConfigKV(ctx, notificationTargetKV(target)); err != nil {
+		return nil, err
+	}
+	target.Arn = ""
+	if i >= 0 {
+		targets[i] = target
+	} else {
+		targets = append(targets, target)
+	}
+	if err := saveNotificationTargets(ctx, clients.k8s, clients.tenant, secret, targets); err != nil {
+		return nil, err
+	}
+	if restart {
+		if err := clients.admin.serviceRestart(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return &models.NotificationTargetResponse{Target: notificationTargetModel(target), RestartRequired: !restart}, nil
+}
+
+// deleteNotificationTarget removes a notification target from the tenant and from the secret
+func deleteNotificationTarget(ctx context.Context, clients *tenantClients, targetType, id string, restart bool) error {
+	targets, secret, err := loadNotificationTargets(ctx, clients.k8s, clients.tenant)
+	if err != nil {
+		return err
+	}
+	i := findNotificationTarget(targets, targetType, id)
+	if i < 0 {
+		return apierrors.NewNotFound(notificationTargetResource, targetType+"/"+id)
+	}
+	if err := clients.admin.delConfigKV(ctx, notificationTargetName(targetType, id)); err != nil {
+		return err
+	}
+	if err := saveNotificationTargets(ctx, clients.k8s, clients.tenant, secret, append(targets[:i], targets[i+1:]...)); err != nil {
+		return err
+	}
+	if restart {
+		return clients.admin.serviceRestart(ctx)
+	}
+	return nil
+}
+
+// getBucketNotifications returns the rules sending the events of the bucket to the notification targets
+func getBucketNotifications(ctx context.Context, client MinioClient, bucket string) (*models.BucketNotifications, error) {
+	config, err := client.getBucketNotification(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.BucketNotifications{Rules: []*models.NotificationRule{}}
+	for _, queue := range config.QueueConfigs {
+		rule := &models.NotificationRule{ID: queue.ID, TargetArn: swag.String(queue.Queue), Events: []string{}}
+		for _, event := range queue.Events {
+			rule.Events = append(rule.Events, string(event))
+		}
+		if queue.Filter != nil {
+			for _, filter := range queue.Filter.S3Key.FilterRules {
+				switch filter.Name {
+				case "prefix":
+					rule.Prefix = filter.Value
+				case "suffix":
+					rule.Suffix = filter.Value
+				}
+			}
+		}
+		resp.Rules = append(resp.Rules, rule)
+	}
+	return resp, nil
+}
+
+// setBucketNotifications replaces the rules of the bucket, the topic and lambda configurations aren't used by
+// MinIO but they're kept as they were
+func setBucketNotifications(ctx context.Context, client MinioClient, bucket string, notifications *models.BucketNotifications) (*models.BucketNotifications, error) {
+	for _, rule := range notifications.Rules {
+		if !strings.HasPrefix(swag.StringValue(rule.TargetArn), "arn:minio:sqs:") {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid target arn %q", swag.StringValue(rule.TargetArn)))
+		}
+	}
+	current, err := client.getBucketNotification(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	config := minio.BucketNotification{TopicConfigs: current.TopicConfigs, LambdaConfigs: current.LambdaConfigs}
+	for _, rule := range notifications.Rules {
+		queue := minio.QueueConfig{NotificationConfig: minio.NotificationConfig{ID: rule.ID}, Queue: swag.StringValue(rule.TargetArn)}
+		for _, event := range rule.Events {
+			queue.AddEvents(minio.NotificationEventType(event))
+		}
+		if rule.Prefix != "" {
+			queue.AddFilterPrefix(rule.Prefix)
+		}
+		if rule.Suffix != "" {
+			queue.AddFilterSuffix(rule.Suffix)
+		}
+		config.QueueConfigs = append(config.QueueConfigs, queue)
+	}
+	if err := client.setBucketNotification(ctx, bucket, config); err != nil {
+		return nil, err
+	}
+	return getBucketNotifications(ctx, client, bucket)
+}
+
+func getListTenantNotificationTargetsResponse(ctx context.Context, token string, params admin_api.ListTenantNotificationTargetsParams) (*models.ListNotificationTargetsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+	defer cancel()
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return listNotificationTargets(ctx, clients.k8s, clients.tenant)
+}
+
+func getCreateTenantNotificationTargetResponse(ctx context.Context, token string, params admin_api.CreateTenantNotificationTargetParams) (*models.NotificationTargetResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+	defer cancel()
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := setNotificationTarget(ctx, clients, params.Body, true, swag.BoolValue(params.Restart))
+	if err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).WithField("target", resp.Target.Arn).Info("notification target created")
+	return resp, nil
+}
+
+func getUpdateTenantNotificationTargetResponse(ctx context.Context, token string, params admin_api.UpdateTenantNotificationTargetParams) (*models.NotificationTargetResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+	defer cancel()
+	if swag.StringValue(params.Body.Type) != params.Type || swag.StringValue(params.Body.ID) != params.ID {
+		return nil, apierrors.NewBadRequest("the type and the id of a notification target can't be changed")
+	}
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := setNotificationTarget(ctx, clients, params.Body, false, swag.BoolValue(params.Restart))
+	if err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).WithField("target", resp.Target.Arn).Info("notification target updated")
+	return resp, nil
+}
+
+func getDeleteTenantNotificationTargetResponse(ctx context.Context, token string, params admin_api.DeleteTenantNotificationTargetParams) error {
+	ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+	defer cancel()
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return err
+	}
+	if err := deleteNotificationTarget(ctx, clients, params.Type, params.ID, swag.BoolValue(params.Restart)); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).WithField("target", notificationTargetArn(params.Type, params.ID)).Info("notification target removed")
+	return nil
+}
+
+func getGetTenantBucketNotificationsResponse(ctx context.Context, token string, params admin_api.GetTenantBucketNotificationsParams) (*models.BucketNotifications, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return getBucketNotifications(ctx, clients.s3, params.Bucket)
+}
+
+func getSetTenantBucketNotificationsResponse(ctx context.Context, token string, params admin_api.SetTenantBucketNotificationsParams) (*models.BucketNotifications, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := setBucketNotifications(ctx, clients.s3, params.Bucket, params.Body)
+	if err != nil {
+		return nil, err
+	}
+	logger.FromContext(ctx).WithField("rules", len(resp.Rules)).Info("bucket notifications updated")
+	return resp, nil
+}
+
+func getDeleteTenantBucketNotificationsResponse(ctx context.Context, token string, params admin_api.DeleteTenantBucketNotificationsParams) error {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return err
+	}
+	if _, err := setBucketNotifications(ctx, clients.s3, params.Bucket, &models.BucketNotifications{}); err != nil {
+		return err
+	}
+	logger.FromContext(ctx).Info("bucket notifications removed")
+	return nil
+}
@@ -0,0 +1,268 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/restapi/operations"
+	"github.com/minio/m3/restapi/operations/admin_api"
+	minio "github.com/minio/minio-go/v6"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// defaultObjectsLimit is the size of the pages of objects when the request doesn't set it
+	defaultObjectsLimit = 100
+	// maxObjectsLimit is the largest page of objects returned by S3
+	maxObjectsLimit = 1000
+	// defaultPresignExpiry is how long a presigned url is valid when the request doesn't set it
+	defaultPresignExpiry = 15 * time.Minute
+)
+
+func registerObjectHandlers(api *operations.M3API) {
+	// List Objects
+	api.AdminAPIListTenantBucketObjectsHandler = admin_api.ListTenantBucketObjectsHandlerFunc(func(params admin_api.ListTenantBucketObjectsParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getListTenantBucketObjectsResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewListTenantBucketObjectsDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewListTenantBucketObjectsOK().WithPayload(resp)
+	})
+	// Object Info
+	api.AdminAPITenantBucketObjectInfoHandler = admin_api.TenantBucketObjectInfoHandlerFunc(func(params admin_api.TenantBucketObjectInfoParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getTenantBucketObjectInfoResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewTenantBucketObjectInfoDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewTenantBucketObjectInfoOK().WithPayload(resp)
+	})
+	// Presign Object
+	api.AdminAPIPresignTenantBucketObjectHandler = admin_api.PresignTenantBucketObjectHandlerFunc(func(params admin_api.PresignTenantBucketObjectParams, principal *models.Principal) middleware.Responder {
+		ctx := bucketContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant, params.Bucket)
+		resp, err := getPresignTenantBucketObjectResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewPresignTenantBucketObjectDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewPresignTenantBucketObjectOK().WithPayload(resp)
+	})
+}
+
+// bucketObjectModel returns the object as listed or stated, the metadata is only known when stated
+func bucketObjectModel(info minio.ObjectInfo) *models.BucketObject {
+	object := &models.BucketObject{
+		Name:         info.Key,
+		Size:         info.Size,
+		LastModified: info.LastModified.UTC().Format(time.RFC3339),
+		Etag:         info.ETag,
+		ContentType:  info.ContentType,
+		StorageClass: info.StorageClass,
+	}
+	if object.StorageClass == "" {
+		object.StorageClass = info.Metadata.Get("X-Amz-Storage-Class")
+	}
+	if len(info.Metadata) > 0 {
+		object.Metadata = make(map[string]string, len(info.Metadata))
+		for key, values := range info.Metadata {
+			object.Metadata[key] = strings.Join(values, ",")
+		}
+	}
+	return object
+}
+
+// listBucketObjects returns a page of the objects under the prefix, the objects are grouped by folder unless
+// the listing is recursive
+func listBucketObjects(ctx context.Context, client MinioClient, bucket, prefix string, recursive bool, limit int, continuationToken string) (*models.ListBucketObjectsResponse, error) {
+	if limit == 0 {
+		limit = defaultObjectsLimit
+	}
+	if limit < 0 || limit > maxObjectsLimit {
+		return nil, apierrors.NewBadRequest("limit must be between 1 and 1000")
+	}
+	delimiter := "/"
+	if recursive {
+		delimiter = ""
+	}
+	result, err := client.listObjects(ctx, bucket, prefix, delimiter, continuationToken, limit)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.ListBucketObjectsResponse{
+		Objects:     []*models.BucketObject{},
+		Prefixes:    []string{},
+		IsTruncated: result.IsTruncated,
+	}
+	if result.IsTruncated {
+		resp.NextContinuationToken = result.NextContinuationToken
+	}
+	for _, info := range result.Contents {
+		resp.Objects = append(resp.Objects, bucketObjectModel(info))
+	}
+	for _, commonPrefix := range result.CommonPrefixes {
+		resp.Prefixes = append(resp.Prefixes, commonPrefix.Prefix)
+	}
+	sort.Strings(resp.Prefixes)
+	return resp, nil
+}
+
+// getBucketObject returns the object with its metadata
+func getBucketObject(ctx context.Context, client MinioClient, bucket, object string) (*models.BucketObject, error) {
+	if object == "" {
+		return nil, apierrors.NewBadRequest("object is required")
+	}
+	info, err := client.statObject(ctx, bucket, object)
+	if err != nil {
+		return nil, err
+	}
+	return bucketObjectModel(info), nil
+}
+
+// presignExpiry returns how long the presigned url is valid, the expiry requested is capped by maxExpiry
+func presignExpiry(requested int64, maxExpiry time.Duration) time.Duration {
+	expiry := defaultPresignExpiry
+	// compared in seconds first, a large request overflows the duration
+	if requested > int64(maxExpiry/time.Second) {
+		return maxExpiry
+	}
+	if requested > 0 {
+		expiry = time.Duration(requested) * time.Second
+	}
+	if expiry > maxExpiry {
+		expiry = maxExpiry
+	}
+	return expiry
+}
+
+// presignBucketObject returns a url to download or upload the object signed by presigner, the object must exist to
+// be downloaded and the bucket to be uploaded to, both are checked through client
+func presignBucketObject(ctx context.Context, client, presigner MinioClient, bucket string, req *models.PresignObjectRequest, maxExpiry time.Duration) (*models.PresignedObjectURL, error) {
+	object, method := swag.StringValue(req.Object), swag.StringValue(req.Method)
+	switch method {
+	case http.MethodGet:
+		if _, err := client.statObject(ctx, bucket, object); err != nil {
+			return nil, err
+		}
+	case http.MethodPut:
+		if _, err := client.getBucketLocation(ctx, bucket); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, apierrors.NewBadRequest("method must be GET or PUT")
+	}
+	expiry := presignExpiry(req.Expires, maxExpiry)
+	u, err := presigner.presign(ctx, method, bucket, object, expiry)
+	if err != nil {
+		return nil, err
+	}
+	return &models.PresignedObjectURL{
+		URL:       u.String(),
+		Method:    method,
+		Expires:   int64(expiry / time.Second),
+		ExpiresAt: time.Now().Add(expiry).UTC().Format(time.RFC3339),
+	}, nil
+}
+
+// newTenantPresignClient returns a client of the host exposing the tenant so the presigned urls work outside of
+// the cluster, the service of the tenant is used when no integration exposes it
+func newTenantPresignClient(ctx context.Context, clients *tenantClients, bucket string) (MinioClient, error) {
+	// the region is part of the signature and the exposed host may not be reachable from m3
+	region, err := clients.s3.getBucketLocation(ctx, bucket)
+	if err != nil {
+		return nil, err
+	}
+	creds, err := getTenantCredentials(ctx, clients.k8s, clients.tenant)
+	if err != nil {
+		return nil, err
+	}
+	endpoint, secure := tenantServiceEndpoint(clients.tenant)
+	exposed, exposedSecure, err := getTenantS3Endpoint(getWorkingIntegrations(ctx), clients.tenant)
+	switch {
+	case err != nil:
+		logger.FromContext(ctx).WithError(err).Warn("presigning with the service of the tenant")
+	case exposed != "":
+		endpoint, secure = exposed, exposedSecure
+	}
+	client, err := minio.NewWithRegion(endpoint, creds.accessKey, creds.secretKey, secure, region)
+	if err != nil {
+		return nil, err
+	}
+	return &minioClient{client: client}, nil
+}
+
+func getListTenantBucketObjectsResponse(ctx context.Context, token string, params admin_api.ListTenantBucketObjectsParams) (*models.ListBucketObjectsResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return listBucketObjects(ctx, clients.s3, params.Bucket, swag.StringValue(params.Prefix), swag.BoolValue(params.Recursive),
+		int(swag.Int32Value(params.Limit)), swag.StringValue(params.ContinuationToken))
+}
+
+func getTenantBucketObjectInfoResponse(ctx context.Context, token string, params admin_api.TenantBucketObjectInfoParams) (*models.BucketObject, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return getBucketObject(ctx, clients.s3, params.Bucket, params.Object)
+}
+
+func getPresignTenantBucketObjectResponse(ctx context.Context, token string, params admin_api.PresignTenantBucketObjectParams) (*models.PresignedObjectURL, error) {
+	ctx, cancel := context.WithTimeout(ctx, bucketsTimeout)
+	defer cancel()
+	maxExpiry, err := getPresignMaxExpiry()
+	if err != nil {
+		return nil, err
+	}
+	clients, err := newTenantClients(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	presigner, err := newTenantPresignClient(ctx, clients, params.Bucket)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := presignBucketObject(ctx, clients.s3, presigner, params.Bucket, params.Body, maxExpiry)
+	if err != nil {
+		return nil, err
+	}
+	// the urls give access to the object without credentials so every one of them is recorded
+	logger.FromContext(ctx).WithFields(logrus.Fields{
+		"bucket":     params.Bucket,
+		"object":     swag.StringValue(params.Body.Object),
+		"method":     resp.Method,
+		"expires_at": resp.ExpiresAt,
+	}).Info("presigned url generated")
+	return resp, nil
+}
@@ -0,0 +1,262 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+)
+
+func newObjectsStub(t *testing.T) (*s3Stub, *minioClient) {
+	stub, client := newS3Stub(t)
+	if err := client.makeBucket(context.Background(), "photos", "", false); err != nil {
+		t.Fatal(err)
+	}
+	modified := time.Date(2020, 5, 1, 10, 0, 0, 0, time.UTC)
+	stub.buckets["photos"].contents = map[string]*s3StubObject{
+		"readme.txt":      {size: 12, modified: modified, contentType: "text/plain", userMetadata: map[string]string{"Owner": "support"}},
+		"raw/1.jpg":       {size: 100, modified: modified, contentType: "image/jpeg"},
+		"raw/2.jpg":       {size: 200, modified: modified, contentType: "image/jpeg"},
+		"raw/2020/3.jpg":  {size: 300, modified: modified, contentType: "image/jpeg"},
+		"logs/access.log": {size: 50, modified: modified, contentType: "text/plain"},
+	}
+	return stub, client
+}
+
+func objectNames(objects []*models.BucketObject) []string {
+	names := []string{}
+	for _, object := range objects {
+		names = append(names, object.Name)
+	}
+	return names
+}
+
+func Test_listBucketObjects(t *testing.T) {
+	ctx := context.Background()
+	_, client := newObjectsStub(t)
+	tests := []struct {
+		name         string
+		bucket       string
+		prefix       string
+		recursive    bool
+		limit        int
+		wantObjects  []string
+		wantPrefixes []string
+		wantCode     int64
+	}{
+		{
+			name:         "Folders of the bucket",
+			bucket:       "photos",
+			wantObjects:  []string{"readme.txt"},
+			wantPrefixes: []string{"logs/", "raw/"},
+		},
+		{
+			name:         "Folder",
+			bucket:       "photos",
+			prefix:       "raw/",
+			wantObjects:  []string{"raw/1.jpg", "raw/2.jpg"},
+			wantPrefixes: []string{"raw/2020/"},
+		},
+		{
+			name:         "Recursive",
+			bucket:       "photos",
+			prefix:       "raw/",
+			recursive:    true,
+			wantObjects:  []string{"raw/1.jpg", "raw/2.jpg", "raw/2020/3.jpg"},
+			wantPrefixes: []string{},
+		},
+		{
+			name:     "Invalid limit",
+			bucket:   "photos",
+			limit:    5000,
+			wantCode: 400,
+		},
+		{
+			name:     "Missing bucket",
+			bucket:   "missing",
+			wantCode: 404,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := listBucketObjects(ctx, client, tt.bucket, tt.prefix, tt.recursive, tt.limit, "")
+			if tt.wantCode != 0 {
+				if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != tt.wantCode {
+					t.Errorf("listBucketObjects() error = %v, want code %d", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("listBucketObjects() error = %v", err)
+			}
+			if got := objectNames(resp.Objects); !reflect.DeepEqual(got, tt.wantObjects) {
+				t.Errorf("listBucketObjects() objects = %v, want %v", got, tt.wantObjects)
+			}
+			if !reflect.DeepEqual(resp.Prefixes, tt.wantPrefixes) {
+				t.Errorf("listBucketObjects() prefixes = %v, want %v", resp.Prefixes, tt.wantPrefixes)
+			}
+			if resp.IsTruncated || resp.NextContinuationToken != "" {
+				t.Errorf("listBucketObjects() is truncated")
+			}
+		})
+	}
+}
+
+func Test_listBucketObjectsPages(t *testing.T) {
+	ctx := context.Background()
+	_, client := newObjectsStub(t)
+	var names []string
+	token := ""
+	for pages := 1; ; pages++ {
+		resp, err := listBucketObjects(ctx, client, "photos", "", true, 2, token)
+		if err != nil {
+			t.Fatalf("listBucketObjects() error = %v", err)
+		}
+		names = append(names, objectNames(resp.Objects)...)
+		if !resp.IsTruncated {
+			if pages != 3 {
+				t.Errorf("listBucketObjects() returned %d pages, want 3", pages)
+			}
+			break
+		}
+		token = resp.NextContinuationToken
+	}
+	want := []string{"logs/access.log", "raw/1.jpg", "raw/2.jpg", "raw/2020/3.jpg", "readme.txt"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("listBucketObjects() pages = %v, want %v", names, want)
+	}
+}
+
+func Test_getBucketObject(t *testing.T) {
+	ctx := context.Background()
+	_, client := newObjectsStub(t)
+	object, err := getBucketObject(ctx, client, "photos", "readme.txt")
+	if err != nil {
+		t.Fatalf("getBucketObject() error = %v", err)
+	}
+	if object.Name != "readme.txt" || object.Size != 12 || object.ContentType != "text/plain" || object.LastModified != "2020-05-01T10:00:00Z" ||
+		object.Etag != s3StubETag("readme.txt") || object.Metadata["X-Amz-Meta-Owner"] != "support" {
+		t.Errorf("getBucketObject() = %+v", object)
+	}
+	_, err = getBucketObject(ctx, client, "photos", "raw/missing.jpg")
+	if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != 404 {
+		t.Errorf("getBucketObject() of a missing object error = %v", err)
+	}
+}
+
+func Test_presignBucketObject(t *testing.T) {
+	ctx := context.Background()
+	_, client := newObjectsStub(t)
+	tests := []struct {
+		name        string
+		req         *models.PresignObjectRequest
+		wantExpires int64
+		wantCode    int64
+	}{
+		{
+			name:        "Download with the default expiry",
+			req:         &models.PresignObjectRequest{Object: swag.String("raw/1.jpg"), Method: swag.String("GET")},
+			wantExpires: 900,
+		},
+		{
+			name:        "Upload",
+			req:         &models.PresignObjectRequest{Object: swag.String("raw/4.jpg"), Method: swag.String("PUT"), Expires: 60},
+			wantExpires: 60,
+		},
+		{
+			name:        "Expiry capped",
+			req:         &models.PresignObjectRequest{Object: swag.String("raw/1.jpg"), Method: swag.String("GET"), Expires: 86400},
+			wantExpires: 3600,
+		},
+		{
+			name:        "Expiry overflowing the duration capped",
+			req:         &models.PresignObjectRequest{Object: swag.String("raw/1.jpg"), Method: swag.String("GET"), Expires: 9223372037},
+			wantExpires: 3600,
+		},
+		{
+			name:     "Download of a missing object",
+			req:      &models.PresignObjectRequest{Object: swag.String("raw/4.jpg"), Method: swag.String("GET")},
+			wantCode: 404,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := presignBucketObject(ctx, client, client, "photos", tt.req, time.Hour)
+			if tt.wantCode != 0 {
+				if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != tt.wantCode {
+					t.Errorf("presignBucketObject() error = %v, want code %d", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("presignBucketObject() error = %v", err)
+			}
+			u, err := url.Parse(resp.URL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp.Expires != tt.wantExpires || u.Query().Get("X-Amz-Expires") != swag.FormatInt64(tt.wantExpires) ||
+				u.Path != "/photos/"+swag.StringValue(tt.req.Object) || u.Query().Get("X-Amz-Signature") == "" {
+				t.Errorf("presignBucketObject() = %+v", resp)
+			}
+			if resp.Method != swag.StringValue(tt.req.Method) || resp.ExpiresAt == "" {
+				t.Errorf("presignBucketObject() = %+v", resp)
+			}
+		})
+	}
+
+	// the presigned download is served by the tenant
+	resp, err := presignBucketObject(ctx, client, client, "photos", tests[0].req, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	head, err := http.Head(resp.URL)
+	if err != nil || head.StatusCode != http.StatusOK || head.Header.Get("Content-Length") != "100" {
+		t.Errorf("HEAD %s = %v, %v", resp.URL, head, err)
+	}
+}
+
+func Test_getPresignMaxExpiry(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "", want: time.Hour},
+		{value: "10m", want: 10 * time.Minute},
+		{value: "200h", wantErr: true},
+		{value: "soon", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			os.Setenv(M3PresignMaxExpiry, tt.value)
+			defer os.Unsetenv(M3PresignMaxExpiry)
+			got, err := getPresignMaxExpiry()
+			if (err != nil) != tt.wantErr || got != tt.want {
+				t.Errorf("getPresignMaxExpiry() = %v, %v, want %v", got, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// AddTenantBucketLifecycleRuleCreatedCode is the HTTP code returned for type AddTenantBucketLifecycleRuleCreated
+const AddTenantBucketLifecycleRuleCreatedCode int = 201
+
+/*AddTenantBucketLifecycleRuleCreated A successful response.
+
+swagger:response addTenantBucketLifecycleRuleCreated
+*/
+type AddTenantBucketLifecycleRuleCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BucketLifecycle `json:"body,omitempty"`
+}
+
+// NewAddTenantBucketLifecycleRuleCreated creates AddTenantBucketLifecycleRuleCreated with default headers values
+func NewAddTenantBucketLifecycleRuleCreated() *AddTenantBucketLifecycleRuleCreated {
+
+	return &AddTenantBucketLifecycleRuleCreated{}
+}
+
+// WithPayload adds the payload to the add tenant bucket lifecycle rule created response
+func (o *AddTenantBucketLifecycleRuleCreated) WithPayload(payload *models.BucketLifecycle) *AddTenantBucketLifecycleRuleCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add tenant bucket lifecycle rule created response
+func (o *AddTenantBucketLifecycleRuleCreated) SetPayload(payload *models.BucketLifecycle) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddTenantBucketLifecycleRuleCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*AddTenantBucketLifecycleRuleDefault Generic error response.
+
+swagger:response addTenantBucketLifecycleRuleDefault
+*/
+type AddTenantBucketLifecycleRuleDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAddTenantBucketLifecycleRuleDefault creates AddTenantBucketLifecycleRuleDefault with default headers values
+func NewAddTenantBucketLifecycleRuleDefault(code int) *AddTenantBucketLifecycleRuleDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &AddTenantBucketLifecycleRuleDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the add tenant bucket lifecycle rule default response
+func (o *AddTenantBucketLifecycleRuleDefault) WithStatusCode(code int) *AddTenantBucketLifecycleRuleDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the add tenant bucket lifecycle rule default response
+func (o *AddTenantBucketLifecycleRuleDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the add tenant bucket lifecycle rule default response
+func (o *AddTenantBucketLifecycleRuleDefault) WithPayload(payload *models.Error) *AddTenantBucketLifecycleRuleDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add tenant bucket lifecycle rule default response
+func (o *AddTenantBucketLifecycleRuleDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddTenantBucketLifecycleRuleDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// AddTenantPolicyCreatedCode is the HTTP code returned for type AddTenantPolicyCreated
+const AddTenantPolicyCreatedCode int = 201
+
+/*AddTenantPolicyCreated A successful response.
+
+swagger:response addTenantPolicyCreated
+*/
+type AddTenantPolicyCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Policy `json:"body,omitempty"`
+}
+
+// NewAddTenantPolicyCreated creates AddTenantPolicyCreated with default headers values
+func NewAddTenantPolicyCreated() *AddTenantPolicyCreated {
+
+	return &AddTenantPolicyCreated{}
+}
+
+// WithPayload adds the payload to the add tenant policy created response
+func (o *AddTenantPolicyCreated) WithPayload(payload *models.Policy) *AddTenantPolicyCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add tenant policy created response
+func (o *AddTenantPolicyCreated) SetPayload(payload *models.Policy) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddTenantPolicyCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*AddTenantPolicyDefault Generic error response.
+
+swagger:response addTenantPolicyDefault
+*/
+type AddTenantPolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAddTenantPolicyDefault creates AddTenantPolicyDefault with default headers values
+func NewAddTenantPolicyDefault(code int) *AddTenantPolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &AddTenantPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the add tenant policy default response
+func (o *AddTenantPolicyDefault) WithStatusCode(code int) *AddTenantPolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the add tenant policy default response
+func (o *AddTenantPolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the add tenant policy default response
+func (o *AddTenantPolicyDefault) WithPayload(payload *models.Error) *AddTenantPolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add tenant policy default response
+func (o *AddTenantPolicyDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddTenantPolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
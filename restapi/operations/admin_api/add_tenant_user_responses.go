@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// AddTenantUserCreatedCode is the HTTP code returned for type AddTenantUserCreated
+const AddTenantUserCreatedCode int = 201
+
+/*AddTenantUserCreated A successful response.
+
+swagger:response addTenantUserCreated
+*/
+type AddTenantUserCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.User `json:"body,omitempty"`
+}
+
+// NewAddTenantUserCreated creates AddTenantUserCreated with default headers values
+func NewAddTenantUserCreated() *AddTenantUserCreated {
+
+	return &AddTenantUserCreated{}
+}
+
+// WithPayload adds the payload to the add tenant user created response
+func (o *AddTenantUserCreated) WithPayload(payload *models.User) *AddTenantUserCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add tenant user created response
+func (o *AddTenantUserCreated) SetPayload(payload *models.User) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddTenantUserCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*AddTenantUserDefault Generic error response.
+
+swagger:response addTenantUserDefault
+*/
+type AddTenantUserDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAddTenantUserDefault creates AddTenantUserDefault with default headers values
+func NewAddTenantUserDefault(code int) *AddTenantUserDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &AddTenantUserDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the add tenant user default response
+func (o *AddTenantUserDefault) WithStatusCode(code int) *AddTenantUserDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the add tenant user default response
+func (o *AddTenantUserDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the add tenant user default response
+func (o *AddTenantUserDefault) WithPayload(payload *models.Error) *AddTenantUserDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the add tenant user default response
+func (o *AddTenantUserDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AddTenantUserDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
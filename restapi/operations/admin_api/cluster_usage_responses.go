@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// ClusterUsageOKCode is the HTTP code returned for type ClusterUsageOK
+const ClusterUsageOKCode int = 200
+
+/*ClusterUsageOK A successful response.
+
+swagger:response clusterUsageOK
+*/
+type ClusterUsageOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ClusterUsage `json:"body,omitempty"`
+}
+
+// NewClusterUsageOK creates ClusterUsageOK with default headers values
+func NewClusterUsageOK() *ClusterUsageOK {
+
+	return &ClusterUsageOK{}
+}
+
+// WithPayload adds the payload to the cluster usage o k response
+func (o *ClusterUsageOK) WithPayload(payload *models.ClusterUsage) *ClusterUsageOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster usage o k response
+func (o *ClusterUsageOK) SetPayload(payload *models.ClusterUsage) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterUsageOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ClusterUsageDefault Generic error response.
+
+swagger:response clusterUsageDefault
+*/
+type ClusterUsageDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewClusterUsageDefault creates ClusterUsageDefault with default headers values
+func NewClusterUsageDefault(code int) *ClusterUsageDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ClusterUsageDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the cluster usage default response
+func (o *ClusterUsageDefault) WithStatusCode(code int) *ClusterUsageDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the cluster usage default response
+func (o *ClusterUsageDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the cluster usage default response
+func (o *ClusterUsageDefault) WithPayload(payload *models.Error) *ClusterUsageDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the cluster usage default response
+func (o *ClusterUsageDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ClusterUsageDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// CreateTenantBucketCreatedCode is the HTTP code returned for type CreateTenantBucketCreated
+const CreateTenantBucketCreatedCode int = 201
+
+/*CreateTenantBucketCreated A successful response.
+
+swagger:response createTenantBucketCreated
+*/
+type CreateTenantBucketCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Bucket `json:"body,omitempty"`
+}
+
+// NewCreateTenantBucketCreated creates CreateTenantBucketCreated with default headers values
+func NewCreateTenantBucketCreated() *CreateTenantBucketCreated {
+
+	return &CreateTenantBucketCreated{}
+}
+
+// WithPayload adds the payload to the create tenant bucket created response
+func (o *CreateTenantBucketCreated) WithPayload(payload *models.Bucket) *CreateTenantBucketCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create tenant bucket created response
+func (o *CreateTenantBucketCreated) SetPayload(payload *models.Bucket) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateTenantBucketCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateTenantBucketDefault Generic error response.
+
+swagger:response createTenantBucketDefault
+*/
+type CreateTenantBucketDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateTenantBucketDefault creates CreateTenantBucketDefault with default headers values
+func NewCreateTenantBucketDefault(code int) *CreateTenantBucketDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateTenantBucketDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create tenant bucket default response
+func (o *CreateTenantBucketDefault) WithStatusCode(code int) *CreateTenantBucketDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create tenant bucket default response
+func (o *CreateTenantBucketDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create tenant bucket default response
+func (o *CreateTenantBucketDefault) WithPayload(payload *models.Error) *CreateTenantBucketDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create tenant bucket default response
+func (o *CreateTenantBucketDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateTenantBucketDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
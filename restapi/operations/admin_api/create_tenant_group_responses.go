@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// CreateTenantGroupCreatedCode is the HTTP code returned for type CreateTenantGroupCreated
+const CreateTenantGroupCreatedCode int = 201
+
+/*CreateTenantGroupCreated A successful response.
+
+swagger:response createTenantGroupCreated
+*/
+type CreateTenantGroupCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Group `json:"body,omitempty"`
+}
+
+// NewCreateTenantGroupCreated creates CreateTenantGroupCreated with default headers values
+func NewCreateTenantGroupCreated() *CreateTenantGroupCreated {
+
+	return &CreateTenantGroupCreated{}
+}
+
+// WithPayload adds the payload to the create tenant group created response
+func (o *CreateTenantGroupCreated) WithPayload(payload *models.Group) *CreateTenantGroupCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create tenant group created response
+func (o *CreateTenantGroupCreated) SetPayload(payload *models.Group) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateTenantGroupCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateTenantGroupDefault Generic error response.
+
+swagger:response createTenantGroupDefault
+*/
+type CreateTenantGroupDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateTenantGroupDefault creates CreateTenantGroupDefault with default headers values
+func NewCreateTenantGroupDefault(code int) *CreateTenantGroupDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateTenantGroupDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create tenant group default response
+func (o *CreateTenantGroupDefault) WithStatusCode(code int) *CreateTenantGroupDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create tenant group default response
+func (o *CreateTenantGroupDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create tenant group default response
+func (o *CreateTenantGroupDefault) WithPayload(payload *models.Error) *CreateTenantGroupDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create tenant group default response
+func (o *CreateTenantGroupDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateTenantGroupDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// CreateTenantJobCreatedCode is the HTTP code returned for type CreateTenantJobCreated
+const CreateTenantJobCreatedCode int = 201
+
+/*CreateTenantJobCreated A successful response.
+
+swagger:response createTenantJobCreated
+*/
+type CreateTenantJobCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TenantJob `json:"body,omitempty"`
+}
+
+// NewCreateTenantJobCreated creates CreateTenantJobCreated with default headers values
+func NewCreateTenantJobCreated() *CreateTenantJobCreated {
+
+	return &CreateTenantJobCreated{}
+}
+
+// WithPayload adds the payload to the create tenant job created response
+func (o *CreateTenantJobCreated) WithPayload(payload *models.TenantJob) *CreateTenantJobCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create tenant job created response
+func (o *CreateTenantJobCreated) SetPayload(payload *models.TenantJob) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateTenantJobCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateTenantJobDefault Generic error response.
+
+swagger:response createTenantJobDefault
+*/
+type CreateTenantJobDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateTenantJobDefault creates CreateTenantJobDefault with default headers values
+func NewCreateTenantJobDefault(code int) *CreateTenantJobDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateTenantJobDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create tenant job default response
+func (o *CreateTenantJobDefault) WithStatusCode(code int) *CreateTenantJobDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create tenant job default response
+func (o *CreateTenantJobDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create tenant job default response
+func (o *CreateTenantJobDefault) WithPayload(payload *models.Error) *CreateTenantJobDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create tenant job default response
+func (o *CreateTenantJobDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateTenantJobDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
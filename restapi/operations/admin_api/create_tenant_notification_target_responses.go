@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// CreateTenantNotificationTargetCreatedCode is the HTTP code returned for type CreateTenantNotificationTargetCreated
+const CreateTenantNotificationTargetCreatedCode int = 201
+
+/*CreateTenantNotificationTargetCreated A successful response.
+
+swagger:response createTenantNotificationTargetCreated
+*/
+type CreateTenantNotificationTargetCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NotificationTargetResponse `json:"body,omitempty"`
+}
+
+// NewCreateTenantNotificationTargetCreated creates CreateTenantNotificationTargetCreated with default headers values
+func NewCreateTenantNotificationTargetCreated() *CreateTenantNotificationTargetCreated {
+
+	return &CreateTenantNotificationTargetCreated{}
+}
+
+// WithPayload adds the payload to the create tenant notification target created response
+func (o *CreateTenantNotificationTargetCreated) WithPayload(payload *models.NotificationTargetResponse) *CreateTenantNotificationTargetCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create tenant notification target created response
+func (o *CreateTenantNotificationTargetCreated) SetPayload(payload *models.NotificationTargetResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateTenantNotificationTargetCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateTenantNotificationTargetDefault Generic error response.
+
+swagger:response createTenantNotificationTargetDefault
+*/
+type CreateTenantNotificationTargetDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateTenantNotificationTargetDefault creates CreateTenantNotificationTargetDefault with default headers values
+func NewCreateTenantNotificationTargetDefault(code int) *CreateTenantNotificationTargetDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateTenantNotificationTargetDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create tenant notification target default response
+func (o *CreateTenantNotificationTargetDefault) WithStatusCode(code int) *CreateTenantNotificationTargetDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create tenant notification target default response
+func (o *CreateTenantNotificationTargetDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create tenant notification target default response
+func (o *CreateTenantNotificationTargetDefault) WithPayload(payload *models.Error) *CreateTenantNotificationTargetDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create tenant notification target default response
+func (o *CreateTenantNotificationTargetDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateTenantNotificationTargetDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// CreateTenantServiceAccountCreatedCode is the HTTP code returned for type CreateTenantServiceAccountCreated
+const CreateTenantServiceAccountCreatedCode int = 201
+
+/*CreateTenantServiceAccountCreated A successful response.
+
+swagger:response createTenantServiceAccountCreated
+*/
+type CreateTenantServiceAccountCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ServiceAccountCredentials `json:"body,omitempty"`
+}
+
+// NewCreateTenantServiceAccountCreated creates CreateTenantServiceAccountCreated with default headers values
+func NewCreateTenantServiceAccountCreated() *CreateTenantServiceAccountCreated {
+
+	return &CreateTenantServiceAccountCreated{}
+}
+
+// WithPayload adds the payload to the create tenant service account created response
+func (o *CreateTenantServiceAccountCreated) WithPayload(payload *models.ServiceAccountCredentials) *CreateTenantServiceAccountCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create tenant service account created response
+func (o *CreateTenantServiceAccountCreated) SetPayload(payload *models.ServiceAccountCredentials) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateTenantServiceAccountCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateTenantServiceAccountDefault Generic error response.
+
+swagger:response createTenantServiceAccountDefault
+*/
+type CreateTenantServiceAccountDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateTenantServiceAccountDefault creates CreateTenantServiceAccountDefault with default headers values
+func NewCreateTenantServiceAccountDefault(code int) *CreateTenantServiceAccountDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateTenantServiceAccountDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create tenant service account default response
+func (o *CreateTenantServiceAccountDefault) WithStatusCode(code int) *CreateTenantServiceAccountDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create tenant service account default response
+func (o *CreateTenantServiceAccountDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create tenant service account default response
+func (o *CreateTenantServiceAccountDefault) WithPayload(payload *models.Error) *CreateTenantServiceAccountDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create tenant service account default response
+func (o *CreateTenantServiceAccountDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateTenantServiceAccountDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
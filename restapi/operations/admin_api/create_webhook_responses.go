@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// CreateWebhookCreatedCode is the HTTP code returned for type CreateWebhookCreated
+const CreateWebhookCreatedCode int = 201
+
+/*CreateWebhookCreated A successful response.
+
+swagger:response createWebhookCreated
+*/
+type CreateWebhookCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Webhook `json:"body,omitempty"`
+}
+
+// NewCreateWebhookCreated creates CreateWebhookCreated with default headers values
+func NewCreateWebhookCreated() *CreateWebhookCreated {
+
+	return &CreateWebhookCreated{}
+}
+
+// WithPayload adds the payload to the create webhook created response
+func (o *CreateWebhookCreated) WithPayload(payload *models.Webhook) *CreateWebhookCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create webhook created response
+func (o *CreateWebhookCreated) SetPayload(payload *models.Webhook) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateWebhookCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateWebhookDefault Generic error response.
+
+swagger:response createWebhookDefault
+*/
+type CreateWebhookDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateWebhookDefault creates CreateWebhookDefault with default headers values
+func NewCreateWebhookDefault(code int) *CreateWebhookDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateWebhookDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create webhook default response
+func (o *CreateWebhookDefault) WithStatusCode(code int) *CreateWebhookDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create webhook default response
+func (o *CreateWebhookDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create webhook default response
+func (o *CreateWebhookDefault) WithPayload(payload *models.Error) *CreateWebhookDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create webhook default response
+func (o *CreateWebhookDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateWebhookDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// DeleteTenantBucketLifecycleNoContentCode is the HTTP code returned for type DeleteTenantBucketLifecycleNoContent
+const DeleteTenantBucketLifecycleNoContentCode int = 204
+
+/*DeleteTenantBucketLifecycleNoContent A successful response.
+
+swagger:response deleteTenantBucketLifecycleNoContent
+*/
+type DeleteTenantBucketLifecycleNoContent struct {
+}
+
+// NewDeleteTenantBucketLifecycleNoContent creates DeleteTenantBucketLifecycleNoContent with default headers values
+func NewDeleteTenantBucketLifecycleNoContent() *DeleteTenantBucketLifecycleNoContent {
+
+	return &DeleteTenantBucketLifecycleNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteTenantBucketLifecycleNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*DeleteTenantBucketLifecycleDefault Generic error response.
+
+swagger:response deleteTenantBucketLifecycleDefault
+*/
+type DeleteTenantBucketLifecycleDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteTenantBucketLifecycleDefault creates DeleteTenantBucketLifecycleDefault with default headers values
+func NewDeleteTenantBucketLifecycleDefault(code int) *DeleteTenantBucketLifecycleDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteTenantBucketLifecycleDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete tenant bucket lifecycle default response
+func (o *DeleteTenantBucketLifecycleDefault) WithStatusCode(code int) *DeleteTenantBucketLifecycleDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete tenant bucket lifecycle default response
+func (o *DeleteTenantBucketLifecycleDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete tenant bucket lifecycle default response
+func (o *DeleteTenantBucketLifecycleDefault) WithPayload(payload *models.Error) *DeleteTenantBucketLifecycleDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete tenant bucket lifecycle default response
+func (o *DeleteTenantBucketLifecycleDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteTenantBucketLifecycleDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
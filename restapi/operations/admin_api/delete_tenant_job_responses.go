@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// DeleteTenantJobNoContentCode is the HTTP code returned for type DeleteTenantJobNoContent
+const DeleteTenantJobNoContentCode int = 204
+
+/*DeleteTenantJobNoContent A successful response.
+
+swagger:response deleteTenantJobNoContent
+*/
+type DeleteTenantJobNoContent struct {
+}
+
+// NewDeleteTenantJobNoContent creates DeleteTenantJobNoContent with default headers values
+func NewDeleteTenantJobNoContent() *DeleteTenantJobNoContent {
+
+	return &DeleteTenantJobNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteTenantJobNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*DeleteTenantJobDefault Generic error response.
+
+swagger:response deleteTenantJobDefault
+*/
+type DeleteTenantJobDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteTenantJobDefault creates DeleteTenantJobDefault with default headers values
+func NewDeleteTenantJobDefault(code int) *DeleteTenantJobDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteTenantJobDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete tenant job default response
+func (o *DeleteTenantJobDefault) WithStatusCode(code int) *DeleteTenantJobDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete tenant job default response
+func (o *DeleteTenantJobDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete tenant job default response
+func (o *DeleteTenantJobDefault) WithPayload(payload *models.Error) *DeleteTenantJobDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete tenant job default response
+func (o *DeleteTenantJobDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteTenantJobDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
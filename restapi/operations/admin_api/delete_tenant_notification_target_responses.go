@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// DeleteTenantNotificationTargetNoContentCode is the HTTP code returned for type DeleteTenantNotificationTargetNoContent
+const DeleteTenantNotificationTargetNoContentCode int = 204
+
+/*DeleteTenantNotificationTargetNoContent A successful response.
+
+swagger:response deleteTenantNotificationTargetNoContent
+*/
+type DeleteTenantNotificationTargetNoContent struct {
+}
+
+// NewDeleteTenantNotificationTargetNoContent creates DeleteTenantNotificationTargetNoContent with default headers values
+func NewDeleteTenantNotificationTargetNoContent() *DeleteTenantNotificationTargetNoContent {
+
+	return &DeleteTenantNotificationTargetNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteTenantNotificationTargetNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*DeleteTenantNotificationTargetDefault Generic error response.
+
+swagger:response deleteTenantNotificationTargetDefault
+*/
+type DeleteTenantNotificationTargetDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteTenantNotificationTargetDefault creates DeleteTenantNotificationTargetDefault with default headers values
+func NewDeleteTenantNotificationTargetDefault(code int) *DeleteTenantNotificationTargetDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteTenantNotificationTargetDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete tenant notification target default response
+func (o *DeleteTenantNotificationTargetDefault) WithStatusCode(code int) *DeleteTenantNotificationTargetDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete tenant notification target default response
+func (o *DeleteTenantNotificationTargetDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete tenant notification target default response
+func (o *DeleteTenantNotificationTargetDefault) WithPayload(payload *models.Error) *DeleteTenantNotificationTargetDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete tenant notification target default response
+func (o *DeleteTenantNotificationTargetDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteTenantNotificationTargetDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -30,28 +30,48 @@ import (
 	"github.com/minio/m3/models"
 )
 
-// DeleteTenantNoContentCode is the HTTP code returned for type DeleteTenantNoContent
-const DeleteTenantNoContentCode int = 204
+// DeleteTenantOKCode is the HTTP code returned for type DeleteTenantOK
+const DeleteTenantOKCode int = 200
 
-/*DeleteTenantNoContent A successful response.
+/*DeleteTenantOK A successful response.
 
-swagger:response deleteTenantNoContent
+swagger:response deleteTenantOK
 */
-type DeleteTenantNoContent struct {
+type DeleteTenantOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DeleteTenantResponse `json:"body,omitempty"`
 }
 
-// NewDeleteTenantNoContent creates DeleteTenantNoContent with default headers values
-func NewDeleteTenantNoContent() *DeleteTenantNoContent {
+// NewDeleteTenantOK creates DeleteTenantOK with default headers values
+func NewDeleteTenantOK() *DeleteTenantOK {
 
-	return &DeleteTenantNoContent{}
+	return &DeleteTenantOK{}
 }
 
-// WriteResponse to the client
-func (o *DeleteTenantNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+// WithPayload adds the payload to the delete tenant o k response
+func (o *DeleteTenantOK) WithPayload(payload *models.DeleteTenantResponse) *DeleteTenantOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete tenant o k response
+func (o *DeleteTenantOK) SetPayload(payload *models.DeleteTenantResponse) {
+	o.Payload = payload
+}
 
-	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+// WriteResponse to the client
+func (o *DeleteTenantOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
-	rw.WriteHeader(204)
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
 }
 
 /*DeleteTenantDefault Generic error response.
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// GetTenantBucketNotificationsOKCode is the HTTP code returned for type GetTenantBucketNotificationsOK
+const GetTenantBucketNotificationsOKCode int = 200
+
+/*GetTenantBucketNotificationsOK A successful response.
+
+swagger:response getTenantBucketNotificationsOK
+*/
+type GetTenantBucketNotificationsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BucketNotifications `json:"body,omitempty"`
+}
+
+// NewGetTenantBucketNotificationsOK creates GetTenantBucketNotificationsOK with default headers values
+func NewGetTenantBucketNotificationsOK() *GetTenantBucketNotificationsOK {
+
+	return &GetTenantBucketNotificationsOK{}
+}
+
+// WithPayload adds the payload to the get tenant bucket notifications o k response
+func (o *GetTenantBucketNotificationsOK) WithPayload(payload *models.BucketNotifications) *GetTenantBucketNotificationsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get tenant bucket notifications o k response
+func (o *GetTenantBucketNotificationsOK) SetPayload(payload *models.BucketNotifications) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTenantBucketNotificationsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetTenantBucketNotificationsDefault Generic error response.
+
+swagger:response getTenantBucketNotificationsDefault
+*/
+type GetTenantBucketNotificationsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetTenantBucketNotificationsDefault creates GetTenantBucketNotificationsDefault with default headers values
+func NewGetTenantBucketNotificationsDefault(code int) *GetTenantBucketNotificationsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetTenantBucketNotificationsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get tenant bucket notifications default response
+func (o *GetTenantBucketNotificationsDefault) WithStatusCode(code int) *GetTenantBucketNotificationsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get tenant bucket notifications default response
+func (o *GetTenantBucketNotificationsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get tenant bucket notifications default response
+func (o *GetTenantBucketNotificationsDefault) WithPayload(payload *models.Error) *GetTenantBucketNotificationsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get tenant bucket notifications default response
+func (o *GetTenantBucketNotificationsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTenantBucketNotificationsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
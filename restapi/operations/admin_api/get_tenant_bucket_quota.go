@@ -0,0 +1,90 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/minio/m3/models"
+)
+
+// GetTenantBucketQuotaHandlerFunc turns a function with the right signature into a get tenant bucket quota handler
+type GetTenantBucketQuotaHandlerFunc func(GetTenantBucketQuotaParams, *models.Principal) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetTenantBucketQuotaHandlerFunc) Handle(params GetTenantBucketQuotaParams, principal *models.Principal) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetTenantBucketQuotaHandler interface for that can handle valid get tenant bucket quota params
+type GetTenantBucketQuotaHandler interface {
+	Handle(GetTenantBucketQuotaParams, *models.Principal) middleware.Responder
+}
+
+// NewGetTenantBucketQuota creates a new http.Handler for the get tenant bucket quota operation
+func NewGetTenantBucketQuota(ctx *middleware.Context, handler GetTenantBucketQuotaHandler) *GetTenantBucketQuota {
+	return &GetTenantBucketQuota{Context: ctx, Handler: handler}
+}
+
+/*GetTenantBucketQuota swagger:route GET /namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/quota AdminAPI getTenantBucketQuota
+
+Quota of a Bucket
+
+*/
+type GetTenantBucketQuota struct {
+	Context *middleware.Context
+	Handler GetTenantBucketQuotaHandler
+}
+
+func (o *GetTenantBucketQuota) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		r = rCtx
+	}
+	var Params = NewGetTenantBucketQuotaParams()
+
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		r = aCtx
+	}
+	var principal *models.Principal
+	if uprinc != nil {
+		principal = uprinc.(*models.Principal) // this is really a models.Principal, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// GetTenantBucketQuotaOKCode is the HTTP code returned for type GetTenantBucketQuotaOK
+const GetTenantBucketQuotaOKCode int = 200
+
+/*GetTenantBucketQuotaOK A successful response.
+
+swagger:response getTenantBucketQuotaOK
+*/
+type GetTenantBucketQuotaOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BucketQuota `json:"body,omitempty"`
+}
+
+// NewGetTenantBucketQuotaOK creates GetTenantBucketQuotaOK with default headers values
+func NewGetTenantBucketQuotaOK() *GetTenantBucketQuotaOK {
+
+	return &GetTenantBucketQuotaOK{}
+}
+
+// WithPayload adds the payload to the get tenant bucket quota o k response
+func (o *GetTenantBucketQuotaOK) WithPayload(payload *models.BucketQuota) *GetTenantBucketQuotaOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get tenant bucket quota o k response
+func (o *GetTenantBucketQuotaOK) SetPayload(payload *models.BucketQuota) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTenantBucketQuotaOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetTenantBucketQuotaDefault Generic error response.
+
+swagger:response getTenantBucketQuotaDefault
+*/
+type GetTenantBucketQuotaDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetTenantBucketQuotaDefault creates GetTenantBucketQuotaDefault with default headers values
+func NewGetTenantBucketQuotaDefault(code int) *GetTenantBucketQuotaDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetTenantBucketQuotaDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get tenant bucket quota default response
+func (o *GetTenantBucketQuotaDefault) WithStatusCode(code int) *GetTenantBucketQuotaDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get tenant bucket quota default response
+func (o *GetTenantBucketQuotaDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get tenant bucket quota default response
+func (o *GetTenantBucketQuotaDefault) WithPayload(payload *models.Error) *GetTenantBucketQuotaDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get tenant bucket quota default response
+func (o *GetTenantBucketQuotaDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTenantBucketQuotaDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// GetTenantBucketReplicationOKCode is the HTTP code returned for type GetTenantBucketReplicationOK
+const GetTenantBucketReplicationOKCode int = 200
+
+/*GetTenantBucketReplicationOK A successful response.
+
+swagger:response getTenantBucketReplicationOK
+*/
+type GetTenantBucketReplicationOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BucketReplication `json:"body,omitempty"`
+}
+
+// NewGetTenantBucketReplicationOK creates GetTenantBucketReplicationOK with default headers values
+func NewGetTenantBucketReplicationOK() *GetTenantBucketReplicationOK {
+
+	return &GetTenantBucketReplicationOK{}
+}
+
+// WithPayload adds the payload to the get tenant bucket replication o k response
+func (o *GetTenantBucketReplicationOK) WithPayload(payload *models.BucketReplication) *GetTenantBucketReplicationOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get tenant bucket replication o k response
+func (o *GetTenantBucketReplicationOK) SetPayload(payload *models.BucketReplication) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTenantBucketReplicationOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetTenantBucketReplicationDefault Generic error response.
+
+swagger:response getTenantBucketReplicationDefault
+*/
+type GetTenantBucketReplicationDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetTenantBucketReplicationDefault creates GetTenantBucketReplicationDefault with default headers values
+func NewGetTenantBucketReplicationDefault(code int) *GetTenantBucketReplicationDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetTenantBucketReplicationDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get tenant bucket replication default response
+func (o *GetTenantBucketReplicationDefault) WithStatusCode(code int) *GetTenantBucketReplicationDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get tenant bucket replication default response
+func (o *GetTenantBucketReplicationDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get tenant bucket replication default response
+func (o *GetTenantBucketReplicationDefault) WithPayload(payload *models.Error) *GetTenantBucketReplicationDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get tenant bucket replication default response
+func (o *GetTenantBucketReplicationDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetTenantBucketReplicationDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// ListManagedCertificatesOKCode is the HTTP code returned for type ListManagedCertificatesOK
+const ListManagedCertificatesOKCode int = 200
+
+/*ListManagedCertificatesOK A successful response.
+
+swagger:response listManagedCertificatesOK
+*/
+type ListManagedCertificatesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ListManagedCertificatesResponse `json:"body,omitempty"`
+}
+
+// NewListManagedCertificatesOK creates ListManagedCertificatesOK with default headers values
+func NewListManagedCertificatesOK() *ListManagedCertificatesOK {
+
+	return &ListManagedCertificatesOK{}
+}
+
+// WithPayload adds the payload to the list managed certificates o k response
+func (o *ListManagedCertificatesOK) WithPayload(payload *models.ListManagedCertificatesResponse) *ListManagedCertificatesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list managed certificates o k response
+func (o *ListManagedCertificatesOK) SetPayload(payload *models.ListManagedCertificatesResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListManagedCertificatesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListManagedCertificatesDefault Generic error response.
+
+swagger:response listManagedCertificatesDefault
+*/
+type ListManagedCertificatesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListManagedCertificatesDefault creates ListManagedCertificatesDefault with default headers values
+func NewListManagedCertificatesDefault(code int) *ListManagedCertificatesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListManagedCertificatesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list managed certificates default response
+func (o *ListManagedCertificatesDefault) WithStatusCode(code int) *ListManagedCertificatesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list managed certificates default response
+func (o *ListManagedCertificatesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list managed certificates default response
+func (o *ListManagedCertificatesDefault) WithPayload(payload *models.Error) *ListManagedCertificatesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list managed certificates default response
+func (o *ListManagedCertificatesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListManagedCertificatesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
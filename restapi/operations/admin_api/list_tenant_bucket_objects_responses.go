@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// ListTenantBucketObjectsOKCode is the HTTP code returned for type ListTenantBucketObjectsOK
+const ListTenantBucketObjectsOKCode int = 200
+
+/*ListTenantBucketObjectsOK A successful response.
+
+swagger:response listTenantBucketObjectsOK
+*/
+type ListTenantBucketObjectsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ListBucketObjectsResponse `json:"body,omitempty"`
+}
+
+// NewListTenantBucketObjectsOK creates ListTenantBucketObjectsOK with default headers values
+func NewListTenantBucketObjectsOK() *ListTenantBucketObjectsOK {
+
+	return &ListTenantBucketObjectsOK{}
+}
+
+// WithPayload adds the payload to the list tenant bucket objects o k response
+func (o *ListTenantBucketObjectsOK) WithPayload(payload *models.ListBucketObjectsResponse) *ListTenantBucketObjectsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant bucket objects o k response
+func (o *ListTenantBucketObjectsOK) SetPayload(payload *models.ListBucketObjectsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantBucketObjectsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListTenantBucketObjectsDefault Generic error response.
+
+swagger:response listTenantBucketObjectsDefault
+*/
+type ListTenantBucketObjectsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListTenantBucketObjectsDefault creates ListTenantBucketObjectsDefault with default headers values
+func NewListTenantBucketObjectsDefault(code int) *ListTenantBucketObjectsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListTenantBucketObjectsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list tenant bucket objects default response
+func (o *ListTenantBucketObjectsDefault) WithStatusCode(code int) *ListTenantBucketObjectsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list tenant bucket objects default response
+func (o *ListTenantBucketObjectsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list tenant bucket objects default response
+func (o *ListTenantBucketObjectsDefault) WithPayload(payload *models.Error) *ListTenantBucketObjectsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant bucket objects default response
+func (o *ListTenantBucketObjectsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantBucketObjectsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// ListTenantBucketQuotasOKCode is the HTTP code returned for type ListTenantBucketQuotasOK
+const ListTenantBucketQuotasOKCode int = 200
+
+/*ListTenantBucketQuotasOK A successful response.
+
+swagger:response listTenantBucketQuotasOK
+*/
+type ListTenantBucketQuotasOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TenantBucketQuotas `json:"body,omitempty"`
+}
+
+// NewListTenantBucketQuotasOK creates ListTenantBucketQuotasOK with default headers values
+func NewListTenantBucketQuotasOK() *ListTenantBucketQuotasOK {
+
+	return &ListTenantBucketQuotasOK{}
+}
+
+// WithPayload adds the payload to the list tenant bucket quotas o k response
+func (o *ListTenantBucketQuotasOK) WithPayload(payload *models.TenantBucketQuotas) *ListTenantBucketQuotasOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant bucket quotas o k response
+func (o *ListTenantBucketQuotasOK) SetPayload(payload *models.TenantBucketQuotas) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantBucketQuotasOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListTenantBucketQuotasDefault Generic error response.
+
+swagger:response listTenantBucketQuotasDefault
+*/
+type ListTenantBucketQuotasDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListTenantBucketQuotasDefault creates ListTenantBucketQuotasDefault with default headers values
+func NewListTenantBucketQuotasDefault(code int) *ListTenantBucketQuotasDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListTenantBucketQuotasDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list tenant bucket quotas default response
+func (o *ListTenantBucketQuotasDefault) WithStatusCode(code int) *ListTenantBucketQuotasDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list tenant bucket quotas default response
+func (o *ListTenantBucketQuotasDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list tenant bucket quotas default response
+func (o *ListTenantBucketQuotasDefault) WithPayload(payload *models.Error) *ListTenantBucketQuotasDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant bucket quotas default response
+func (o *ListTenantBucketQuotasDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantBucketQuotasDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
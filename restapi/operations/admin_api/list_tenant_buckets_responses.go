@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// ListTenantBucketsOKCode is the HTTP code returned for type ListTenantBucketsOK
+const ListTenantBucketsOKCode int = 200
+
+/*ListTenantBucketsOK A successful response.
+
+swagger:response listTenantBucketsOK
+*/
+type ListTenantBucketsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ListBucketsResponse `json:"body,omitempty"`
+}
+
+// NewListTenantBucketsOK creates ListTenantBucketsOK with default headers values
+func NewListTenantBucketsOK() *ListTenantBucketsOK {
+
+	return &ListTenantBucketsOK{}
+}
+
+// WithPayload adds the payload to the list tenant buckets o k response
+func (o *ListTenantBucketsOK) WithPayload(payload *models.ListBucketsResponse) *ListTenantBucketsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant buckets o k response
+func (o *ListTenantBucketsOK) SetPayload(payload *models.ListBucketsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantBucketsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListTenantBucketsDefault Generic error response.
+
+swagger:response listTenantBucketsDefault
+*/
+type ListTenantBucketsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListTenantBucketsDefault creates ListTenantBucketsDefault with default headers values
+func NewListTenantBucketsDefault(code int) *ListTenantBucketsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListTenantBucketsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list tenant buckets default response
+func (o *ListTenantBucketsDefault) WithStatusCode(code int) *ListTenantBucketsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list tenant buckets default response
+func (o *ListTenantBucketsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list tenant buckets default response
+func (o *ListTenantBucketsDefault) WithPayload(payload *models.Error) *ListTenantBucketsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant buckets default response
+func (o *ListTenantBucketsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantBucketsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// ListTenantGroupsOKCode is the HTTP code returned for type ListTenantGroupsOK
+const ListTenantGroupsOKCode int = 200
+
+/*ListTenantGroupsOK A successful response.
+
+swagger:response listTenantGroupsOK
+*/
+type ListTenantGroupsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ListGroupsResponse `json:"body,omitempty"`
+}
+
+// NewListTenantGroupsOK creates ListTenantGroupsOK with default headers values
+func NewListTenantGroupsOK() *ListTenantGroupsOK {
+
+	return &ListTenantGroupsOK{}
+}
+
+// WithPayload adds the payload to the list tenant groups o k response
+func (o *ListTenantGroupsOK) WithPayload(payload *models.ListGroupsResponse) *ListTenantGroupsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant groups o k response
+func (o *ListTenantGroupsOK) SetPayload(payload *models.ListGroupsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantGroupsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListTenantGroupsDefault Generic error response.
+
+swagger:response listTenantGroupsDefault
+*/
+type ListTenantGroupsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListTenantGroupsDefault creates ListTenantGroupsDefault with default headers values
+func NewListTenantGroupsDefault(code int) *ListTenantGroupsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListTenantGroupsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list tenant groups default response
+func (o *ListTenantGroupsDefault) WithStatusCode(code int) *ListTenantGroupsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list tenant groups default response
+func (o *ListTenantGroupsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list tenant groups default response
+func (o *ListTenantGroupsDefault) WithPayload(payload *models.Error) *ListTenantGroupsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant groups default response
+func (o *ListTenantGroupsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantGroupsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// ListTenantJobsOKCode is the HTTP code returned for type ListTenantJobsOK
+const ListTenantJobsOKCode int = 200
+
+/*ListTenantJobsOK A successful response.
+
+swagger:response listTenantJobsOK
+*/
+type ListTenantJobsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ListTenantJobsResponse `json:"body,omitempty"`
+}
+
+// NewListTenantJobsOK creates ListTenantJobsOK with default headers values
+func NewListTenantJobsOK() *ListTenantJobsOK {
+
+	return &ListTenantJobsOK{}
+}
+
+// WithPayload adds the payload to the list tenant jobs o k response
+func (o *ListTenantJobsOK) WithPayload(payload *models.ListTenantJobsResponse) *ListTenantJobsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant jobs o k response
+func (o *ListTenantJobsOK) SetPayload(payload *models.ListTenantJobsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantJobsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListTenantJobsDefault Generic error response.
+
+swagger:response listTenantJobsDefault
+*/
+type ListTenantJobsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListTenantJobsDefault creates ListTenantJobsDefault with default headers values
+func NewListTenantJobsDefault(code int) *ListTenantJobsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListTenantJobsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list tenant jobs default response
+func (o *ListTenantJobsDefault) WithStatusCode(code int) *ListTenantJobsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list tenant jobs default response
+func (o *ListTenantJobsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list tenant jobs default response
+func (o *ListTenantJobsDefault) WithPayload(payload *models.Error) *ListTenantJobsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant jobs default response
+func (o *ListTenantJobsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantJobsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
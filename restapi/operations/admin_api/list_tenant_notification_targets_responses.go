@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// ListTenantNotificationTargetsOKCode is the HTTP code returned for type ListTenantNotificationTargetsOK
+const ListTenantNotificationTargetsOKCode int = 200
+
+/*ListTenantNotificationTargetsOK A successful response.
+
+swagger:response listTenantNotificationTargetsOK
+*/
+type ListTenantNotificationTargetsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ListNotificationTargetsResponse `json:"body,omitempty"`
+}
+
+// NewListTenantNotificationTargetsOK creates ListTenantNotificationTargetsOK with default headers values
+func NewListTenantNotificationTargetsOK() *ListTenantNotificationTargetsOK {
+
+	return &ListTenantNotificationTargetsOK{}
+}
+
+// WithPayload adds the payload to the list tenant notification targets o k response
+func (o *ListTenantNotificationTargetsOK) WithPayload(payload *models.ListNotificationTargetsResponse) *ListTenantNotificationTargetsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant notification targets o k response
+func (o *ListTenantNotificationTargetsOK) SetPayload(payload *models.ListNotificationTargetsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantNotificationTargetsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListTenantNotificationTargetsDefault Generic error response.
+
+swagger:response listTenantNotificationTargetsDefault
+*/
+type ListTenantNotificationTargetsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListTenantNotificationTargetsDefault creates ListTenantNotificationTargetsDefault with default headers values
+func NewListTenantNotificationTargetsDefault(code int) *ListTenantNotificationTargetsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListTenantNotificationTargetsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list tenant notification targets default response
+func (o *ListTenantNotificationTargetsDefault) WithStatusCode(code int) *ListTenantNotificationTargetsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list tenant notification targets default response
+func (o *ListTenantNotificationTargetsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list tenant notification targets default response
+func (o *ListTenantNotificationTargetsDefault) WithPayload(payload *models.Error) *ListTenantNotificationTargetsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant notification targets default response
+func (o *ListTenantNotificationTargetsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantNotificationTargetsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
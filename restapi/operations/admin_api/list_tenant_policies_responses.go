@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// ListTenantPoliciesOKCode is the HTTP code returned for type ListTenantPoliciesOK
+const ListTenantPoliciesOKCode int = 200
+
+/*ListTenantPoliciesOK A successful response.
+
+swagger:response listTenantPoliciesOK
+*/
+type ListTenantPoliciesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ListPoliciesResponse `json:"body,omitempty"`
+}
+
+// NewListTenantPoliciesOK creates ListTenantPoliciesOK with default headers values
+func NewListTenantPoliciesOK() *ListTenantPoliciesOK {
+
+	return &ListTenantPoliciesOK{}
+}
+
+// WithPayload adds the payload to the list tenant policies o k response
+func (o *ListTenantPoliciesOK) WithPayload(payload *models.ListPoliciesResponse) *ListTenantPoliciesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant policies o k response
+func (o *ListTenantPoliciesOK) SetPayload(payload *models.ListPoliciesResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantPoliciesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListTenantPoliciesDefault Generic error response.
+
+swagger:response listTenantPoliciesDefault
+*/
+type ListTenantPoliciesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListTenantPoliciesDefault creates ListTenantPoliciesDefault with default headers values
+func NewListTenantPoliciesDefault(code int) *ListTenantPoliciesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListTenantPoliciesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list tenant policies default response
+func (o *ListTenantPoliciesDefault) WithStatusCode(code int) *ListTenantPoliciesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list tenant policies default response
+func (o *ListTenantPoliciesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list tenant policies default response
+func (o *ListTenantPoliciesDefault) WithPayload(payload *models.Error) *ListTenantPoliciesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant policies default response
+func (o *ListTenantPoliciesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantPoliciesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
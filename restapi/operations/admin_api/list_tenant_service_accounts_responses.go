@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// ListTenantServiceAccountsOKCode is the HTTP code returned for type ListTenantServiceAccountsOK
+const ListTenantServiceAccountsOKCode int = 200
+
+/*ListTenantServiceAccountsOK A successful response.
+
+swagger:response listTenantServiceAccountsOK
+*/
+type ListTenantServiceAccountsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ListServiceAccountsResponse `json:"body,omitempty"`
+}
+
+// NewListTenantServiceAccountsOK creates ListTenantServiceAccountsOK with default headers values
+func NewListTenantServiceAccountsOK() *ListTenantServiceAccountsOK {
+
+	return &ListTenantServiceAccountsOK{}
+}
+
+// WithPayload adds the payload to the list tenant service accounts o k response
+func (o *ListTenantServiceAccountsOK) WithPayload(payload *models.ListServiceAccountsResponse) *ListTenantServiceAccountsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant service accounts o k response
+func (o *ListTenantServiceAccountsOK) SetPayload(payload *models.ListServiceAccountsResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantServiceAccountsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListTenantServiceAccountsDefault Generic error response.
+
+swagger:response listTenantServiceAccountsDefault
+*/
+type ListTenantServiceAccountsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListTenantServiceAccountsDefault creates ListTenantServiceAccountsDefault with default headers values
+func NewListTenantServiceAccountsDefault(code int) *ListTenantServiceAccountsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListTenantServiceAccountsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list tenant service accounts default response
+func (o *ListTenantServiceAccountsDefault) WithStatusCode(code int) *ListTenantServiceAccountsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list tenant service accounts default response
+func (o *ListTenantServiceAccountsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list tenant service accounts default response
+func (o *ListTenantServiceAccountsDefault) WithPayload(payload *models.Error) *ListTenantServiceAccountsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant service accounts default response
+func (o *ListTenantServiceAccountsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantServiceAccountsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// ListTenantUsersOKCode is the HTTP code returned for type ListTenantUsersOK
+const ListTenantUsersOKCode int = 200
+
+/*ListTenantUsersOK A successful response.
+
+swagger:response listTenantUsersOK
+*/
+type ListTenantUsersOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ListUsersResponse `json:"body,omitempty"`
+}
+
+// NewListTenantUsersOK creates ListTenantUsersOK with default headers values
+func NewListTenantUsersOK() *ListTenantUsersOK {
+
+	return &ListTenantUsersOK{}
+}
+
+// WithPayload adds the payload to the list tenant users o k response
+func (o *ListTenantUsersOK) WithPayload(payload *models.ListUsersResponse) *ListTenantUsersOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant users o k response
+func (o *ListTenantUsersOK) SetPayload(payload *models.ListUsersResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantUsersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListTenantUsersDefault Generic error response.
+
+swagger:response listTenantUsersDefault
+*/
+type ListTenantUsersDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListTenantUsersDefault creates ListTenantUsersDefault with default headers values
+func NewListTenantUsersDefault(code int) *ListTenantUsersDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListTenantUsersDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list tenant users default response
+func (o *ListTenantUsersDefault) WithStatusCode(code int) *ListTenantUsersDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list tenant users default response
+func (o *ListTenantUsersDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list tenant users default response
+func (o *ListTenantUsersDefault) WithPayload(payload *models.Error) *ListTenantUsersDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list tenant users default response
+func (o *ListTenantUsersDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListTenantUsersDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// ListWebhookDeliveriesOKCode is the HTTP code returned for type ListWebhookDeliveriesOK
+const ListWebhookDeliveriesOKCode int = 200
+
+/*ListWebhookDeliveriesOK A successful response.
+
+swagger:response listWebhookDeliveriesOK
+*/
+type ListWebhookDeliveriesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ListWebhookDeliveriesResponse `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesOK creates ListWebhookDeliveriesOK with default headers values
+func NewListWebhookDeliveriesOK() *ListWebhookDeliveriesOK {
+
+	return &ListWebhookDeliveriesOK{}
+}
+
+// WithPayload adds the payload to the list webhook deliveries o k response
+func (o *ListWebhookDeliveriesOK) WithPayload(payload *models.ListWebhookDeliveriesResponse) *ListWebhookDeliveriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries o k response
+func (o *ListWebhookDeliveriesOK) SetPayload(payload *models.ListWebhookDeliveriesResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListWebhookDeliveriesDefault Generic error response.
+
+swagger:response listWebhookDeliveriesDefault
+*/
+type ListWebhookDeliveriesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesDefault creates ListWebhookDeliveriesDefault with default headers values
+func NewListWebhookDeliveriesDefault(code int) *ListWebhookDeliveriesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListWebhookDeliveriesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list webhook deliveries default response
+func (o *ListWebhookDeliveriesDefault) WithStatusCode(code int) *ListWebhookDeliveriesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list webhook deliveries default response
+func (o *ListWebhookDeliveriesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list webhook deliveries default response
+func (o *ListWebhookDeliveriesDefault) WithPayload(payload *models.Error) *ListWebhookDeliveriesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries default response
+func (o *ListWebhookDeliveriesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
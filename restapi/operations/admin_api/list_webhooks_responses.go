@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// ListWebhooksOKCode is the HTTP code returned for type ListWebhooksOK
+const ListWebhooksOKCode int = 200
+
+/*ListWebhooksOK A successful response.
+
+swagger:response listWebhooksOK
+*/
+type ListWebhooksOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ListWebhooksResponse `json:"body,omitempty"`
+}
+
+// NewListWebhooksOK creates ListWebhooksOK with default headers values
+func NewListWebhooksOK() *ListWebhooksOK {
+
+	return &ListWebhooksOK{}
+}
+
+// WithPayload adds the payload to the list webhooks o k response
+func (o *ListWebhooksOK) WithPayload(payload *models.ListWebhooksResponse) *ListWebhooksOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks o k response
+func (o *ListWebhooksOK) SetPayload(payload *models.ListWebhooksResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ListWebhooksDefault Generic error response.
+
+swagger:response listWebhooksDefault
+*/
+type ListWebhooksDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhooksDefault creates ListWebhooksDefault with default headers values
+func NewListWebhooksDefault(code int) *ListWebhooksDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListWebhooksDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list webhooks default response
+func (o *ListWebhooksDefault) WithStatusCode(code int) *ListWebhooksDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list webhooks default response
+func (o *ListWebhooksDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list webhooks default response
+func (o *ListWebhooksDefault) WithPayload(payload *models.Error) *ListWebhooksDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks default response
+func (o *ListWebhooksDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// PresignTenantBucketObjectOKCode is the HTTP code returned for type PresignTenantBucketObjectOK
+const PresignTenantBucketObjectOKCode int = 200
+
+/*PresignTenantBucketObjectOK A successful response.
+
+swagger:response presignTenantBucketObjectOK
+*/
+type PresignTenantBucketObjectOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PresignedObjectURL `json:"body,omitempty"`
+}
+
+// NewPresignTenantBucketObjectOK creates PresignTenantBucketObjectOK with default headers values
+func NewPresignTenantBucketObjectOK() *PresignTenantBucketObjectOK {
+
+	return &PresignTenantBucketObjectOK{}
+}
+
+// WithPayload adds the payload to the presign tenant bucket object o k response
+func (o *PresignTenantBucketObjectOK) WithPayload(payload *models.PresignedObjectURL) *PresignTenantBucketObjectOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the presign tenant bucket object o k response
+func (o *PresignTenantBucketObjectOK) SetPayload(payload *models.PresignedObjectURL) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PresignTenantBucketObjectOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*PresignTenantBucketObjectDefault Generic error response.
+
+swagger:response presignTenantBucketObjectDefault
+*/
+type PresignTenantBucketObjectDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPresignTenantBucketObjectDefault creates PresignTenantBucketObjectDefault with default headers values
+func NewPresignTenantBucketObjectDefault(code int) *PresignTenantBucketObjectDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PresignTenantBucketObjectDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the presign tenant bucket object default response
+func (o *PresignTenantBucketObjectDefault) WithStatusCode(code int) *PresignTenantBucketObjectDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the presign tenant bucket object default response
+func (o *PresignTenantBucketObjectDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the presign tenant bucket object default response
+func (o *PresignTenantBucketObjectDefault) WithPayload(payload *models.Error) *PresignTenantBucketObjectDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the presign tenant bucket object default response
+func (o *PresignTenantBucketObjectDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PresignTenantBucketObjectDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
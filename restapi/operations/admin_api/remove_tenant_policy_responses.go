@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// RemoveTenantPolicyNoContentCode is the HTTP code returned for type RemoveTenantPolicyNoContent
+const RemoveTenantPolicyNoContentCode int = 204
+
+/*RemoveTenantPolicyNoContent A successful response.
+
+swagger:response removeTenantPolicyNoContent
+*/
+type RemoveTenantPolicyNoContent struct {
+}
+
+// NewRemoveTenantPolicyNoContent creates RemoveTenantPolicyNoContent with default headers values
+func NewRemoveTenantPolicyNoContent() *RemoveTenantPolicyNoContent {
+
+	return &RemoveTenantPolicyNoContent{}
+}
+
+// WriteResponse to the client
+func (o *RemoveTenantPolicyNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*RemoveTenantPolicyDefault Generic error response.
+
+swagger:response removeTenantPolicyDefault
+*/
+type RemoveTenantPolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRemoveTenantPolicyDefault creates RemoveTenantPolicyDefault with default headers values
+func NewRemoveTenantPolicyDefault(code int) *RemoveTenantPolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RemoveTenantPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the remove tenant policy default response
+func (o *RemoveTenantPolicyDefault) WithStatusCode(code int) *RemoveTenantPolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the remove tenant policy default response
+func (o *RemoveTenantPolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the remove tenant policy default response
+func (o *RemoveTenantPolicyDefault) WithPayload(payload *models.Error) *RemoveTenantPolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the remove tenant policy default response
+func (o *RemoveTenantPolicyDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RemoveTenantPolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// SetTenantBucketLifecycleOKCode is the HTTP code returned for type SetTenantBucketLifecycleOK
+const SetTenantBucketLifecycleOKCode int = 200
+
+/*SetTenantBucketLifecycleOK A successful response.
+
+swagger:response setTenantBucketLifecycleOK
+*/
+type SetTenantBucketLifecycleOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BucketLifecycle `json:"body,omitempty"`
+}
+
+// NewSetTenantBucketLifecycleOK creates SetTenantBucketLifecycleOK with default headers values
+func NewSetTenantBucketLifecycleOK() *SetTenantBucketLifecycleOK {
+
+	return &SetTenantBucketLifecycleOK{}
+}
+
+// WithPayload adds the payload to the set tenant bucket lifecycle o k response
+func (o *SetTenantBucketLifecycleOK) WithPayload(payload *models.BucketLifecycle) *SetTenantBucketLifecycleOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant bucket lifecycle o k response
+func (o *SetTenantBucketLifecycleOK) SetPayload(payload *models.BucketLifecycle) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantBucketLifecycleOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetTenantBucketLifecycleDefault Generic error response.
+
+swagger:response setTenantBucketLifecycleDefault
+*/
+type SetTenantBucketLifecycleDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetTenantBucketLifecycleDefault creates SetTenantBucketLifecycleDefault with default headers values
+func NewSetTenantBucketLifecycleDefault(code int) *SetTenantBucketLifecycleDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetTenantBucketLifecycleDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set tenant bucket lifecycle default response
+func (o *SetTenantBucketLifecycleDefault) WithStatusCode(code int) *SetTenantBucketLifecycleDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set tenant bucket lifecycle default response
+func (o *SetTenantBucketLifecycleDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set tenant bucket lifecycle default response
+func (o *SetTenantBucketLifecycleDefault) WithPayload(payload *models.Error) *SetTenantBucketLifecycleDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant bucket lifecycle default response
+func (o *SetTenantBucketLifecycleDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantBucketLifecycleDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// SetTenantBucketNotificationsOKCode is the HTTP code returned for type SetTenantBucketNotificationsOK
+const SetTenantBucketNotificationsOKCode int = 200
+
+/*SetTenantBucketNotificationsOK A successful response.
+
+swagger:response setTenantBucketNotificationsOK
+*/
+type SetTenantBucketNotificationsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BucketNotifications `json:"body,omitempty"`
+}
+
+// NewSetTenantBucketNotificationsOK creates SetTenantBucketNotificationsOK with default headers values
+func NewSetTenantBucketNotificationsOK() *SetTenantBucketNotificationsOK {
+
+	return &SetTenantBucketNotificationsOK{}
+}
+
+// WithPayload adds the payload to the set tenant bucket notifications o k response
+func (o *SetTenantBucketNotificationsOK) WithPayload(payload *models.BucketNotifications) *SetTenantBucketNotificationsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant bucket notifications o k response
+func (o *SetTenantBucketNotificationsOK) SetPayload(payload *models.BucketNotifications) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantBucketNotificationsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetTenantBucketNotificationsDefault Generic error response.
+
+swagger:response setTenantBucketNotificationsDefault
+*/
+type SetTenantBucketNotificationsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetTenantBucketNotificationsDefault creates SetTenantBucketNotificationsDefault with default headers values
+func NewSetTenantBucketNotificationsDefault(code int) *SetTenantBucketNotificationsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetTenantBucketNotificationsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set tenant bucket notifications default response
+func (o *SetTenantBucketNotificationsDefault) WithStatusCode(code int) *SetTenantBucketNotificationsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set tenant bucket notifications default response
+func (o *SetTenantBucketNotificationsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set tenant bucket notifications default response
+func (o *SetTenantBucketNotificationsDefault) WithPayload(payload *models.Error) *SetTenantBucketNotificationsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant bucket notifications default response
+func (o *SetTenantBucketNotificationsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantBucketNotificationsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
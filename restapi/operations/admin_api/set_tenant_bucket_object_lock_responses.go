@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// SetTenantBucketObjectLockOKCode is the HTTP code returned for type SetTenantBucketObjectLockOK
+const SetTenantBucketObjectLockOKCode int = 200
+
+/*SetTenantBucketObjectLockOK A successful response.
+
+swagger:response setTenantBucketObjectLockOK
+*/
+type SetTenantBucketObjectLockOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Bucket `json:"body,omitempty"`
+}
+
+// NewSetTenantBucketObjectLockOK creates SetTenantBucketObjectLockOK with default headers values
+func NewSetTenantBucketObjectLockOK() *SetTenantBucketObjectLockOK {
+
+	return &SetTenantBucketObjectLockOK{}
+}
+
+// WithPayload adds the payload to the set tenant bucket object lock o k response
+func (o *SetTenantBucketObjectLockOK) WithPayload(payload *models.Bucket) *SetTenantBucketObjectLockOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant bucket object lock o k response
+func (o *SetTenantBucketObjectLockOK) SetPayload(payload *models.Bucket) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantBucketObjectLockOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetTenantBucketObjectLockDefault Generic error response.
+
+swagger:response setTenantBucketObjectLockDefault
+*/
+type SetTenantBucketObjectLockDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetTenantBucketObjectLockDefault creates SetTenantBucketObjectLockDefault with default headers values
+func NewSetTenantBucketObjectLockDefault(code int) *SetTenantBucketObjectLockDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetTenantBucketObjectLockDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set tenant bucket object lock default response
+func (o *SetTenantBucketObjectLockDefault) WithStatusCode(code int) *SetTenantBucketObjectLockDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set tenant bucket object lock default response
+func (o *SetTenantBucketObjectLockDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set tenant bucket object lock default response
+func (o *SetTenantBucketObjectLockDefault) WithPayload(payload *models.Error) *SetTenantBucketObjectLockDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant bucket object lock default response
+func (o *SetTenantBucketObjectLockDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantBucketObjectLockDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// SetTenantBucketQuotaOKCode is the HTTP code returned for type SetTenantBucketQuotaOK
+const SetTenantBucketQuotaOKCode int = 200
+
+/*SetTenantBucketQuotaOK A successful response.
+
+swagger:response setTenantBucketQuotaOK
+*/
+type SetTenantBucketQuotaOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.SetBucketQuotaResponse `json:"body,omitempty"`
+}
+
+// NewSetTenantBucketQuotaOK creates SetTenantBucketQuotaOK with default headers values
+func NewSetTenantBucketQuotaOK() *SetTenantBucketQuotaOK {
+
+	return &SetTenantBucketQuotaOK{}
+}
+
+// WithPayload adds the payload to the set tenant bucket quota o k response
+func (o *SetTenantBucketQuotaOK) WithPayload(payload *models.SetBucketQuotaResponse) *SetTenantBucketQuotaOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant bucket quota o k response
+func (o *SetTenantBucketQuotaOK) SetPayload(payload *models.SetBucketQuotaResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantBucketQuotaOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetTenantBucketQuotaDefault Generic error response.
+
+swagger:response setTenantBucketQuotaDefault
+*/
+type SetTenantBucketQuotaDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetTenantBucketQuotaDefault creates SetTenantBucketQuotaDefault with default headers values
+func NewSetTenantBucketQuotaDefault(code int) *SetTenantBucketQuotaDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetTenantBucketQuotaDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set tenant bucket quota default response
+func (o *SetTenantBucketQuotaDefault) WithStatusCode(code int) *SetTenantBucketQuotaDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set tenant bucket quota default response
+func (o *SetTenantBucketQuotaDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set tenant bucket quota default response
+func (o *SetTenantBucketQuotaDefault) WithPayload(payload *models.Error) *SetTenantBucketQuotaDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant bucket quota default response
+func (o *SetTenantBucketQuotaDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantBucketQuotaDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
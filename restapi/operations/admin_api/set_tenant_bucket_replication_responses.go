@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// SetTenantBucketReplicationOKCode is the HTTP code returned for type SetTenantBucketReplicationOK
+const SetTenantBucketReplicationOKCode int = 200
+
+/*SetTenantBucketReplicationOK A successful response.
+
+swagger:response setTenantBucketReplicationOK
+*/
+type SetTenantBucketReplicationOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BucketReplication `json:"body,omitempty"`
+}
+
+// NewSetTenantBucketReplicationOK creates SetTenantBucketReplicationOK with default headers values
+func NewSetTenantBucketReplicationOK() *SetTenantBucketReplicationOK {
+
+	return &SetTenantBucketReplicationOK{}
+}
+
+// WithPayload adds the payload to the set tenant bucket replication o k response
+func (o *SetTenantBucketReplicationOK) WithPayload(payload *models.BucketReplication) *SetTenantBucketReplicationOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant bucket replication o k response
+func (o *SetTenantBucketReplicationOK) SetPayload(payload *models.BucketReplication) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantBucketReplicationOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetTenantBucketReplicationDefault Generic error response.
+
+swagger:response setTenantBucketReplicationDefault
+*/
+type SetTenantBucketReplicationDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetTenantBucketReplicationDefault creates SetTenantBucketReplicationDefault with default headers values
+func NewSetTenantBucketReplicationDefault(code int) *SetTenantBucketReplicationDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetTenantBucketReplicationDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set tenant bucket replication default response
+func (o *SetTenantBucketReplicationDefault) WithStatusCode(code int) *SetTenantBucketReplicationDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set tenant bucket replication default response
+func (o *SetTenantBucketReplicationDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set tenant bucket replication default response
+func (o *SetTenantBucketReplicationDefault) WithPayload(payload *models.Error) *SetTenantBucketReplicationDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant bucket replication default response
+func (o *SetTenantBucketReplicationDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantBucketReplicationDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
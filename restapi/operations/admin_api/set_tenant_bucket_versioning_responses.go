@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// SetTenantBucketVersioningOKCode is the HTTP code returned for type SetTenantBucketVersioningOK
+const SetTenantBucketVersioningOKCode int = 200
+
+/*SetTenantBucketVersioningOK A successful response.
+
+swagger:response setTenantBucketVersioningOK
+*/
+type SetTenantBucketVersioningOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Bucket `json:"body,omitempty"`
+}
+
+// NewSetTenantBucketVersioningOK creates SetTenantBucketVersioningOK with default headers values
+func NewSetTenantBucketVersioningOK() *SetTenantBucketVersioningOK {
+
+	return &SetTenantBucketVersioningOK{}
+}
+
+// WithPayload adds the payload to the set tenant bucket versioning o k response
+func (o *SetTenantBucketVersioningOK) WithPayload(payload *models.Bucket) *SetTenantBucketVersioningOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant bucket versioning o k response
+func (o *SetTenantBucketVersioningOK) SetPayload(payload *models.Bucket) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantBucketVersioningOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetTenantBucketVersioningDefault Generic error response.
+
+swagger:response setTenantBucketVersioningDefault
+*/
+type SetTenantBucketVersioningDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetTenantBucketVersioningDefault creates SetTenantBucketVersioningDefault with default headers values
+func NewSetTenantBucketVersioningDefault(code int) *SetTenantBucketVersioningDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetTenantBucketVersioningDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set tenant bucket versioning default response
+func (o *SetTenantBucketVersioningDefault) WithStatusCode(code int) *SetTenantBucketVersioningDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set tenant bucket versioning default response
+func (o *SetTenantBucketVersioningDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set tenant bucket versioning default response
+func (o *SetTenantBucketVersioningDefault) WithPayload(payload *models.Error) *SetTenantBucketVersioningDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant bucket versioning default response
+func (o *SetTenantBucketVersioningDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantBucketVersioningDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// SetTenantGroupPolicyOKCode is the HTTP code returned for type SetTenantGroupPolicyOK
+const SetTenantGroupPolicyOKCode int = 200
+
+/*SetTenantGroupPolicyOK A successful response.
+
+swagger:response setTenantGroupPolicyOK
+*/
+type SetTenantGroupPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Group `json:"body,omitempty"`
+}
+
+// NewSetTenantGroupPolicyOK creates SetTenantGroupPolicyOK with default headers values
+func NewSetTenantGroupPolicyOK() *SetTenantGroupPolicyOK {
+
+	return &SetTenantGroupPolicyOK{}
+}
+
+// WithPayload adds the payload to the set tenant group policy o k response
+func (o *SetTenantGroupPolicyOK) WithPayload(payload *models.Group) *SetTenantGroupPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant group policy o k response
+func (o *SetTenantGroupPolicyOK) SetPayload(payload *models.Group) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantGroupPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetTenantGroupPolicyDefault Generic error response.
+
+swagger:response setTenantGroupPolicyDefault
+*/
+type SetTenantGroupPolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetTenantGroupPolicyDefault creates SetTenantGroupPolicyDefault with default headers values
+func NewSetTenantGroupPolicyDefault(code int) *SetTenantGroupPolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetTenantGroupPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set tenant group policy default response
+func (o *SetTenantGroupPolicyDefault) WithStatusCode(code int) *SetTenantGroupPolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set tenant group policy default response
+func (o *SetTenantGroupPolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set tenant group policy default response
+func (o *SetTenantGroupPolicyDefault) WithPayload(payload *models.Error) *SetTenantGroupPolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant group policy default response
+func (o *SetTenantGroupPolicyDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantGroupPolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
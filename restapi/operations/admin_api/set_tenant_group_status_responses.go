@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// SetTenantGroupStatusOKCode is the HTTP code returned for type SetTenantGroupStatusOK
+const SetTenantGroupStatusOKCode int = 200
+
+/*SetTenantGroupStatusOK A successful response.
+
+swagger:response setTenantGroupStatusOK
+*/
+type SetTenantGroupStatusOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Group `json:"body,omitempty"`
+}
+
+// NewSetTenantGroupStatusOK creates SetTenantGroupStatusOK with default headers values
+func NewSetTenantGroupStatusOK() *SetTenantGroupStatusOK {
+
+	return &SetTenantGroupStatusOK{}
+}
+
+// WithPayload adds the payload to the set tenant group status o k response
+func (o *SetTenantGroupStatusOK) WithPayload(payload *models.Group) *SetTenantGroupStatusOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant group status o k response
+func (o *SetTenantGroupStatusOK) SetPayload(payload *models.Group) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantGroupStatusOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetTenantGroupStatusDefault Generic error response.
+
+swagger:response setTenantGroupStatusDefault
+*/
+type SetTenantGroupStatusDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetTenantGroupStatusDefault creates SetTenantGroupStatusDefault with default headers values
+func NewSetTenantGroupStatusDefault(code int) *SetTenantGroupStatusDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetTenantGroupStatusDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set tenant group status default response
+func (o *SetTenantGroupStatusDefault) WithStatusCode(code int) *SetTenantGroupStatusDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set tenant group status default response
+func (o *SetTenantGroupStatusDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set tenant group status default response
+func (o *SetTenantGroupStatusDefault) WithPayload(payload *models.Error) *SetTenantGroupStatusDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant group status default response
+func (o *SetTenantGroupStatusDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantGroupStatusDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// SetTenantUserPolicyOKCode is the HTTP code returned for type SetTenantUserPolicyOK
+const SetTenantUserPolicyOKCode int = 200
+
+/*SetTenantUserPolicyOK A successful response.
+
+swagger:response setTenantUserPolicyOK
+*/
+type SetTenantUserPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.User `json:"body,omitempty"`
+}
+
+// NewSetTenantUserPolicyOK creates SetTenantUserPolicyOK with default headers values
+func NewSetTenantUserPolicyOK() *SetTenantUserPolicyOK {
+
+	return &SetTenantUserPolicyOK{}
+}
+
+// WithPayload adds the payload to the set tenant user policy o k response
+func (o *SetTenantUserPolicyOK) WithPayload(payload *models.User) *SetTenantUserPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant user policy o k response
+func (o *SetTenantUserPolicyOK) SetPayload(payload *models.User) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantUserPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetTenantUserPolicyDefault Generic error response.
+
+swagger:response setTenantUserPolicyDefault
+*/
+type SetTenantUserPolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetTenantUserPolicyDefault creates SetTenantUserPolicyDefault with default headers values
+func NewSetTenantUserPolicyDefault(code int) *SetTenantUserPolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetTenantUserPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set tenant user policy default response
+func (o *SetTenantUserPolicyDefault) WithStatusCode(code int) *SetTenantUserPolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set tenant user policy default response
+func (o *SetTenantUserPolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set tenant user policy default response
+func (o *SetTenantUserPolicyDefault) WithPayload(payload *models.Error) *SetTenantUserPolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant user policy default response
+func (o *SetTenantUserPolicyDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantUserPolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// SetTenantUserStatusOKCode is the HTTP code returned for type SetTenantUserStatusOK
+const SetTenantUserStatusOKCode int = 200
+
+/*SetTenantUserStatusOK A successful response.
+
+swagger:response setTenantUserStatusOK
+*/
+type SetTenantUserStatusOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.User `json:"body,omitempty"`
+}
+
+// NewSetTenantUserStatusOK creates SetTenantUserStatusOK with default headers values
+func NewSetTenantUserStatusOK() *SetTenantUserStatusOK {
+
+	return &SetTenantUserStatusOK{}
+}
+
+// WithPayload adds the payload to the set tenant user status o k response
+func (o *SetTenantUserStatusOK) WithPayload(payload *models.User) *SetTenantUserStatusOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant user status o k response
+func (o *SetTenantUserStatusOK) SetPayload(payload *models.User) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantUserStatusOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetTenantUserStatusDefault Generic error response.
+
+swagger:response setTenantUserStatusDefault
+*/
+type SetTenantUserStatusDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetTenantUserStatusDefault creates SetTenantUserStatusDefault with default headers values
+func NewSetTenantUserStatusDefault(code int) *SetTenantUserStatusDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetTenantUserStatusDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set tenant user status default response
+func (o *SetTenantUserStatusDefault) WithStatusCode(code int) *SetTenantUserStatusDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set tenant user status default response
+func (o *SetTenantUserStatusDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set tenant user status default response
+func (o *SetTenantUserStatusDefault) WithPayload(payload *models.Error) *SetTenantUserStatusDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set tenant user status default response
+func (o *SetTenantUserStatusDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetTenantUserStatusDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// TenantBucketInfoOKCode is the HTTP code returned for type TenantBucketInfoOK
+const TenantBucketInfoOKCode int = 200
+
+/*TenantBucketInfoOK A successful response.
+
+swagger:response tenantBucketInfoOK
+*/
+type TenantBucketInfoOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Bucket `json:"body,omitempty"`
+}
+
+// NewTenantBucketInfoOK creates TenantBucketInfoOK with default headers values
+func NewTenantBucketInfoOK() *TenantBucketInfoOK {
+
+	return &TenantBucketInfoOK{}
+}
+
+// WithPayload adds the payload to the tenant bucket info o k response
+func (o *TenantBucketInfoOK) WithPayload(payload *models.Bucket) *TenantBucketInfoOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant bucket info o k response
+func (o *TenantBucketInfoOK) SetPayload(payload *models.Bucket) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantBucketInfoOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*TenantBucketInfoDefault Generic error response.
+
+swagger:response tenantBucketInfoDefault
+*/
+type TenantBucketInfoDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTenantBucketInfoDefault creates TenantBucketInfoDefault with default headers values
+func NewTenantBucketInfoDefault(code int) *TenantBucketInfoDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &TenantBucketInfoDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the tenant bucket info default response
+func (o *TenantBucketInfoDefault) WithStatusCode(code int) *TenantBucketInfoDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the tenant bucket info default response
+func (o *TenantBucketInfoDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the tenant bucket info default response
+func (o *TenantBucketInfoDefault) WithPayload(payload *models.Error) *TenantBucketInfoDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant bucket info default response
+func (o *TenantBucketInfoDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantBucketInfoDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// TenantBucketObjectInfoOKCode is the HTTP code returned for type TenantBucketObjectInfoOK
+const TenantBucketObjectInfoOKCode int = 200
+
+/*TenantBucketObjectInfoOK A successful response.
+
+swagger:response tenantBucketObjectInfoOK
+*/
+type TenantBucketObjectInfoOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.BucketObject `json:"body,omitempty"`
+}
+
+// NewTenantBucketObjectInfoOK creates TenantBucketObjectInfoOK with default headers values
+func NewTenantBucketObjectInfoOK() *TenantBucketObjectInfoOK {
+
+	return &TenantBucketObjectInfoOK{}
+}
+
+// WithPayload adds the payload to the tenant bucket object info o k response
+func (o *TenantBucketObjectInfoOK) WithPayload(payload *models.BucketObject) *TenantBucketObjectInfoOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant bucket object info o k response
+func (o *TenantBucketObjectInfoOK) SetPayload(payload *models.BucketObject) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantBucketObjectInfoOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*TenantBucketObjectInfoDefault Generic error response.
+
+swagger:response tenantBucketObjectInfoDefault
+*/
+type TenantBucketObjectInfoDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTenantBucketObjectInfoDefault creates TenantBucketObjectInfoDefault with default headers values
+func NewTenantBucketObjectInfoDefault(code int) *TenantBucketObjectInfoDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &TenantBucketObjectInfoDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the tenant bucket object info default response
+func (o *TenantBucketObjectInfoDefault) WithStatusCode(code int) *TenantBucketObjectInfoDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the tenant bucket object info default response
+func (o *TenantBucketObjectInfoDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the tenant bucket object info default response
+func (o *TenantBucketObjectInfoDefault) WithPayload(payload *models.Error) *TenantBucketObjectInfoDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant bucket object info default response
+func (o *TenantBucketObjectInfoDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantBucketObjectInfoDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
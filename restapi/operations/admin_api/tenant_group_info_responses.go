@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// TenantGroupInfoOKCode is the HTTP code returned for type TenantGroupInfoOK
+const TenantGroupInfoOKCode int = 200
+
+/*TenantGroupInfoOK A successful response.
+
+swagger:response tenantGroupInfoOK
+*/
+type TenantGroupInfoOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Group `json:"body,omitempty"`
+}
+
+// NewTenantGroupInfoOK creates TenantGroupInfoOK with default headers values
+func NewTenantGroupInfoOK() *TenantGroupInfoOK {
+
+	return &TenantGroupInfoOK{}
+}
+
+// WithPayload adds the payload to the tenant group info o k response
+func (o *TenantGroupInfoOK) WithPayload(payload *models.Group) *TenantGroupInfoOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant group info o k response
+func (o *TenantGroupInfoOK) SetPayload(payload *models.Group) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantGroupInfoOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*TenantGroupInfoDefault Generic error response.
+
+swagger:response tenantGroupInfoDefault
+*/
+type TenantGroupInfoDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTenantGroupInfoDefault creates TenantGroupInfoDefault with default headers values
+func NewTenantGroupInfoDefault(code int) *TenantGroupInfoDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &TenantGroupInfoDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the tenant group info default response
+func (o *TenantGroupInfoDefault) WithStatusCode(code int) *TenantGroupInfoDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the tenant group info default response
+func (o *TenantGroupInfoDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the tenant group info default response
+func (o *TenantGroupInfoDefault) WithPayload(payload *models.Error) *TenantGroupInfoDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant group info default response
+func (o *TenantGroupInfoDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantGroupInfoDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// TenantJobInfoOKCode is the HTTP code returned for type TenantJobInfoOK
+const TenantJobInfoOKCode int = 200
+
+/*TenantJobInfoOK A successful response.
+
+swagger:response tenantJobInfoOK
+*/
+type TenantJobInfoOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TenantJob `json:"body,omitempty"`
+}
+
+// NewTenantJobInfoOK creates TenantJobInfoOK with default headers values
+func NewTenantJobInfoOK() *TenantJobInfoOK {
+
+	return &TenantJobInfoOK{}
+}
+
+// WithPayload adds the payload to the tenant job info o k response
+func (o *TenantJobInfoOK) WithPayload(payload *models.TenantJob) *TenantJobInfoOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant job info o k response
+func (o *TenantJobInfoOK) SetPayload(payload *models.TenantJob) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantJobInfoOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*TenantJobInfoDefault Generic error response.
+
+swagger:response tenantJobInfoDefault
+*/
+type TenantJobInfoDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTenantJobInfoDefault creates TenantJobInfoDefault with default headers values
+func NewTenantJobInfoDefault(code int) *TenantJobInfoDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &TenantJobInfoDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the tenant job info default response
+func (o *TenantJobInfoDefault) WithStatusCode(code int) *TenantJobInfoDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the tenant job info default response
+func (o *TenantJobInfoDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the tenant job info default response
+func (o *TenantJobInfoDefault) WithPayload(payload *models.Error) *TenantJobInfoDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant job info default response
+func (o *TenantJobInfoDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantJobInfoDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// TenantJobLogsOKCode is the HTTP code returned for type TenantJobLogsOK
+const TenantJobLogsOKCode int = 200
+
+/*TenantJobLogsOK A successful response.
+
+swagger:response tenantJobLogsOK
+*/
+type TenantJobLogsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TenantJobLogs `json:"body,omitempty"`
+}
+
+// NewTenantJobLogsOK creates TenantJobLogsOK with default headers values
+func NewTenantJobLogsOK() *TenantJobLogsOK {
+
+	return &TenantJobLogsOK{}
+}
+
+// WithPayload adds the payload to the tenant job logs o k response
+func (o *TenantJobLogsOK) WithPayload(payload *models.TenantJobLogs) *TenantJobLogsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant job logs o k response
+func (o *TenantJobLogsOK) SetPayload(payload *models.TenantJobLogs) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantJobLogsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*TenantJobLogsDefault Generic error response.
+
+swagger:response tenantJobLogsDefault
+*/
+type TenantJobLogsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTenantJobLogsDefault creates TenantJobLogsDefault with default headers values
+func NewTenantJobLogsDefault(code int) *TenantJobLogsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &TenantJobLogsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the tenant job logs default response
+func (o *TenantJobLogsDefault) WithStatusCode(code int) *TenantJobLogsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the tenant job logs default response
+func (o *TenantJobLogsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the tenant job logs default response
+func (o *TenantJobLogsDefault) WithPayload(payload *models.Error) *TenantJobLogsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant job logs default response
+func (o *TenantJobLogsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantJobLogsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// TenantServerInfoOKCode is the HTTP code returned for type TenantServerInfoOK
+const TenantServerInfoOKCode int = 200
+
+/*TenantServerInfoOK A successful response.
+
+swagger:response tenantServerInfoOK
+*/
+type TenantServerInfoOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TenantServerInfo `json:"body,omitempty"`
+}
+
+// NewTenantServerInfoOK creates TenantServerInfoOK with default headers values
+func NewTenantServerInfoOK() *TenantServerInfoOK {
+
+	return &TenantServerInfoOK{}
+}
+
+// WithPayload adds the payload to the tenant server info o k response
+func (o *TenantServerInfoOK) WithPayload(payload *models.TenantServerInfo) *TenantServerInfoOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant server info o k response
+func (o *TenantServerInfoOK) SetPayload(payload *models.TenantServerInfo) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantServerInfoOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*TenantServerInfoDefault Generic error response.
+
+swagger:response tenantServerInfoDefault
+*/
+type TenantServerInfoDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTenantServerInfoDefault creates TenantServerInfoDefault with default headers values
+func NewTenantServerInfoDefault(code int) *TenantServerInfoDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &TenantServerInfoDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the tenant server info default response
+func (o *TenantServerInfoDefault) WithStatusCode(code int) *TenantServerInfoDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the tenant server info default response
+func (o *TenantServerInfoDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the tenant server info default response
+func (o *TenantServerInfoDefault) WithPayload(payload *models.Error) *TenantServerInfoDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant server info default response
+func (o *TenantServerInfoDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantServerInfoDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// TenantUsageOKCode is the HTTP code returned for type TenantUsageOK
+const TenantUsageOKCode int = 200
+
+/*TenantUsageOK A successful response.
+
+swagger:response tenantUsageOK
+*/
+type TenantUsageOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TenantUsage `json:"body,omitempty"`
+}
+
+// NewTenantUsageOK creates TenantUsageOK with default headers values
+func NewTenantUsageOK() *TenantUsageOK {
+
+	return &TenantUsageOK{}
+}
+
+// WithPayload adds the payload to the tenant usage o k response
+func (o *TenantUsageOK) WithPayload(payload *models.TenantUsage) *TenantUsageOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant usage o k response
+func (o *TenantUsageOK) SetPayload(payload *models.TenantUsage) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantUsageOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*TenantUsageDefault Generic error response.
+
+swagger:response tenantUsageDefault
+*/
+type TenantUsageDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTenantUsageDefault creates TenantUsageDefault with default headers values
+func NewTenantUsageDefault(code int) *TenantUsageDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &TenantUsageDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the tenant usage default response
+func (o *TenantUsageDefault) WithStatusCode(code int) *TenantUsageDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the tenant usage default response
+func (o *TenantUsageDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the tenant usage default response
+func (o *TenantUsageDefault) WithPayload(payload *models.Error) *TenantUsageDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the tenant usage default response
+func (o *TenantUsageDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TenantUsageDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// UpdateTenantGroupMembersOKCode is the HTTP code returned for type UpdateTenantGroupMembersOK
+const UpdateTenantGroupMembersOKCode int = 200
+
+/*UpdateTenantGroupMembersOK A successful response.
+
+swagger:response updateTenantGroupMembersOK
+*/
+type UpdateTenantGroupMembersOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Group `json:"body,omitempty"`
+}
+
+// NewUpdateTenantGroupMembersOK creates UpdateTenantGroupMembersOK with default headers values
+func NewUpdateTenantGroupMembersOK() *UpdateTenantGroupMembersOK {
+
+	return &UpdateTenantGroupMembersOK{}
+}
+
+// WithPayload adds the payload to the update tenant group members o k response
+func (o *UpdateTenantGroupMembersOK) WithPayload(payload *models.Group) *UpdateTenantGroupMembersOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update tenant group members o k response
+func (o *UpdateTenantGroupMembersOK) SetPayload(payload *models.Group) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateTenantGroupMembersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdateTenantGroupMembersDefault Generic error response.
+
+swagger:response updateTenantGroupMembersDefault
+*/
+type UpdateTenantGroupMembersDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateTenantGroupMembersDefault creates UpdateTenantGroupMembersDefault with default headers values
+func NewUpdateTenantGroupMembersDefault(code int) *UpdateTenantGroupMembersDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdateTenantGroupMembersDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update tenant group members default response
+func (o *UpdateTenantGroupMembersDefault) WithStatusCode(code int) *UpdateTenantGroupMembersDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update tenant group members default response
+func (o *UpdateTenantGroupMembersDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update tenant group members default response
+func (o *UpdateTenantGroupMembersDefault) WithPayload(payload *models.Error) *UpdateTenantGroupMembersDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update tenant group members default response
+func (o *UpdateTenantGroupMembersDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateTenantGroupMembersDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
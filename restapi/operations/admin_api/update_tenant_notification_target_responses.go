@@ -0,0 +1,133 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+//
+
+package admin_api
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/minio/m3/models"
+)
+
+// UpdateTenantNotificationTargetOKCode is the HTTP code returned for type UpdateTenantNotificationTargetOK
+const UpdateTenantNotificationTargetOKCode int = 200
+
+/*UpdateTenantNotificationTargetOK A successful response.
+
+swagger:response updateTenantNotificationTargetOK
+*/
+type UpdateTenantNotificationTargetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NotificationTargetResponse `json:"body,omitempty"`
+}
+
+// NewUpdateTenantNotificationTargetOK creates UpdateTenantNotificationTargetOK with default headers values
+func NewUpdateTenantNotificationTargetOK() *UpdateTenantNotificationTargetOK {
+
+	return &UpdateTenantNotificationTargetOK{}
+}
+
+// WithPayload adds the payload to the update tenant notification target o k response
+func (o *UpdateTenantNotificationTargetOK) WithPayload(payload *models.NotificationTargetResponse) *UpdateTenantNotificationTargetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update tenant notification target o k response
+func (o *UpdateTenantNotificationTargetOK) SetPayload(payload *models.NotificationTargetResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateTenantNotificationTargetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*UpdateTenantNotificationTargetDefault Generic error response.
+
+swagger:response updateTenantNotificationTargetDefault
+*/
+type UpdateTenantNotificationTargetDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateTenantNotificationTargetDefault creates UpdateTenantNotificationTargetDefault with default headers values
+func NewUpdateTenantNotificationTargetDefault(code int) *UpdateTenantNotificationTargetDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdateTenantNotificationTargetDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update tenant notification target default response
+func (o *UpdateTenantNotificationTargetDefault) WithStatusCode(code int) *UpdateTenantNotificationTargetDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update tenant notification target default response
+func (o *UpdateTenantNotificationTargetDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update tenant notification target default response
+func (o *UpdateTenantNotificationTargetDefault) WithPayload(payload *models.Error) *UpdateTenantNotificationTargetDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update tenant notification target default response
+func (o *UpdateTenantNotificationTargetDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateTenantNotificationTargetDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
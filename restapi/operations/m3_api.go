@@ -61,27 +61,210 @@ func NewM3API(spec *loads.Document) *M3API {
 
 		JSONProducer: runtime.JSONProducer(),
 
+		AdminAPIAddTenantBucketLifecycleRuleHandler: admin_api.AddTenantBucketLifecycleRuleHandlerFunc(func(params admin_api.AddTenantBucketLifecycleRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.AddTenantBucketLifecycleRule has not yet been implemented")
+		}),
+		AdminAPIAddTenantPolicyHandler: admin_api.AddTenantPolicyHandlerFunc(func(params admin_api.AddTenantPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.AddTenantPolicy has not yet been implemented")
+		}),
+		AdminAPIAddTenantUserHandler: admin_api.AddTenantUserHandlerFunc(func(params admin_api.AddTenantUserParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.AddTenantUser has not yet been implemented")
+		}),
+		AdminAPIClearTenantBucketQuotaHandler: admin_api.ClearTenantBucketQuotaHandlerFunc(func(params admin_api.ClearTenantBucketQuotaParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ClearTenantBucketQuota has not yet been implemented")
+		}),
+		AdminAPIClusterUsageHandler: admin_api.ClusterUsageHandlerFunc(func(params admin_api.ClusterUsageParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ClusterUsage has not yet been implemented")
+		}),
 		AdminAPICreateTenantHandler: admin_api.CreateTenantHandlerFunc(func(params admin_api.CreateTenantParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.CreateTenant has not yet been implemented")
 		}),
+		AdminAPICreateTenantBucketHandler: admin_api.CreateTenantBucketHandlerFunc(func(params admin_api.CreateTenantBucketParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CreateTenantBucket has not yet been implemented")
+		}),
+		AdminAPICreateTenantGroupHandler: admin_api.CreateTenantGroupHandlerFunc(func(params admin_api.CreateTenantGroupParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CreateTenantGroup has not yet been implemented")
+		}),
+		AdminAPICreateTenantJobHandler: admin_api.CreateTenantJobHandlerFunc(func(params admin_api.CreateTenantJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CreateTenantJob has not yet been implemented")
+		}),
+		AdminAPICreateTenantNotificationTargetHandler: admin_api.CreateTenantNotificationTargetHandlerFunc(func(params admin_api.CreateTenantNotificationTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CreateTenantNotificationTarget has not yet been implemented")
+		}),
+		AdminAPICreateTenantServiceAccountHandler: admin_api.CreateTenantServiceAccountHandlerFunc(func(params admin_api.CreateTenantServiceAccountParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CreateTenantServiceAccount has not yet been implemented")
+		}),
+		AdminAPICreateWebhookHandler: admin_api.CreateWebhookHandlerFunc(func(params admin_api.CreateWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.CreateWebhook has not yet been implemented")
+		}),
 		AdminAPIDeleteTenantHandler: admin_api.DeleteTenantHandlerFunc(func(params admin_api.DeleteTenantParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.DeleteTenant has not yet been implemented")
 		}),
+		AdminAPIDeleteTenantBucketHandler: admin_api.DeleteTenantBucketHandlerFunc(func(params admin_api.DeleteTenantBucketParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteTenantBucket has not yet been implemented")
+		}),
+		AdminAPIDeleteTenantBucketLifecycleHandler: admin_api.DeleteTenantBucketLifecycleHandlerFunc(func(params admin_api.DeleteTenantBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteTenantBucketLifecycle has not yet been implemented")
+		}),
+		AdminAPIDeleteTenantBucketLifecycleRuleHandler: admin_api.DeleteTenantBucketLifecycleRuleHandlerFunc(func(params admin_api.DeleteTenantBucketLifecycleRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteTenantBucketLifecycleRule has not yet been implemented")
+		}),
+		AdminAPIDeleteTenantBucketNotificationsHandler: admin_api.DeleteTenantBucketNotificationsHandlerFunc(func(params admin_api.DeleteTenantBucketNotificationsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteTenantBucketNotifications has not yet been implemented")
+		}),
+		AdminAPIDeleteTenantBucketReplicationHandler: admin_api.DeleteTenantBucketReplicationHandlerFunc(func(params admin_api.DeleteTenantBucketReplicationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteTenantBucketReplication has not yet been implemented")
+		}),
+		AdminAPIDeleteTenantJobHandler: admin_api.DeleteTenantJobHandlerFunc(func(params admin_api.DeleteTenantJobParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteTenantJob has not yet been implemented")
+		}),
+		AdminAPIDeleteTenantNotificationTargetHandler: admin_api.DeleteTenantNotificationTargetHandlerFunc(func(params admin_api.DeleteTenantNotificationTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteTenantNotificationTarget has not yet been implemented")
+		}),
+		AdminAPIDeleteTenantServiceAccountHandler: admin_api.DeleteTenantServiceAccountHandlerFunc(func(params admin_api.DeleteTenantServiceAccountParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteTenantServiceAccount has not yet been implemented")
+		}),
+		AdminAPIDeleteWebhookHandler: admin_api.DeleteWebhookHandlerFunc(func(params admin_api.DeleteWebhookParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.DeleteWebhook has not yet been implemented")
+		}),
 		AdminAPIGetResourceQuotaHandler: admin_api.GetResourceQuotaHandlerFunc(func(params admin_api.GetResourceQuotaParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.GetResourceQuota has not yet been implemented")
 		}),
+		AdminAPIGetTenantBucketLifecycleHandler: admin_api.GetTenantBucketLifecycleHandlerFunc(func(params admin_api.GetTenantBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.GetTenantBucketLifecycle has not yet been implemented")
+		}),
+		AdminAPIGetTenantBucketNotificationsHandler: admin_api.GetTenantBucketNotificationsHandlerFunc(func(params admin_api.GetTenantBucketNotificationsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.GetTenantBucketNotifications has not yet been implemented")
+		}),
+		AdminAPIGetTenantBucketQuotaHandler: admin_api.GetTenantBucketQuotaHandlerFunc(func(params admin_api.GetTenantBucketQuotaParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.GetTenantBucketQuota has not yet been implemented")
+		}),
+		AdminAPIGetTenantBucketReplicationHandler: admin_api.GetTenantBucketReplicationHandlerFunc(func(params admin_api.GetTenantBucketReplicationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.GetTenantBucketReplication has not yet been implemented")
+		}),
 		AdminAPIListAllTenantsHandler: admin_api.ListAllTenantsHandlerFunc(func(params admin_api.ListAllTenantsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ListAllTenants has not yet been implemented")
 		}),
+		AdminAPIListManagedCertificatesHandler: admin_api.ListManagedCertificatesHandlerFunc(func(params admin_api.ListManagedCertificatesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListManagedCertificates has not yet been implemented")
+		}),
+		AdminAPIListTenantBucketObjectsHandler: admin_api.ListTenantBucketObjectsHandlerFunc(func(params admin_api.ListTenantBucketObjectsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListTenantBucketObjects has not yet been implemented")
+		}),
+		AdminAPIListTenantBucketQuotasHandler: admin_api.ListTenantBucketQuotasHandlerFunc(func(params admin_api.ListTenantBucketQuotasParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListTenantBucketQuotas has not yet been implemented")
+		}),
+		AdminAPIListTenantBucketsHandler: admin_api.ListTenantBucketsHandlerFunc(func(params admin_api.ListTenantBucketsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListTenantBuckets has not yet been implemented")
+		}),
+		AdminAPIListTenantGroupsHandler: admin_api.ListTenantGroupsHandlerFunc(func(params admin_api.ListTenantGroupsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListTenantGroups has not yet been implemented")
+		}),
+		AdminAPIListTenantJobsHandler: admin_api.ListTenantJobsHandlerFunc(func(params admin_api.ListTenantJobsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListTenantJobs has not yet been implemented")
+		}),
+		AdminAPIListTenantNotificationTargetsHandler: admin_api.ListTenantNotificationTargetsHandlerFunc(func(params admin_api.ListTenantNotificationTargetsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListTenantNotificationTargets has not yet been implemented")
+		}),
+		AdminAPIListTenantPoliciesHandler: admin_api.ListTenantPoliciesHandlerFunc(func(params admin_api.ListTenantPoliciesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListTenantPolicies has not yet been implemented")
+		}),
+		AdminAPIListTenantServiceAccountsHandler: admin_api.ListTenantServiceAccountsHandlerFunc(func(params admin_api.ListTenantServiceAccountsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListTenantServiceAccounts has not yet been implemented")
+		}),
+		AdminAPIListTenantUsersHandler: admin_api.ListTenantUsersHandlerFunc(func(params admin_api.ListTenantUsersParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListTenantUsers has not yet been implemented")
+		}),
 		AdminAPIListTenantsHandler: admin_api.ListTenantsHandlerFunc(func(params admin_api.ListTenantsParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.ListTenants has not yet been implemented")
 		}),
+		AdminAPIListWebhookDeliveriesHandler: admin_api.ListWebhookDeliveriesHandlerFunc(func(params admin_api.ListWebhookDeliveriesParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListWebhookDeliveries has not yet been implemented")
+		}),
+		AdminAPIListWebhooksHandler: admin_api.ListWebhooksHandlerFunc(func(params admin_api.ListWebhooksParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.ListWebhooks has not yet been implemented")
+		}),
+		AdminAPIPresignTenantBucketObjectHandler: admin_api.PresignTenantBucketObjectHandlerFunc(func(params admin_api.PresignTenantBucketObjectParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.PresignTenantBucketObject has not yet been implemented")
+		}),
+		AdminAPIRemoveTenantGroupHandler: admin_api.RemoveTenantGroupHandlerFunc(func(params admin_api.RemoveTenantGroupParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.RemoveTenantGroup has not yet been implemented")
+		}),
+		AdminAPIRemoveTenantPolicyHandler: admin_api.RemoveTenantPolicyHandlerFunc(func(params admin_api.RemoveTenantPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.RemoveTenantPolicy has not yet been implemented")
+		}),
+		AdminAPIRemoveTenantUserHandler: admin_api.RemoveTenantUserHandlerFunc(func(params admin_api.RemoveTenantUserParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.RemoveTenantUser has not yet been implemented")
+		}),
+		AdminAPISetTenantBucketLifecycleHandler: admin_api.SetTenantBucketLifecycleHandlerFunc(func(params admin_api.SetTenantBucketLifecycleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SetTenantBucketLifecycle has not yet been implemented")
+		}),
+		AdminAPISetTenantBucketNotificationsHandler: admin_api.SetTenantBucketNotificationsHandlerFunc(func(params admin_api.SetTenantBucketNotificationsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SetTenantBucketNotifications has not yet been implemented")
+		}),
+		AdminAPISetTenantBucketObjectLockHandler: admin_api.SetTenantBucketObjectLockHandlerFunc(func(params admin_api.SetTenantBucketObjectLockParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SetTenantBucketObjectLock has not yet been implemented")
+		}),
+		AdminAPISetTenantBucketQuotaHandler: admin_api.SetTenantBucketQuotaHandlerFunc(func(params admin_api.SetTenantBucketQuotaParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SetTenantBucketQuota has not yet been implemented")
+		}),
+		AdminAPISetTenantBucketReplicationHandler: admin_api.SetTenantBucketReplicationHandlerFunc(func(params admin_api.SetTenantBucketReplicationParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SetTenantBucketReplication has not yet been implemented")
+		}),
+		AdminAPISetTenantBucketVersioningHandler: admin_api.SetTenantBucketVersioningHandlerFunc(func(params admin_api.SetTenantBucketVersioningParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SetTenantBucketVersioning has not yet been implemented")
+		}),
+		AdminAPISetTenantGroupPolicyHandler: admin_api.SetTenantGroupPolicyHandlerFunc(func(params admin_api.SetTenantGroupPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SetTenantGroupPolicy has not yet been implemented")
+		}),
+		AdminAPISetTenantGroupStatusHandler: admin_api.SetTenantGroupStatusHandlerFunc(func(params admin_api.SetTenantGroupStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SetTenantGroupStatus has not yet been implemented")
+		}),
+		AdminAPISetTenantUserPolicyHandler: admin_api.SetTenantUserPolicyHandlerFunc(func(params admin_api.SetTenantUserPolicyParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SetTenantUserPolicy has not yet been implemented")
+		}),
+		AdminAPISetTenantUserStatusHandler: admin_api.SetTenantUserStatusHandlerFunc(func(params admin_api.SetTenantUserStatusParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.SetTenantUserStatus has not yet been implemented")
+		}),
+		AdminAPITenantBucketInfoHandler: admin_api.TenantBucketInfoHandlerFunc(func(params admin_api.TenantBucketInfoParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.TenantBucketInfo has not yet been implemented")
+		}),
+		AdminAPITenantBucketObjectInfoHandler: admin_api.TenantBucketObjectInfoHandlerFunc(func(params admin_api.TenantBucketObjectInfoParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.TenantBucketObjectInfo has not yet been implemented")
+		}),
+		AdminAPITenantGroupInfoHandler: admin_api.TenantGroupInfoHandlerFunc(func(params admin_api.TenantGroupInfoParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.TenantGroupInfo has not yet been implemented")
+		}),
 		AdminAPITenantInfoHandler: admin_api.TenantInfoHandlerFunc(func(params admin_api.TenantInfoParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.TenantInfo has not yet been implemented")
 		}),
+		AdminAPITenantJobInfoHandler: admin_api.TenantJobInfoHandlerFunc(func(params admin_api.TenantJobInfoParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.TenantJobInfo has not yet been implemented")
+		}),
+		AdminAPITenantJobLogsHandler: admin_api.TenantJobLogsHandlerFunc(func(params admin_api.TenantJobLogsParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.TenantJobLogs has not yet been implemented")
+		}),
+		AdminAPITenantServerInfoHandler: admin_api.TenantServerInfoHandlerFunc(func(params admin_api.TenantServerInfoParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.TenantServerInfo has not yet been implemented")
+		}),
+		AdminAPITenantUsageHandler: admin_api.TenantUsageHandlerFunc(func(params admin_api.TenantUsageParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.TenantUsage has not yet been implemented")
+		}),
+		AdminAPITenantUserInfoHandler: admin_api.TenantUserInfoHandlerFunc(func(params admin_api.TenantUserInfoParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.TenantUserInfo has not yet been implemented")
+		}),
 		AdminAPIUpdateTenantHandler: admin_api.UpdateTenantHandlerFunc(func(params admin_api.UpdateTenantParams, principal *models.Principal) middleware.Responder {
 			return middleware.NotImplemented("operation admin_api.UpdateTenant has not yet been implemented")
 		}),
+		AdminAPIUpdateTenantBucketLifecycleRuleHandler: admin_api.UpdateTenantBucketLifecycleRuleHandlerFunc(func(params admin_api.UpdateTenantBucketLifecycleRuleParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.UpdateTenantBucketLifecycleRule has not yet been implemented")
+		}),
+		AdminAPIUpdateTenantGroupMembersHandler: admin_api.UpdateTenantGroupMembersHandlerFunc(func(params admin_api.UpdateTenantGroupMembersParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.UpdateTenantGroupMembers has not yet been implemented")
+		}),
+		AdminAPIUpdateTenantNotificationTargetHandler: admin_api.UpdateTenantNotificationTargetHandlerFunc(func(params admin_api.UpdateTenantNotificationTargetParams, principal *models.Principal) middleware.Responder {
+			return middleware.NotImplemented("operation admin_api.UpdateTenantNotificationTarget has not yet been implemented")
+		}),
 
 		KeyAuth: func(token string, scopes []string) (*models.Principal, error) {
 			return nil, errors.NotImplemented("oauth2 bearer auth (key) has not yet been implemented")
@@ -128,20 +311,142 @@ type M3API struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// AdminAPIAddTenantBucketLifecycleRuleHandler sets the operation handler for the add tenant bucket lifecycle rule operation
+	AdminAPIAddTenantBucketLifecycleRuleHandler admin_api.AddTenantBucketLifecycleRuleHandler
+	// AdminAPIAddTenantPolicyHandler sets the operation handler for the add tenant policy operation
+	AdminAPIAddTenantPolicyHandler admin_api.AddTenantPolicyHandler
+	// AdminAPIAddTenantUserHandler sets the operation handler for the add tenant user operation
+	AdminAPIAddTenantUserHandler admin_api.AddTenantUserHandler
+	// AdminAPIClearTenantBucketQuotaHandler sets the operation handler for the clear tenant bucket quota operation
+	AdminAPIClearTenantBucketQuotaHandler admin_api.ClearTenantBucketQuotaHandler
+	// AdminAPIClusterUsageHandler sets the operation handler for the cluster usage operation
+	AdminAPIClusterUsageHandler admin_api.ClusterUsageHandler
 	// AdminAPICreateTenantHandler sets the operation handler for the create tenant operation
 	AdminAPICreateTenantHandler admin_api.CreateTenantHandler
+	// AdminAPICreateTenantBucketHandler sets the operation handler for the create tenant bucket operation
+	AdminAPICreateTenantBucketHandler admin_api.CreateTenantBucketHandler
+	// AdminAPICreateTenantGroupHandler sets the operation handler for the create tenant group operation
+	AdminAPICreateTenantGroupHandler admin_api.CreateTenantGroupHandler
+	// AdminAPICreateTenantJobHandler sets the operation handler for the create tenant job operation
+	AdminAPICreateTenantJobHandler admin_api.CreateTenantJobHandler
+	// AdminAPICreateTenantNotificationTargetHandler sets the operation handler for the create tenant notification target operation
+	AdminAPICreateTenantNotificationTargetHandler admin_api.CreateTenantNotificationTargetHandler
+	// AdminAPICreateTenantServiceAccountHandler sets the operation handler for the create tenant service account operation
+	AdminAPICreateTenantServiceAccountHandler admin_api.CreateTenantServiceAccountHandler
+	// AdminAPICreateWebhookHandler sets the operation handler for the create webhook operation
+	AdminAPICreateWebhookHandler admin_api.CreateWebhookHandler
 	// AdminAPIDeleteTenantHandler sets the operation handler for the delete tenant operation
 	AdminAPIDeleteTenantHandler admin_api.DeleteTenantHandler
+	// AdminAPIDeleteTenantBucketHandler sets the operation handler for the delete tenant bucket operation
+	AdminAPIDeleteTenantBucketHandler admin_api.DeleteTenantBucketHandler
+	// AdminAPIDeleteTenantBucketLifecycleHandler sets the operation handler for the delete tenant bucket lifecycle operation
+	AdminAPIDeleteTenantBucketLifecycleHandler admin_api.DeleteTenantBucketLifecycleHandler
+	// AdminAPIDeleteTenantBucketLifecycleRuleHandler sets the operation handler for the delete tenant bucket lifecycle rule operation
+	AdminAPIDeleteTenantBucketLifecycleRuleHandler admin_api.DeleteTenantBucketLifecycleRuleHandler
+	// AdminAPIDeleteTenantBucketNotificationsHandler sets the operation handler for the delete tenant bucket notifications operation
+	AdminAPIDeleteTenantBucketNotificationsHandler admin_api.DeleteTenantBucketNotificationsHandler
+	// AdminAPIDeleteTenantBucketReplicationHandler sets the operation handler for the delete tenant bucket replication operation
+	AdminAPIDeleteTenantBucketReplicationHandler admin_api.DeleteTenantBucketReplicationHandler
+	// AdminAPIDeleteTenantJobHandler sets the operation handler for the delete tenant job operation
+	AdminAPIDeleteTenantJobHandler admin_api.DeleteTenantJobHandler
+	// AdminAPIDeleteTenantNotificationTargetHandler sets the operation handler for the delete tenant notification target operation
+	AdminAPIDeleteTenantNotificationTargetHandler admin_api.DeleteTenantNotificationTargetHandler
+	// AdminAPIDeleteTenantServiceAccountHandler sets the operation handler for the delete tenant service account operation
+	AdminAPIDeleteTenantServiceAccountHandler admin_api.DeleteTenantServiceAccountHandler
+	// AdminAPIDeleteWebhookHandler sets the operation handler for the delete webhook operation
+	AdminAPIDeleteWebhookHandler admin_api.DeleteWebhookHandler
 	// AdminAPIGetResourceQuotaHandler sets the operation handler for the get resource quota operation
 	AdminAPIGetResourceQuotaHandler admin_api.GetResourceQuotaHandler
+	// AdminAPIGetTenantBucketLifecycleHandler sets the operation handler for the get tenant bucket lifecycle operation
+	AdminAPIGetTenantBucketLifecycleHandler admin_api.GetTenantBucketLifecycleHandler
+	// AdminAPIGetTenantBucketNotificationsHandler sets the operation handler for the get tenant bucket notifications operation
+	AdminAPIGetTenantBucketNotificationsHandler admin_api.GetTenantBucketNotificationsHandler
+	// AdminAPIGetTenantBucketQuotaHandler sets the operation handler for the get tenant bucket quota operation
+	AdminAPIGetTenantBucketQuotaHandler admin_api.GetTenantBucketQuotaHandler
+	// AdminAPIGetTenantBucketReplicationHandler sets the operation handler for the get tenant bucket replication operation
+	AdminAPIGetTenantBucketReplicationHandler admin_api.GetTenantBucketReplicationHandler
 	// AdminAPIListAllTenantsHandler sets the operation handler for the list all tenants operation
 	AdminAPIListAllTenantsHandler admin_api.ListAllTenantsHandler
+	// AdminAPIListManagedCertificatesHandler sets the operation handler for the list managed certificates operation
+	AdminAPIListManagedCertificatesHandler admin_api.ListManagedCertificatesHandler
+	// AdminAPIListTenantBucketObjectsHandler sets the operation handler for the list tenant bucket objects operation
+	AdminAPIListTenantBucketObjectsHandler admin_api.ListTenantBucketObjectsHandler
+	// AdminAPIListTenantBucketQuotasHandler sets the operation handler for the list tenant bucket quotas operation
+	AdminAPIListTenantBucketQuotasHandler admin_api.ListTenantBucketQuotasHandler
+	// AdminAPIListTenantBucketsHandler sets the operation handler for the list tenant buckets operation
+	AdminAPIListTenantBucketsHandler admin_api.ListTenantBucketsHandler
+	// AdminAPIListTenantGroupsHandler sets the operation handler for the list tenant groups operation
+	AdminAPIListTenantGroupsHandler admin_api.ListTenantGroupsHandler
+	// AdminAPIListTenantJobsHandler sets the operation handler for the list tenant jobs operation
+	AdminAPIListTenantJobsHandler admin_api.ListTenantJobsHandler
+	// AdminAPIListTenantNotificationTargetsHandler sets the operation handler for the list tenant notification targets operation
+	AdminAPIListTenantNotificationTargetsHandler admin_api.ListTenantNotificationTargetsHandler
+	// AdminAPIListTenantPoliciesHandler sets the operation handler for the list tenant policies operation
+	AdminAPIListTenantPoliciesHandler admin_api.ListTenantPoliciesHandler
+	// AdminAPIListTenantServiceAccountsHandler sets the operation handler for the list tenant service accounts operation
+	AdminAPIListTenantServiceAccountsHandler admin_api.ListTenantServiceAccountsHandler
+	// AdminAPIListTenantUsersHandler sets the operation handler for the list tenant users operation
+	AdminAPIListTenantUsersHandler admin_api.ListTenantUsersHandler
 	// AdminAPIListTenantsHandler sets the operation handler for the list tenants operation
 	AdminAPIListTenantsHandler admin_api.ListTenantsHandler
+	// AdminAPIListWebhookDeliveriesHandler sets the operation handler for the list webhook deliveries operation
+	AdminAPIListWebhookDeliveriesHandler admin_api.ListWebhookDeliveriesHandler
+	// AdminAPIListWebhooksHandler sets the operation handler for the list webhooks operation
+	AdminAPIListWebhooksHandler admin_api.ListWebhooksHandler
+	// AdminAPIPresignTenantBucketObjectHandler sets the operation handler for the presign tenant bucket object operation
+	AdminAPIPresignTenantBucketObjectHandler admin_api.PresignTenantBucketObjectHandler
+	// AdminAPIRemoveTenantGroupHandler sets the operation handler for the remove tenant group operation
+	AdminAPIRemoveTenantGroupHandler admin_api.RemoveTenantGroupHandler
+	// AdminAPIRemoveTenantPolicyHandler sets the operation handler for the remove tenant policy operation
+	AdminAPIRemoveTenantPolicyHandler admin_api.RemoveTenantPolicyHandler
+	// AdminAPIRemoveTenantUserHandler sets the operation handler for the remove tenant user operation
+	AdminAPIRemoveTenantUserHandler admin_api.RemoveTenantUserHandler
+	// AdminAPISetTenantBucketLifecycleHandler sets the operation handler for the set tenant bucket lifecycle operation
+	AdminAPISetTenantBucketLifecycleHandler admin_api.SetTenantBucketLifecycleHandler
+	// AdminAPISetTenantBucketNotificationsHandler sets the operation handler for the set tenant bucket notifications operation
+	AdminAPISetTenantBucketNotificationsHandler admin_api.SetTenantBucketNotificationsHandler
+	// AdminAPISetTenantBucketObjectLockHandler sets the operation handler for the set tenant bucket object lock operation
+	AdminAPISetTenantBucketObjectLockHandler admin_api.SetTenantBucketObjectLockHandler
+	// AdminAPISetTenantBucketQuotaHandler sets the operation handler for the set tenant bucket quota operation
+	AdminAPISetTenantBucketQuotaHandler admin_api.SetTenantBucketQuotaHandler
+	// AdminAPISetTenantBucketReplicationHandler sets the operation handler for the set tenant bucket replication operation
+	AdminAPISetTenantBucketReplicationHandler admin_api.SetTenantBucketReplicationHandler
+	// AdminAPISetTenantBucketVersioningHandler sets the operation handler for the set tenant bucket versioning operation
+	AdminAPISetTenantBucketVersioningHandler admin_api.SetTenantBucketVersioningHandler
+	// AdminAPISetTenantGroupPolicyHandler sets the operation handler for the set tenant group policy operation
+	AdminAPISetTenantGroupPolicyHandler admin_api.SetTenantGroupPolicyHandler
+	// AdminAPISetTenantGroupStatusHandler sets the operation handler for the set tenant group status operation
+	AdminAPISetTenantGroupStatusHandler admin_api.SetTenantGroupStatusHandler
+	// AdminAPISetTenantUserPolicyHandler sets the operation handler for the set tenant user policy operation
+	AdminAPISetTenantUserPolicyHandler admin_api.SetTenantUserPolicyHandler
+	// AdminAPISetTenantUserStatusHandler sets the operation handler for the set tenant user status operation
+	AdminAPISetTenantUserStatusHandler admin_api.SetTenantUserStatusHandler
+	// AdminAPITenantBucketInfoHandler sets the operation handler for the tenant bucket info operation
+	AdminAPITenantBucketInfoHandler admin_api.TenantBucketInfoHandler
+	// AdminAPITenantBucketObjectInfoHandler sets the operation handler for the tenant bucket object info operation
+	AdminAPITenantBucketObjectInfoHandler admin_api.TenantBucketObjectInfoHandler
+	// AdminAPITenantGroupInfoHandler sets the operation handler for the tenant group info operation
+	AdminAPITenantGroupInfoHandler admin_api.TenantGroupInfoHandler
 	// AdminAPITenantInfoHandler sets the operation handler for the tenant info operation
 	AdminAPITenantInfoHandler admin_api.TenantInfoHandler
+	// AdminAPITenantJobInfoHandler sets the operation handler for the tenant job info operation
+	AdminAPITenantJobInfoHandler admin_api.TenantJobInfoHandler
+	// AdminAPITenantJobLogsHandler sets the operation handler for the tenant job logs operation
+	AdminAPITenantJobLogsHandler admin_api.TenantJobLogsHandler
+	// AdminAPITenantServerInfoHandler sets the operation handler for the tenant server info operation
+	AdminAPITenantServerInfoHandler admin_api.TenantServerInfoHandler
+	// AdminAPITenantUsageHandler sets the operation handler for the tenant usage operation
+	AdminAPITenantUsageHandler admin_api.TenantUsageHandler
+	// AdminAPITenantUserInfoHandler sets the operation handler for the tenant user info operation
+	AdminAPITenantUserInfoHandler admin_api.TenantUserInfoHandler
 	// AdminAPIUpdateTenantHandler sets the operation handler for the update tenant operation
 	AdminAPIUpdateTenantHandler admin_api.UpdateTenantHandler
+	// AdminAPIUpdateTenantBucketLifecycleRuleHandler sets the operation handler for the update tenant bucket lifecycle rule operation
+	AdminAPIUpdateTenantBucketLifecycleRuleHandler admin_api.UpdateTenantBucketLifecycleRuleHandler
+	// AdminAPIUpdateTenantGroupMembersHandler sets the operation handler for the update tenant group members operation
+	AdminAPIUpdateTenantGroupMembersHandler admin_api.UpdateTenantGroupMembersHandler
+	// AdminAPIUpdateTenantNotificationTargetHandler sets the operation handler for the update tenant notification target operation
+	AdminAPIUpdateTenantNotificationTargetHandler admin_api.UpdateTenantNotificationTargetHandler
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
 	ServeError func(http.ResponseWriter, *http.Request, error)
@@ -212,27 +517,210 @@ func (o *M3API) Validate() error {
 		unregistered = append(unregistered, "KeyAuth")
 	}
 
+	if o.AdminAPIAddTenantBucketLifecycleRuleHandler == nil {
+		unregistered = append(unregistered, "admin_api.AddTenantBucketLifecycleRuleHandler")
+	}
+	if o.AdminAPIAddTenantPolicyHandler == nil {
+		unregistered = append(unregistered, "admin_api.AddTenantPolicyHandler")
+	}
+	if o.AdminAPIAddTenantUserHandler == nil {
+		unregistered = append(unregistered, "admin_api.AddTenantUserHandler")
+	}
+	if o.AdminAPIClearTenantBucketQuotaHandler == nil {
+		unregistered = append(unregistered, "admin_api.ClearTenantBucketQuotaHandler")
+	}
+	if o.AdminAPIClusterUsageHandler == nil {
+		unregistered = append(unregistered, "admin_api.ClusterUsageHandler")
+	}
 	if o.AdminAPICreateTenantHandler == nil {
 		unregistered = append(unregistered, "admin_api.CreateTenantHandler")
 	}
+	if o.AdminAPICreateTenantBucketHandler == nil {
+		unregistered = append(unregistered, "admin_api.CreateTenantBucketHandler")
+	}
+	if o.AdminAPICreateTenantGroupHandler == nil {
+		unregistered = append(unregistered, "admin_api.CreateTenantGroupHandler")
+	}
+	if o.AdminAPICreateTenantJobHandler == nil {
+		unregistered = append(unregistered, "admin_api.CreateTenantJobHandler")
+	}
+	if o.AdminAPICreateTenantNotificationTargetHandler == nil {
+		unregistered = append(unregistered, "admin_api.CreateTenantNotificationTargetHandler")
+	}
+	if o.AdminAPICreateTenantServiceAccountHandler == nil {
+		unregistered = append(unregistered, "admin_api.CreateTenantServiceAccountHandler")
+	}
+	if o.AdminAPICreateWebhookHandler == nil {
+		unregistered = append(unregistered, "admin_api.CreateWebhookHandler")
+	}
 	if o.AdminAPIDeleteTenantHandler == nil {
 		unregistered = append(unregistered, "admin_api.DeleteTenantHandler")
 	}
+	if o.AdminAPIDeleteTenantBucketHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteTenantBucketHandler")
+	}
+	if o.AdminAPIDeleteTenantBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteTenantBucketLifecycleHandler")
+	}
+	if o.AdminAPIDeleteTenantBucketLifecycleRuleHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteTenantBucketLifecycleRuleHandler")
+	}
+	if o.AdminAPIDeleteTenantBucketNotificationsHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteTenantBucketNotificationsHandler")
+	}
+	if o.AdminAPIDeleteTenantBucketReplicationHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteTenantBucketReplicationHandler")
+	}
+	if o.AdminAPIDeleteTenantJobHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteTenantJobHandler")
+	}
+	if o.AdminAPIDeleteTenantNotificationTargetHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteTenantNotificationTargetHandler")
+	}
+	if o.AdminAPIDeleteTenantServiceAccountHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteTenantServiceAccountHandler")
+	}
+	if o.AdminAPIDeleteWebhookHandler == nil {
+		unregistered = append(unregistered, "admin_api.DeleteWebhookHandler")
+	}
 	if o.AdminAPIGetResourceQuotaHandler == nil {
 		unregistered = append(unregistered, "admin_api.GetResourceQuotaHandler")
 	}
+	if o.AdminAPIGetTenantBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "admin_api.GetTenantBucketLifecycleHandler")
+	}
+	if o.AdminAPIGetTenantBucketNotificationsHandler == nil {
+		unregistered = append(unregistered, "admin_api.GetTenantBucketNotificationsHandler")
+	}
+	if o.AdminAPIGetTenantBucketQuotaHandler == nil {
+		unregistered = append(unregistered, "admin_api.GetTenantBucketQuotaHandler")
+	}
+	if o.AdminAPIGetTenantBucketReplicationHandler == nil {
+		unregistered = append(unregistered, "admin_api.GetTenantBucketReplicationHandler")
+	}
 	if o.AdminAPIListAllTenantsHandler == nil {
 		unregistered = append(unregistered, "admin_api.ListAllTenantsHandler")
 	}
+	if o.AdminAPIListManagedCertificatesHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListManagedCertificatesHandler")
+	}
+	if o.AdminAPIListTenantBucketObjectsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListTenantBucketObjectsHandler")
+	}
+	if o.AdminAPIListTenantBucketQuotasHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListTenantBucketQuotasHandler")
+	}
+	if o.AdminAPIListTenantBucketsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListTenantBucketsHandler")
+	}
+	if o.AdminAPIListTenantGroupsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListTenantGroupsHandler")
+	}
+	if o.AdminAPIListTenantJobsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListTenantJobsHandler")
+	}
+	if o.AdminAPIListTenantNotificationTargetsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListTenantNotificationTargetsHandler")
+	}
+	if o.AdminAPIListTenantPoliciesHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListTenantPoliciesHandler")
+	}
+	if o.AdminAPIListTenantServiceAccountsHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListTenantServiceAccountsHandler")
+	}
+	if o.AdminAPIListTenantUsersHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListTenantUsersHandler")
+	}
 	if o.AdminAPIListTenantsHandler == nil {
 		unregistered = append(unregistered, "admin_api.ListTenantsHandler")
 	}
+	if o.AdminAPIListWebhookDeliveriesHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListWebhookDeliveriesHandler")
+	}
+	if o.AdminAPIListWebhooksHandler == nil {
+		unregistered = append(unregistered, "admin_api.ListWebhooksHandler")
+	}
+	if o.AdminAPIPresignTenantBucketObjectHandler == nil {
+		unregistered = append(unregistered, "admin_api.PresignTenantBucketObjectHandler")
+	}
+	if o.AdminAPIRemoveTenantGroupHandler == nil {
+		unregistered = append(unregistered, "admin_api.RemoveTenantGroupHandler")
+	}
+	if o.AdminAPIRemoveTenantPolicyHandler == nil {
+		unregistered = append(unregistered, "admin_api.RemoveTenantPolicyHandler")
+	}
+	if o.AdminAPIRemoveTenantUserHandler == nil {
+		unregistered = append(unregistered, "admin_api.RemoveTenantUserHandler")
+	}
+	if o.AdminAPISetTenantBucketLifecycleHandler == nil {
+		unregistered = append(unregistered, "admin_api.SetTenantBucketLifecycleHandler")
+	}
+	if o.AdminAPISetTenantBucketNotificationsHandler == nil {
+		unregistered = append(unregistered, "admin_api.SetTenantBucketNotificationsHandler")
+	}
+	if o.AdminAPISetTenantBucketObjectLockHandler == nil {
+		unregistered = append(unregistered, "admin_api.SetTenantBucketObjectLockHandler")
+	}
+	if o.AdminAPISetTenantBucketQuotaHandler == nil {
+		unregistered = append(unregistered, "admin_api.SetTenantBucketQuotaHandler")
+	}
+	if o.AdminAPISetTenantBucketReplicationHandler == nil {
+		unregistered = append(unregistered, "admin_api.SetTenantBucketReplicationHandler")
+	}
+	if o.AdminAPISetTenantBucketVersioningHandler == nil {
+		unregistered = append(unregistered, "admin_api.SetTenantBucketVersioningHandler")
+	}
+	if o.AdminAPISetTenantGroupPolicyHandler == nil {
+		unregistered = append(unregistered, "admin_api.SetTenantGroupPolicyHandler")
+	}
+	if o.AdminAPISetTenantGroupStatusHandler == nil {
+		unregistered = append(unregistered, "admin_api.SetTenantGroupStatusHandler")
+	}
+	if o.AdminAPISetTenantUserPolicyHandler == nil {
+		unregistered = append(unregistered, "admin_api.SetTenantUserPolicyHandler")
+	}
+	if o.AdminAPISetTenantUserStatusHandler == nil {
+		unregistered = append(unregistered, "admin_api.SetTenantUserStatusHandler")
+	}
+	if o.AdminAPITenantBucketInfoHandler == nil {
+		unregistered = append(unregistered, "admin_api.TenantBucketInfoHandler")
+	}
+	if o.AdminAPITenantBucketObjectInfoHandler == nil {
+		unregistered = append(unregistered, "admin_api.TenantBucketObjectInfoHandler")
+	}
+	if o.AdminAPITenantGroupInfoHandler == nil {
+		unregistered = append(unregistered, "admin_api.TenantGroupInfoHandler")
+	}
 	if o.AdminAPITenantInfoHandler == nil {
 		unregistered = append(unregistered, "admin_api.TenantInfoHandler")
 	}
+	if o.AdminAPITenantJobInfoHandler == nil {
+		unregistered = append(unregistered, "admin_api.TenantJobInfoHandler")
+	}
+	if o.AdminAPITenantJobLogsHandler == nil {
+		unregistered = append(unregistered, "admin_api.TenantJobLogsHandler")
+	}
+	if o.AdminAPITenantServerInfoHandler == nil {
+		unregistered = append(unregistered, "admin_api.TenantServerInfoHandler")
+	}
+	if o.AdminAPITenantUsageHandler == nil {
+		unregistered = append(unregistered, "admin_api.TenantUsageHandler")
+	}
+	if o.AdminAPITenantUserInfoHandler == nil {
+		unregistered = append(unregistered, "admin_api.TenantUserInfoHandler")
+	}
 	if o.AdminAPIUpdateTenantHandler == nil {
 		unregistered = append(unregistered, "admin_api.UpdateTenantHandler")
 	}
+	if o.AdminAPIUpdateTenantBucketLifecycleRuleHandler == nil {
+		unregistered = append(unregistered, "admin_api.UpdateTenantBucketLifecycleRuleHandler")
+	}
+	if o.AdminAPIUpdateTenantGroupMembersHandler == nil {
+		unregistered = append(unregistered, "admin_api.UpdateTenantGroupMembersHandler")
+	}
+	if o.AdminAPIUpdateTenantNotificationTargetHandler == nil {
+		unregistered = append(unregistered, "admin_api.UpdateTenantNotificationTargetHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -331,14 +819,94 @@ func (o *M3API) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/lifecycle/rules"] = admin_api.NewAddTenantBucketLifecycleRule(o.context, o.AdminAPIAddTenantBucketLifecycleRuleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/namespaces/{namespace}/tenants/{tenant}/policies"] = admin_api.NewAddTenantPolicy(o.context, o.AdminAPIAddTenantPolicyHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/namespaces/{namespace}/tenants/{tenant}/users"] = admin_api.NewAddTenantUser(o.context, o.AdminAPIAddTenantUserHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/quota"] = admin_api.NewClearTenantBucketQuota(o.context, o.AdminAPIClearTenantBucketQuotaHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/usage"] = admin_api.NewClusterUsage(o.context, o.AdminAPIClusterUsageHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/tenants"] = admin_api.NewCreateTenant(o.context, o.AdminAPICreateTenantHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/namespaces/{namespace}/tenants/{tenant}/buckets"] = admin_api.NewCreateTenantBucket(o.context, o.AdminAPICreateTenantBucketHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/namespaces/{namespace}/tenants/{tenant}/groups"] = admin_api.NewCreateTenantGroup(o.context, o.AdminAPICreateTenantGroupHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/namespaces/{namespace}/tenants/{tenant}/jobs"] = admin_api.NewCreateTenantJob(o.context, o.AdminAPICreateTenantJobHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/namespaces/{namespace}/tenants/{tenant}/notification-targets"] = admin_api.NewCreateTenantNotificationTarget(o.context, o.AdminAPICreateTenantNotificationTargetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/namespaces/{namespace}/tenants/{tenant}/service-accounts"] = admin_api.NewCreateTenantServiceAccount(o.context, o.AdminAPICreateTenantServiceAccountHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks"] = admin_api.NewCreateWebhook(o.context, o.AdminAPICreateWebhookHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}"] = admin_api.NewDeleteTenant(o.context, o.AdminAPIDeleteTenantHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}"] = admin_api.NewDeleteTenantBucket(o.context, o.AdminAPIDeleteTenantBucketHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/lifecycle"] = admin_api.NewDeleteTenantBucketLifecycle(o.context, o.AdminAPIDeleteTenantBucketLifecycleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/lifecycle/rules/{rule}"] = admin_api.NewDeleteTenantBucketLifecycleRule(o.context, o.AdminAPIDeleteTenantBucketLifecycleRuleHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/notifications"] = admin_api.NewDeleteTenantBucketNotifications(o.context, o.AdminAPIDeleteTenantBucketNotificationsHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/replication"] = admin_api.NewDeleteTenantBucketReplication(o.context, o.AdminAPIDeleteTenantBucketReplicationHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}/jobs/{job}"] = admin_api.NewDeleteTenantJob(o.context, o.AdminAPIDeleteTenantJobHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}/notification-targets/{type}/{id}"] = admin_api.NewDeleteTenantNotificationTarget(o.context, o.AdminAPIDeleteTenantNotificationTargetHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}/service-accounts/{access_key}"] = admin_api.NewDeleteTenantServiceAccount(o.context, o.AdminAPIDeleteTenantServiceAccountHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/webhooks/{name}"] = admin_api.NewDeleteWebhook(o.context, o.AdminAPIDeleteWebhookHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -346,19 +914,183 @@ func (o *M3API) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/lifecycle"] = admin_api.NewGetTenantBucketLifecycle(o.context, o.AdminAPIGetTenantBucketLifecycleHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/notifications"] = admin_api.NewGetTenantBucketNotifications(o.context, o.AdminAPIGetTenantBucketNotificationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/quota"] = admin_api.NewGetTenantBucketQuota(o.context, o.AdminAPIGetTenantBucketQuotaHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/replication"] = admin_api.NewGetTenantBucketReplication(o.context, o.AdminAPIGetTenantBucketReplicationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/tenants"] = admin_api.NewListAllTenants(o.context, o.AdminAPIListAllTenantsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/managed-certificates"] = admin_api.NewListManagedCertificates(o.context, o.AdminAPIListManagedCertificatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/objects"] = admin_api.NewListTenantBucketObjects(o.context, o.AdminAPIListTenantBucketObjectsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/quotas"] = admin_api.NewListTenantBucketQuotas(o.context, o.AdminAPIListTenantBucketQuotasHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/buckets"] = admin_api.NewListTenantBuckets(o.context, o.AdminAPIListTenantBucketsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/groups"] = admin_api.NewListTenantGroups(o.context, o.AdminAPIListTenantGroupsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/jobs"] = admin_api.NewListTenantJobs(o.context, o.AdminAPIListTenantJobsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/notification-targets"] = admin_api.NewListTenantNotificationTargets(o.context, o.AdminAPIListTenantNotificationTargetsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/policies"] = admin_api.NewListTenantPolicies(o.context, o.AdminAPIListTenantPoliciesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/service-accounts"] = admin_api.NewListTenantServiceAccounts(o.context, o.AdminAPIListTenantServiceAccountsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/users"] = admin_api.NewListTenantUsers(o.context, o.AdminAPIListTenantUsersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/namespaces/{namespace}/tenants"] = admin_api.NewListTenants(o.context, o.AdminAPIListTenantsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/webhooks/deliveries"] = admin_api.NewListWebhookDeliveries(o.context, o.AdminAPIListWebhookDeliveriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks"] = admin_api.NewListWebhooks(o.context, o.AdminAPIListWebhooksHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/objects/presign"] = admin_api.NewPresignTenantBucketObject(o.context, o.AdminAPIPresignTenantBucketObjectHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}/groups/{group}"] = admin_api.NewRemoveTenantGroup(o.context, o.AdminAPIRemoveTenantGroupHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}/policies/{policy}"] = admin_api.NewRemoveTenantPolicy(o.context, o.AdminAPIRemoveTenantPolicyHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/namespaces/{namespace}/tenants/{tenant}/users/{user}"] = admin_api.NewRemoveTenantUser(o.context, o.AdminAPIRemoveTenantUserHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/lifecycle"] = admin_api.NewSetTenantBucketLifecycle(o.context, o.AdminAPISetTenantBucketLifecycleHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/notifications"] = admin_api.NewSetTenantBucketNotifications(o.context, o.AdminAPISetTenantBucketNotificationsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/object-lock"] = admin_api.NewSetTenantBucketObjectLock(o.context, o.AdminAPISetTenantBucketObjectLockHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/quota"] = admin_api.NewSetTenantBucketQuota(o.context, o.AdminAPISetTenantBucketQuotaHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/replication"] = admin_api.NewSetTenantBucketReplication(o.context, o.AdminAPISetTenantBucketReplicationHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/versioning"] = admin_api.NewSetTenantBucketVersioning(o.context, o.AdminAPISetTenantBucketVersioningHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}/groups/{group}/policy"] = admin_api.NewSetTenantGroupPolicy(o.context, o.AdminAPISetTenantGroupPolicyHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}/groups/{group}/status"] = admin_api.NewSetTenantGroupStatus(o.context, o.AdminAPISetTenantGroupStatusHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}/users/{user}/policy"] = admin_api.NewSetTenantUserPolicy(o.context, o.AdminAPISetTenantUserPolicyHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}/users/{user}/status"] = admin_api.NewSetTenantUserStatus(o.context, o.AdminAPISetTenantUserStatusHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}"] = admin_api.NewTenantBucketInfo(o.context, o.AdminAPITenantBucketInfoHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/objects/metadata"] = admin_api.NewTenantBucketObjectInfo(o.context, o.AdminAPITenantBucketObjectInfoHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/groups/{group}"] = admin_api.NewTenantGroupInfo(o.context, o.AdminAPITenantGroupInfoHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}"] = admin_api.NewTenantInfo(o.context, o.AdminAPITenantInfoHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/jobs/{job}"] = admin_api.NewTenantJobInfo(o.context, o.AdminAPITenantJobInfoHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/jobs/{job}/logs"] = admin_api.NewTenantJobLogs(o.context, o.AdminAPITenantJobLogsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/servers"] = admin_api.NewTenantServerInfo(o.context, o.AdminAPITenantServerInfoHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/usage"] = admin_api.NewTenantUsage(o.context, o.AdminAPITenantUsageHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/namespaces/{namespace}/tenants/{tenant}/users/{user}"] = admin_api.NewTenantUserInfo(o.context, o.AdminAPITenantUserInfoHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}"] = admin_api.NewUpdateTenant(o.context, o.AdminAPIUpdateTenantHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}/buckets/{bucket}/lifecycle/rules/{rule}"] = admin_api.NewUpdateTenantBucketLifecycleRule(o.context, o.AdminAPIUpdateTenantBucketLifecycleRuleHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}/groups/{group}/members"] = admin_api.NewUpdateTenantGroupMembers(o.context, o.AdminAPIUpdateTenantGroupMembersHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/namespaces/{namespace}/tenants/{tenant}/notification-targets/{type}/{id}"] = admin_api.NewUpdateTenantNotificationTarget(o.context, o.AdminAPIUpdateTenantNotificationTargetHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
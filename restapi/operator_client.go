@@ -19,8 +19,10 @@ package restapi
 import (
 	"context"
 
+	"github.com/minio/m3/pkg/tracing"
 	v1 "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
 	operatorClientset "github.com/minio/minio-operator/pkg/client/clientset/versioned"
+	"go.opentelemetry.io/otel/attribute"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	types "k8s.io/apimachinery/pkg/types"
 )
@@ -44,21 +46,29 @@ type operatorClient struct {
 }
 
 // MinIOInstanceDelete implements the minio instance delete action from minio-operator
-func (c *operatorClient) MinIOInstanceDelete(ctx context.Context, namespace string, instanceName string, options metav1.DeleteOptions) error {
+func (c *operatorClient) MinIOInstanceDelete(ctx context.Context, namespace string, instanceName string, options metav1.DeleteOptions) (err error) {
+	ctx, span := tracing.Start(ctx, "OperatorClient.MinIOInstanceDelete", attribute.String("namespace", namespace), attribute.String("tenant", instanceName))
+	defer func() { tracing.End(span, err) }()
 	return c.client.OperatorV1().MinIOInstances(namespace).Delete(ctx, instanceName, options)
 }
 
 // MinIOInstanceGet implements the minio instance get action from minio-operator
-func (c *operatorClient) MinIOInstanceGet(ctx context.Context, namespace string, instanceName string, options metav1.GetOptions) (*v1.MinIOInstance, error) {
+func (c *operatorClient) MinIOInstanceGet(ctx context.Context, namespace string, instanceName string, options metav1.GetOptions) (_ *v1.MinIOInstance, err error) {
+	ctx, span := tracing.Start(ctx, "OperatorClient.MinIOInstanceGet", attribute.String("namespace", namespace), attribute.String("tenant", instanceName))
+	defer func() { tracing.End(span, err) }()
 	return c.client.OperatorV1().MinIOInstances(namespace).Get(ctx, instanceName, options)
 }
 
 // MinIOInstancePatch implements the minio instance patch action from minio-operator
-func (c *operatorClient) MinIOInstancePatch(ctx context.Context, namespace string, instanceName string, pt types.PatchType, data []byte, options metav1.PatchOptions) (*v1.MinIOInstance, error) {
+func (c *operatorClient) MinIOInstancePatch(ctx context.Context, namespace string, instanceName string, pt types.PatchType, data []byte, options metav1.PatchOptions) (_ *v1.MinIOInstance, err error) {
+	ctx, span := tracing.Start(ctx, "OperatorClient.MinIOInstancePatch", attribute.String("namespace", namespace), attribute.String("tenant", instanceName))
+	defer func() { tracing.End(span, err) }()
 	return c.client.OperatorV1().MinIOInstances(namespace).Patch(ctx, instanceName, pt, data, options)
 }
 
 // MinIOInstanceList implements the minio instance list action from minio-operator
-func (c *operatorClient) MinIOInstanceList(ctx context.Context, namespace string, opts metav1.ListOptions) (*v1.MinIOInstanceList, error) {
+func (c *operatorClient) MinIOInstanceList(ctx context.Context, namespace string, opts metav1.ListOptions) (_ *v1.MinIOInstanceList, err error) {
+	ctx, span := tracing.Start(ctx, "OperatorClient.MinIOInstanceList", attribute.String("namespace", namespace))
+	defer func() { tracing.End(span, err) }()
 	return c.client.OperatorV1().MinIOInstances(namespace).List(ctx, opts)
 }
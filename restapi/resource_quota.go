@@ -18,12 +18,11 @@ package restapi
 
 import (
 	"context"
-	"log"
 
 	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/pkg/logger"
 
 	"github.com/go-openapi/runtime/middleware"
-	"github.com/go-openapi/swag"
 	"github.com/minio/m3/models"
 	"github.com/minio/m3/restapi/operations"
 	"github.com/minio/m3/restapi/operations/admin_api"
@@ -36,7 +35,8 @@ func registerResourceQuotaHandlers(api *operations.M3API) {
 		sessionID := string(*principal)
 		resp, err := getResourceQuotaResponse(sessionID, params)
 		if err != nil {
-			return admin_api.NewGetResourceQuotaDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+			apiErr := prepareError(params.HTTPRequest.Context(), err)
+			return admin_api.NewGetResourceQuotaDefault(int(apiErr.Code)).WithPayload(apiErr)
 		}
 		return admin_api.NewGetResourceQuotaOK().WithPayload(resp)
 
@@ -70,10 +70,10 @@ func getResourceQuota(ctx context.Context, client K8sClient, namespace, resource
 }
 
 func getResourceQuotaResponse(token string, params admin_api.GetResourceQuotaParams) (*models.ResourceQuota, error) {
-	ctx := context.Background()
+	ctx := params.HTTPRequest.Context()
 	client, err := cluster.K8sClient(token)
 	if err != nil {
-		log.Println("error getting k8sClient:", err)
+		logger.FromContext(ctx).WithError(err).Error("error getting k8sClient")
 		return nil, err
 	}
 	k8sClient := &k8sClient{
@@ -81,7 +81,7 @@ func getResourceQuotaResponse(token string, params admin_api.GetResourceQuotaPar
 	}
 	resourceQuota, err := getResourceQuota(ctx, k8sClient, params.Namespace, params.ResourceQuotaName)
 	if err != nil {
-		log.Println("error getting resource quota:", err)
+		logger.FromContext(ctx).WithError(err).Error("error getting resource quota")
 		return nil, err
 
 	}
@@ -0,0 +1,333 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"crypto/md5"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	minio "github.com/minio/minio-go/v6"
+)
+
+// s3StubBucket is a bucket stored by the s3 stub
+type s3StubBucket struct {
+	created      time.Time
+	versioning   string
+	objectLock   bool
+	retention    []byte
+	lifecycle    []byte
+	notification []byte
+	objects      int
+	contents     map[string]*s3StubObject
+}
+
+// s3StubObject is an object stored by the s3 stub, only its metadata is kept
+type s3StubObject struct {
+	size         int64
+	modified     time.Time
+	contentType  string
+	userMetadata map[string]string
+}
+
+// s3Stub is a minimal path-style s3 server that implements the bucket api calls done by m3, it's used to test
+// the management of buckets without a running MinIO
+type s3Stub struct {
+	mu      sync.Mutex
+	buckets map[string]*s3StubBucket
+	// failPut is the bucket subresource, ie: versioning, whose updates fail
+	failPut string
+}
+
+type s3StubError struct {
+	XMLName    xml.Name `xml:"Error"`
+	Code       string   `xml:"Code"`
+	Message    string   `xml:"Message"`
+	BucketName string   `xml:"BucketName"`
+}
+
+type s3StubBucketList struct {
+	XMLName xml.Name `xml:"ListAllMyBucketsResult"`
+	Buckets struct {
+		Bucket []struct {
+			Name         string    `xml:"Name"`
+			CreationDate time.Time `xml:"CreationDate"`
+		} `xml:"Bucket"`
+	} `xml:"Buckets"`
+}
+
+type s3StubListV2 struct {
+	XMLName               xml.Name `xml:"ListBucketResult"`
+	Name                  string   `xml:"Name"`
+	Prefix                string   `xml:"Prefix"`
+	Delimiter             string   `xml:"Delimiter,omitempty"`
+	MaxKeys               int      `xml:"MaxKeys"`
+	IsTruncated           bool     `xml:"IsTruncated"`
+	NextContinuationToken string   `xml:"NextContinuationToken,omitempty"`
+	Contents              []struct {
+		Key          string    `xml:"Key"`
+		LastModified time.Time `xml:"LastModified"`
+		ETag         string    `xml:"ETag"`
+		Size         int64     `xml:"Size"`
+		StorageClass string    `xml:"StorageClass"`
+	} `xml:"Contents"`
+	CommonPrefixes []struct {
+		Prefix string `xml:"Prefix"`
+	} `xml:"CommonPrefixes"`
+}
+
+type s3StubVersioning struct {
+	XMLName xml.Name `xml:"VersioningConfiguration"`
+	Status  string   `xml:"Status,omitempty"`
+}
+
+// newS3Stub starts the stub and returns a client of it, the server is closed when the test finishes
+func newS3Stub(t *testing.T) (*s3Stub, *minioClient) {
+	stub := &s3Stub{buckets: map[string]*s3StubBucket{}}
+	server := httptest.NewServer(stub)
+	t.Cleanup(server.Close)
+	// setting the region avoids the bucket location lookups
+	client, err := minio.NewWithRegion(strings.TrimPrefix(server.URL, "http://"), "accesskey", "secretkey", false, "us-east-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return stub, &minioClient{client: client}
+}
+
+func (s *s3Stub) writeError(w http.ResponseWriter, status int, code, message, bucket string) {
+	w.Header().Set("Content-Type", "application/xml")
+	w.WriteHeader(status)
+	_ = xml.NewEncoder(w).Encode(s3StubError{Code: code, Message: message, BucketName: bucket})
+}
+
+func (s *s3Stub) writeXML(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/xml")
+	_ = xml.NewEncoder(w).Encode(v)
+}
+
+func (s *s3Stub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	name := strings.TrimPrefix(r.URL.Path, "/")
+	var key string
+	if i := strings.Index(name, "/"); i >= 0 {
+		name, key = name[:i], name[i+1:]
+	}
+	query := r.URL.Query()
+	if name == "" {
+		var list s3StubBucketList
+		for bucketName, bucket := range s.buckets {
+			list.Buckets.Bucket = append(list.Buckets.Bucket, struct {
+				Name         string    `xml:"Name"`
+				CreationDate time.Time `xml:"CreationDate"`
+			}{Name: bucketName, CreationDate: bucket.created})
+		}
+		s.writeXML(w, list)
+		return
+	}
+	bucket, exists := s.buckets[name]
+	if r.Method == http.MethodPut && len(query) == 0 {
+		if exists {
+			s.writeError(w, http.StatusConflict, "BucketAlreadyOwnedByYou", "Your previous request to create the named bucket succeeded and you already own it.", name)
+			return
+		}
+		bucket = &s3StubBucket{created: time.Now().UTC().Truncate(time.Second)}
+		if r.Header.Get("x-amz-bucket-object-lock-enabled") == "true" {
+			bucket.objectLock = true
+			bucket.versioning = "Enabled"
+		}
+		s.buckets[name] = bucket
+		return
+	}
+	if !exists {
+		s.writeError(w, http.StatusNotFound, "NoSuchBucket", "The specified bucket does not exist", name)
+		return
+	}
+	if key != "" {
+		s.serveObject(w, r, bucket, name, key)
+		return
+	}
+
+	_, versioning := query["versioning"]
+	_, objectLock := query["object-lock"]
+	_, lifecycle := query["lifecycle"]
+	_, notification := query["notification"]
+	if _, fail := query[s.failPut]; fail && r.Method == http.MethodPut {
+		s.writeError(w, http.StatusBadRequest, "MalformedXML", "The XML you provided was not well-formed.", name)
+		return
+	}
+	switch {
+	case r.Method == http.MethodGet && notification:
+		w.Header().Set("Content-Type", "application/xml")
+		if bucket.notification == nil {
+			_, _ = w.Write([]byte(`<NotificationConfiguration></NotificationConfiguration>`))
+			return
+		}
+		_, _ = w.Write(bucket.notification)
+	case r.Method == http.MethodPut && notification:
+		bucket.notification, _ = ioutil.ReadAll(r.Body)
+	case r.Method == http.MethodGet && lifecycle:
+		if bucket.lifecycle == nil {
+			s.writeError(w, http.StatusNotFound, "NoSuchLifecycleConfiguration", "The lifecycle configuration does not exist", name)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		_, _ = w.Write(bucket.lifecycle)
+	case r.Method == http.MethodPut && lifecycle:
+		bucket.lifecycle, _ = ioutil.ReadAll(r.Body)
+	case r.Method == http.MethodDelete && lifecycle:
+		bucket.lifecycle = nil
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && query.Get("list-type") == "2":
+		s.listObjects(w, bucket, name, query.Get("prefix"), query.Get("delimiter"), query.Get("continuation-token"), query.Get("max-keys"))
+	case r.Method == http.MethodDelete:
+		if bucket.objects > 0 || len(bucket.contents) > 0 {
+			s.writeError(w, http.StatusConflict, "BucketNotEmpty", "The bucket you tried to delete is not empty", name)
+			return
+		}
+		delete(s.buckets, name)
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodGet && versioning:
+		s.writeXML(w, s3StubVersioning{Status: bucket.versioning})
+	case r.Method == http.MethodPut && versioning:
+		var config s3StubVersioning
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := xml.Unmarshal(body, &config); err != nil {
+			s.writeError(w, http.StatusBadRequest, "MalformedXML", err.Error(), name)
+			return
+		}
+		if bucket.objectLock && config.Status != "Enabled" {
+			s.writeError(w, http.StatusConflict, "InvalidBucketState", "An Object Lock configuration is present on this bucket, so the versioning state cannot be changed.", name)
+			return
+		}
+		bucket.versioning = config.Status
+	case r.Method == http.MethodGet && objectLock:
+		if !bucket.objectLock {
+			s.writeError(w, http.StatusNotFound, objectLockNotFound, "Object Lock configuration does not exist for this bucket", name)
+			return
+		}
+		w.Header().Set("Content-Type", "application/xml")
+		if bucket.retention != nil {
+			_, _ = w.Write(bucket.retention)
+			return
+		}
+		_, _ = w.Write([]byte(`<ObjectLockConfiguration><ObjectLockEnabled>Enabled</ObjectLockEnabled></ObjectLockConfiguration>`))
+	case r.Method == http.MethodPut && objectLock:
+		if !bucket.objectLock {
+			s.writeError(w, http.StatusConflict, "InvalidBucketState", "Object Lock configuration cannot be enabled on existing buckets", name)
+			return
+		}
+		bucket.retention, _ = ioutil.ReadAll(r.Body)
+	case r.Method == http.MethodHead:
+	default:
+		s.writeError(w, http.StatusNotImplemented, "NotImplemented", "A header you provided implies functionality that is not implemented", name)
+	}
+}
+
+// serveObject implements the stat of the objects
+func (s *s3Stub) serveObject(w http.ResponseWriter, r *http.Request, bucket *s3StubBucket, name, key string) {
+	object, exists := bucket.contents[key]
+	if r.Method != http.MethodHead {
+		s.writeError(w, http.StatusNotImplemented, "NotImplemented", "A header you provided implies functionality that is not implemented", name)
+		return
+	}
+	if !exists {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Length", strconv.FormatInt(object.size, 10))
+	w.Header().Set("Last-Modified", object.modified.Format(http.TimeFormat))
+	w.Header().Set("ETag", `"`+s3StubETag(key)+`"`)
+	w.Header().Set("Content-Type", object.contentType)
+	for metaKey, value := range object.userMetadata {
+		w.Header().Set("X-Amz-Meta-"+metaKey, value)
+	}
+}
+
+// listObjects implements the v2 listing, the continuation token is the last key returned
+func (s *s3Stub) listObjects(w http.ResponseWriter, bucket *s3StubBucket, name, prefix, delimiter, token, maxKeys string) {
+	list := s3StubListV2{Name: name, Prefix: prefix, Delimiter: delimiter, MaxKeys: 1000}
+	if maxKeys != "" {
+		list.MaxKeys, _ = strconv.Atoi(maxKeys)
+	}
+	var keys []string
+	for key := range bucket.contents {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	seenPrefixes := map[string]bool{}
+	count := 0
+	for _, key := range keys {
+		// the keys grouped on the prefix returned last were already listed
+		listed := delimiter != "" && strings.HasSuffix(token, delimiter) && strings.HasPrefix(key, token)
+		if !strings.HasPrefix(key, prefix) || key <= token || listed {
+			continue
+		}
+		if delimiter != "" {
+			if i := strings.Index(key[len(prefix):], delimiter); i >= 0 {
+				commonPrefix := key[:len(prefix)+i+len(delimiter)]
+				if seenPrefixes[commonPrefix] || commonPrefix <= token {
+					continue
+				}
+				if count == list.MaxKeys {
+					list.IsTruncated = true
+					break
+				}
+				seenPrefixes[commonPrefix] = true
+				list.CommonPrefixes = append(list.CommonPrefixes, struct {
+					Prefix string `xml:"Prefix"`
+				}{Prefix: commonPrefix})
+				list.NextContinuationToken = commonPrefix
+				count++
+				continue
+			}
+		}
+		if count == list.MaxKeys {
+			list.IsTruncated = true
+			break
+		}
+		object := bucket.contents[key]
+		list.Contents = append(list.Contents, struct {
+			Key          string    `xml:"Key"`
+			LastModified time.Time `xml:"LastModified"`
+			ETag         string    `xml:"ETag"`
+			Size         int64     `xml:"Size"`
+			StorageClass string    `xml:"StorageClass"`
+		}{Key: key, LastModified: object.modified, ETag: `"` + s3StubETag(key) + `"`, Size: object.size, StorageClass: "STANDARD"})
+		list.NextContinuationToken = key
+		count++
+	}
+	if !list.IsTruncated {
+		list.NextContinuationToken = ""
+	}
+	s.writeXML(w, list)
+}
+
+// s3StubETag returns a stable etag of the object
+func s3StubETag(key string) string {
+	return fmt.Sprintf("%x", md5.Sum([]byte(key)))
+}
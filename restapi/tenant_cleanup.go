@@ -0,0 +1,94 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/minio/m3/models"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+// Names of the resources created by m3 for a tenant besides the MinIOInstance
+
+func tenantSecretName(tenant string) string {
+	return fmt.Sprintf("%s-secret", tenant)
+}
+
+func tenantMCSSecretName(tenant string) string {
+	return fmt.Sprintf("%s-mcs-secret", tenant)
+}
+
+func tenantNodePortName(tenant string) string {
+	return fmt.Sprintf("%s-np", tenant)
+}
+
+func tenantMCSNodePortName(tenant string) string {
+	return fmt.Sprintf("%s-mcs-np", tenant)
+}
+
+func tenantLoadBalancerName(tenant string) string {
+	return fmt.Sprintf("%s-lb", tenant)
+}
+
+func tenantMCSLoadBalancerName(tenant string) string {
+	return fmt.Sprintf("%s-mcs-lb", tenant)
+}
+
+func tenantHTTPRouteName(tenant string) string {
+	return fmt.Sprintf("%s-route", tenant)
+}
+
+func tenantMCSHTTPRouteName(tenant string) string {
+	return fmt.Sprintf("%s-mcs-route", tenant)
+}
+
+func tenantManagedCertificateName(tenant string) string {
+	return fmt.Sprintf("%s-cert", tenant)
+}
+
+// tenantCertificateName is the name of the cert-manager Certificate and of the Secret where it's stored
+func tenantCertificateName(tenant string) string {
+	return fmt.Sprintf("%s-tls", tenant)
+}
+
+// deleteTenantResources removes the secrets m3 created for a tenant, the resources created by the integrations
+// are removed by their own hooks. Secrets that don't exist are skipped so it's safe to retry it, the removed
+// secrets are returned even if some of them couldn't be removed
+func deleteTenantResources(ctx context.Context, client K8sClient, namespace, tenant string) ([]*models.RemovedResource, error) {
+	var removed []*models.RemovedResource
+	var errs []error
+	for _, name := range []string{tenantSecretName(tenant), tenantMCSSecretName(tenant)} {
+		removed, errs = trackRemoved(removed, errs, "Secret", name, client.deleteSecret(ctx, namespace, name, metav1.DeleteOptions{}))
+	}
+	return removed, utilerrors.NewAggregate(errs)
+}
+
+// trackRemoved adds the resource to the removed ones if it was deleted or the error if it couldn't be deleted,
+// resources not found are ignored
+func trackRemoved(removed []*models.RemovedResource, errs []error, kind, name string, err error) ([]*models.RemovedResource, []error) {
+	switch {
+	case err == nil:
+		removed = append(removed, &models.RemovedResource{Kind: kind, Name: name})
+	case !apierrors.IsNotFound(err):
+		errs = append(errs, fmt.Errorf("error deleting %s %s: %v", kind, name, err))
+	}
+	return removed, errs
+}
@@ -0,0 +1,123 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	extensionsBeta1 "k8s.io/api/extensions/v1beta1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+var k8sClientGetSecretMock func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Secret, error)
+var k8sClientCreateSecretMock func(ctx context.Context, namespace string, secret *corev1.Secret, opts metav1.CreateOptions) (*corev1.Secret, error)
+var k8sClientUpdateSecretMock func(ctx context.Context, namespace string, secret *corev1.Secret, opts metav1.UpdateOptions) (*corev1.Secret, error)
+var k8sClientDeleteSecretMock func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error
+var k8sClientDeleteServiceMock func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error
+var k8sClientGetIngressMock func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*extensionsBeta1.Ingress, error)
+var k8sClientUpdateIngressMock func(ctx context.Context, namespace string, ingress *extensionsBeta1.Ingress, opts metav1.UpdateOptions) (*extensionsBeta1.Ingress, error)
+
+// mock function of getSecret()
+func (c k8sClientMock) getSecret(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Secret, error) {
+	return k8sClientGetSecretMock(ctx, namespace, name, opts)
+}
+
+// mock function of createSecret()
+func (c k8sClientMock) createSecret(ctx context.Context, namespace string, secret *corev1.Secret, opts metav1.CreateOptions) (*corev1.Secret, error) {
+	return k8sClientCreateSecretMock(ctx, namespace, secret, opts)
+}
+
+// mock function of updateSecret()
+func (c k8sClientMock) updateSecret(ctx context.Context, namespace string, secret *corev1.Secret, opts metav1.UpdateOptions) (*corev1.Secret, error) {
+	return k8sClientUpdateSecretMock(ctx, namespace, secret, opts)
+}
+
+// mock function of deleteSecret()
+func (c k8sClientMock) deleteSecret(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+	return k8sClientDeleteSecretMock(ctx, namespace, name, opts)
+}
+
+// mock function of deleteService()
+func (c k8sClientMock) deleteService(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+	return k8sClientDeleteServiceMock(ctx, namespace, name, opts)
+}
+
+// mock function of getIngress()
+func (c k8sClientMock) getIngress(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*extensionsBeta1.Ingress, error) {
+	return k8sClientGetIngressMock(ctx, namespace, name, opts)
+}
+
+// mock function of updateIngress()
+func (c k8sClientMock) updateIngress(ctx context.Context, namespace string, ingress *extensionsBeta1.Ingress, opts metav1.UpdateOptions) (*extensionsBeta1.Ingress, error) {
+	return k8sClientUpdateIngressMock(ctx, namespace, ingress, opts)
+}
+
+// existingResources returns a delete mock that succeeds for the given names and returns NotFound for any other
+func existingResources(names ...string) func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+	return func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+		for _, n := range names {
+			if n == name {
+				return nil
+			}
+		}
+		return apierrors.NewNotFound(schema.GroupResource{}, name)
+	}
+}
+
+func Test_deleteTenantResources(t *testing.T) {
+	tests := []struct {
+		name        string
+		secrets     func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error
+		wantRemoved int
+		wantErr     bool
+	}{
+		{
+			name:        "Tenant with console",
+			secrets:     existingResources("tenant-1-secret", "tenant-1-mcs-secret"),
+			wantRemoved: 2,
+		},
+		{
+			name:        "Tenant without console",
+			secrets:     existingResources("tenant-1-secret"),
+			wantRemoved: 1,
+		},
+		{
+			name: "Error deleting a secret",
+			secrets: func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+				return apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, name, errors.New("not allowed"))
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k8sClientDeleteSecretMock = tt.secrets
+			removed, err := deleteTenantResources(context.Background(), k8sClientMock{}, "default", "tenant-1")
+			if (err != nil) != tt.wantErr {
+				t.Errorf("deleteTenantResources() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if len(removed) != tt.wantRemoved {
+				t.Errorf("deleteTenantResources() removed = %v, want %v", len(removed), tt.wantRemoved)
+			}
+		})
+	}
+}
@@ -0,0 +1,264 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/pkg/tracing"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+const (
+	minioLivenessPath     = "/minio/health/live"
+	minioClusterPath      = "/minio/health/cluster"
+	minioServerStatus     = "X-Minio-Server-Status"
+	minioWriteQuorum      = "X-Minio-Write-Quorum"
+	minioServerNotStarted = "server-not-initialized"
+	// tenantHealthProbeTimeout is the timeout of every request sent to the tenant
+	tenantHealthProbeTimeout = 3 * time.Second
+	// tenantHealthHistorySize is the number of probes remembered per tenant
+	tenantHealthHistorySize = 10
+)
+
+// tenantsHealthHistory keeps the result of the last probes of every tenant, it lives in memory
+// so every m3 replica keeps its own history
+var tenantsHealthHistory = newTenantHealthHistory(tenantHealthHistorySize)
+
+// tenantNodeEndpoint is a MinIO pod to be probed
+type tenantNodeEndpoint struct {
+	name     string
+	endpoint string
+}
+
+// tenantHealthEndpoints returns the endpoint of the tenant service, used to probe the cluster health, and the
+// endpoint of every MinIO pod reachable through the headless service, ie: https://tenant-0.tenant-hl-svc.default.svc.cluster.local:9000
+func tenantHealthEndpoints(minInst *operator.MinIOInstance) (string, []tenantNodeEndpoint) {
+	minInst = minInst.DeepCopy().EnsureDefaults()
+	endpoint, secure := tenantServiceEndpoint(minInst)
+	scheme := "http"
+	if secure {
+		scheme = "https"
+	}
+	clusterEndpoint := fmt.Sprintf("%s://%s", scheme, endpoint)
+	var nodes []tenantNodeEndpoint
+	var index int32
+	for _, zone := range minInst.Spec.Zones {
+		for i := int32(0); i < zone.Servers; i++ {
+			podName := fmt.Sprintf("%s-%d", minInst.MinIOStatefulSetName(), index)
+			nodes = append(nodes, tenantNodeEndpoint{
+				name:     podName,
+				endpoint: fmt.Sprintf("%s://%s.%s.%s.svc.%s:%d", scheme, podName, minInst.MinIOHLServiceName(), minInst.Namespace, operator.ClusterDomain, operator.MinIOPort),
+			})
+			index++
+		}
+	}
+	return clusterEndpoint, nodes
+}
+
+// newTenantHealthClient returns the http client used to probe tenants, the certificates are verified with
+// tlsConfig as in the clients of the tenant
+func newTenantHealthClient(tlsConfig *tls.Config) cluster.HTTPClientI {
+	return &cluster.HTTPClient{
+		Client: &http.Client{
+			Timeout:   tenantHealthProbeTimeout,
+			Transport: newTenantTransport(tlsConfig),
+		},
+	}
+}
+
+// tenantHealthTLSConfig returns the tls config used to probe the tenant, when the certificate of the tenant can't
+// be read by the caller the probes only trust the cluster roots and report the failed verification
+func tenantHealthTLSConfig(ctx context.Context, token string, minInst *operator.MinIOInstance) (*tls.Config, error) {
+	clientset, err := cluster.K8sClient(token)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig, err := tenantTLSConfig(ctx, &k8sClient{client: clientset}, minInst)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Warn("error reading the certificate of the tenant")
+		return cachedTenantTLSConfig(nil)
+	}
+	return tlsConfig, nil
+}
+
+// probeTenantEndpoint sends a GET request to the endpoint which is canceled along with ctx
+func probeTenantEndpoint(ctx context.Context, client cluster.HTTPClientI, endpoint string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	return client.Do(req)
+}
+
+// probeTenantHealth checks the liveness of every MinIO node and whether the cluster has write quorum, all the
+// probes run concurrently. The tenant is graded green when the cluster is healthy and every node is online,
+// yellow when the cluster is healthy but some nodes are not online and red when the cluster has no write quorum
+func probeTenantHealth(ctx context.Context, client cluster.HTTPClientI, clusterEndpoint string, nodes []tenantNodeEndpoint) *models.TenantHealth {
+	ctx, span := tracing.Start(ctx, "probeTenantHealth", attribute.String("endpoint", clusterEndpoint), attribute.Int("nodes", len(nodes)))
+	defer span.End()
+
+	health := &models.TenantHealth{
+		CheckedAt: strfmt.DateTime(time.Now().UTC()),
+		Nodes:     make([]*models.TenantNodeHealth, len(nodes)),
+	}
+	var wg sync.WaitGroup
+	for i, node := range nodes {
+		wg.Add(1)
+		go func(i int, node tenantNodeEndpoint) {
+			defer wg.Done()
+			health.Nodes[i] = probeTenantNode(ctx, client, node)
+		}(i, node)
+	}
+	health.Cluster = probeTenantCluster(ctx, client, clusterEndpoint)
+	wg.Wait()
+
+	health.Grade = models.TenantHealthGradeGreen
+	for _, node := range health.Nodes {
+		if node.Status != models.TenantNodeHealthStatusOnline {
+			health.Grade = models.TenantHealthGradeYellow
+		}
+	}
+	if !health.Cluster.Healthy {
+		health.Grade = models.TenantHealthGradeRed
+	}
+	span.SetAttributes(attribute.String("grade", health.Grade))
+	return health
+}
+
+// probeTenantNode checks a single MinIO node is live
+func probeTenantNode(ctx context.Context, client cluster.HTTPClientI, node tenantNodeEndpoint) *models.TenantNodeHealth {
+	nodeHealth := &models.TenantNodeHealth{
+		Name:     node.name,
+		Endpoint: node.endpoint,
+		Status:   models.TenantNodeHealthStatusOffline,
+	}
+	resp, err := probeTenantEndpoint(ctx, client, node.endpoint+minioLivenessPath)
+	if err != nil {
+		nodeHealth.Error = err.Error()
+		return nodeHealth
+	}
+	defer drainBody(resp.Body)
+	switch {
+	case resp.StatusCode != http.StatusOK:
+		nodeHealth.Error = fmt.Sprintf("liveness check returned %s", resp.Status)
+	case resp.Header.Get(minioServerStatus) == minioServerNotStarted:
+		nodeHealth.Status = models.TenantNodeHealthStatusInitializing
+	default:
+		nodeHealth.Status = models.TenantNodeHealthStatusOnline
+	}
+	return nodeHealth
+}
+
+// probeTenantCluster checks the cluster has write quorum through the tenant service, MinIO answers
+// 200 when the cluster has write quorum and 503 otherwise, in both cases the quorum is returned on a header
+func probeTenantCluster(ctx context.Context, client cluster.HTTPClientI, endpoint string) *models.TenantClusterHealth {
+	clusterHealth := &models.TenantClusterHealth{
+		Endpoint: endpoint,
+	}
+	resp, err := probeTenantEndpoint(ctx, client, endpoint+minioClusterPath)
+	if err != nil {
+		clusterHealth.Error = err.Error()
+		return clusterHealth
+	}
+	defer drainBody(resp.Body)
+	if quorum, err := strconv.ParseInt(resp.Header.Get(minioWriteQuorum), 10, 64); err == nil {
+		clusterHealth.WriteQuorum = quorum
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		clusterHealth.Healthy = true
+	case http.StatusServiceUnavailable:
+		clusterHealth.Error = "cluster doesn't have write quorum"
+	default:
+		clusterHealth.Error = fmt.Sprintf("cluster check returned %s", resp.Status)
+	}
+	return clusterHealth
+}
+
+// drainBody reads the rest of the body so the connection can be reused
+func drainBody(body io.ReadCloser) {
+	_, _ = io.Copy(ioutil.Discard, body)
+	_ = body.Close()
+}
+
+// tenantHealthHistory keeps the last probes of every tenant by namespace and name
+type tenantHealthHistory struct {
+	mu      sync.Mutex
+	size    int
+	entries map[string][]*models.TenantHealthHistory
+}
+
+func newTenantHealthHistory(size int) *tenantHealthHistory {
+	return &tenantHealthHistory{
+		size:    size,
+		entries: make(map[string][]*models.TenantHealthHistory),
+	}
+}
+
+// record adds the result of a probe to the tenant history and returns the updated history, oldest first
+func (h *tenantHealthHistory) record(namespace, tenant string, health *models.TenantHealth) []*models.TenantHealthHistory {
+	entry := &models.TenantHealthHistory{
+		CheckedAt:  health.CheckedAt,
+		Grade:      health.Grade,
+		TotalNodes: int64(len(health.Nodes)),
+	}
+	for _, node := range health.Nodes {
+		if node.Status == models.TenantNodeHealthStatusOnline {
+			entry.OnlineNodes++
+		}
+	}
+	key := namespace + "/" + tenant
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	entries := append(h.entries[key], entry)
+	if len(entries) > h.size {
+		entries = entries[len(entries)-h.size:]
+	}
+	h.entries[key] = entries
+	history := make([]*models.TenantHealthHistory, len(entries))
+	copy(history, entries)
+	return history
+}
+
+// forget drops the history of a deleted tenant
+func (h *tenantHealthHistory) forget(namespace, tenant string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	delete(h.entries, namespace+"/"+tenant)
+}
+
+// getTenantHealth probes the tenant and records the result on its history
+func getTenantHealth(ctx context.Context, client cluster.HTTPClientI, minInst *operator.MinIOInstance) *models.TenantHealth {
+	clusterEndpoint, nodes := tenantHealthEndpoints(minInst)
+	health := probeTenantHealth(ctx, client, clusterEndpoint, nodes)
+	health.History = tenantsHealthHistory.record(minInst.Namespace, minInst.Name, health)
+	return health
+}
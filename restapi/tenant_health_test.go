@@ -0,0 +1,210 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/minio/m3/models"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newMinIOStandIn returns an httptest server answering the MinIO health endpoints
+func newMinIOStandIn(t *testing.T, liveStatus int, serverStatus string, clusterStatus int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case minioLivenessPath:
+			if serverStatus != "" {
+				w.Header().Set(minioServerStatus, serverStatus)
+			}
+			w.WriteHeader(liveStatus)
+		case minioClusterPath:
+			w.Header().Set(minioWriteQuorum, "3")
+			w.WriteHeader(clusterStatus)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func Test_probeTenantHealth(t *testing.T) {
+	online := newMinIOStandIn(t, http.StatusOK, "", http.StatusOK)
+	initializing := newMinIOStandIn(t, http.StatusOK, minioServerNotStarted, http.StatusOK)
+	failing := newMinIOStandIn(t, http.StatusServiceUnavailable, "", http.StatusServiceUnavailable)
+	unreachable := newMinIOStandIn(t, http.StatusOK, "", http.StatusOK)
+	unreachable.Close()
+
+	tests := []struct {
+		name             string
+		canceled         bool
+		clusterEndpoint  string
+		nodes            []string
+		wantGrade        string
+		wantHealthy      bool
+		wantWriteQuorum  int64
+		wantNodeStatuses []string
+	}{
+		{
+			name:             "All nodes online",
+			clusterEndpoint:  online.URL,
+			nodes:            []string{online.URL, online.URL},
+			wantGrade:        models.TenantHealthGradeGreen,
+			wantHealthy:      true,
+			wantWriteQuorum:  3,
+			wantNodeStatuses: []string{models.TenantNodeHealthStatusOnline, models.TenantNodeHealthStatusOnline},
+		},
+		{
+			name:             "Node not live",
+			clusterEndpoint:  online.URL,
+			nodes:            []string{online.URL, failing.URL},
+			wantGrade:        models.TenantHealthGradeYellow,
+			wantHealthy:      true,
+			wantWriteQuorum:  3,
+			wantNodeStatuses: []string{models.TenantNodeHealthStatusOnline, models.TenantNodeHealthStatusOffline},
+		},
+		{
+			name:             "Node initializing",
+			clusterEndpoint:  online.URL,
+			nodes:            []string{initializing.URL, online.URL},
+			wantGrade:        models.TenantHealthGradeYellow,
+			wantHealthy:      true,
+			wantWriteQuorum:  3,
+			wantNodeStatuses: []string{models.TenantNodeHealthStatusInitializing, models.TenantNodeHealthStatusOnline},
+		},
+		{
+			name:             "Cluster without write quorum",
+			clusterEndpoint:  failing.URL,
+			nodes:            []string{online.URL, failing.URL},
+			wantGrade:        models.TenantHealthGradeRed,
+			wantHealthy:      false,
+			wantWriteQuorum:  3,
+			wantNodeStatuses: []string{models.TenantNodeHealthStatusOnline, models.TenantNodeHealthStatusOffline},
+		},
+		{
+			name:             "Probes canceled",
+			canceled:         true,
+			clusterEndpoint:  online.URL,
+			nodes:            []string{online.URL},
+			wantGrade:        models.TenantHealthGradeRed,
+			wantHealthy:      false,
+			wantNodeStatuses: []string{models.TenantNodeHealthStatusOffline},
+		},
+		{
+			name:             "Tenant unreachable",
+			clusterEndpoint:  unreachable.URL,
+			nodes:            []string{unreachable.URL},
+			wantGrade:        models.TenantHealthGradeRed,
+			wantHealthy:      false,
+			wantNodeStatuses: []string{models.TenantNodeHealthStatusOffline},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var nodes []tenantNodeEndpoint
+			for i, endpoint := range tt.nodes {
+				nodes = append(nodes, tenantNodeEndpoint{name: fmt.Sprintf("tenant-%d", i), endpoint: endpoint})
+			}
+			ctx, cancel := context.WithCancel(context.Background())
+			if tt.canceled {
+				cancel()
+			}
+			defer cancel()
+			got := probeTenantHealth(ctx, newTenantHealthClient(nil), tt.clusterEndpoint, nodes)
+			if got.Grade != tt.wantGrade {
+				t.Errorf("probeTenantHealth() grade = %v, want %v", got.Grade, tt.wantGrade)
+			}
+			if got.Cluster.Healthy != tt.wantHealthy {
+				t.Errorf("probeTenantHealth() cluster healthy = %v, want %v", got.Cluster.Healthy, tt.wantHealthy)
+			}
+			if !tt.wantHealthy && got.Cluster.Error == "" {
+				t.Errorf("probeTenantHealth() unhealthy cluster should report an error")
+			}
+			if got.Cluster.WriteQuorum != tt.wantWriteQuorum {
+				t.Errorf("probeTenantHealth() write quorum = %v, want %v", got.Cluster.WriteQuorum, tt.wantWriteQuorum)
+			}
+			if len(got.Nodes) != len(tt.wantNodeStatuses) {
+				t.Fatalf("probeTenantHealth() nodes = %v, want %v", len(got.Nodes), len(tt.wantNodeStatuses))
+			}
+			for i, node := range got.Nodes {
+				if node.Status != tt.wantNodeStatuses[i] {
+					t.Errorf("probeTenantHealth() node %s status = %v, want %v", node.Name, node.Status, tt.wantNodeStatuses[i])
+				}
+			}
+		})
+	}
+}
+
+func Test_tenantHealthEndpoints(t *testing.T) {
+	minInst := &operator.MinIOInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant", Namespace: "default"},
+		Spec: operator.MinIOInstanceSpec{
+			RequestAutoCert: true,
+			Zones: []operator.Zone{
+				{Name: "zone-0", Servers: 2},
+				{Name: "zone-1", Servers: 2},
+			},
+		},
+	}
+	clusterEndpoint, nodes := tenantHealthEndpoints(minInst)
+	if want := "https://tenant.default.svc." + operator.ClusterDomain + ":9000"; clusterEndpoint != want {
+		t.Errorf("tenantHealthEndpoints() cluster = %v, want %v", clusterEndpoint, want)
+	}
+	if len(nodes) != 4 {
+		t.Fatalf("tenantHealthEndpoints() nodes = %v, want 4", len(nodes))
+	}
+	if want := "https://tenant-3.tenant-hl-svc.default.svc." + operator.ClusterDomain + ":9000"; nodes[3].endpoint != want {
+		t.Errorf("tenantHealthEndpoints() last node = %v, want %v", nodes[3].endpoint, want)
+	}
+	if minInst.Spec.ServiceName != "" {
+		t.Errorf("tenantHealthEndpoints() should not modify the tenant")
+	}
+}
+
+func Test_tenantHealthHistory(t *testing.T) {
+	history := newTenantHealthHistory(3)
+	grades := []string{models.TenantHealthGradeRed, models.TenantHealthGradeYellow, models.TenantHealthGradeGreen, models.TenantHealthGradeGreen}
+	var got []*models.TenantHealthHistory
+	for _, grade := range grades {
+		got = history.record("default", "tenant", &models.TenantHealth{
+			Grade: grade,
+			Nodes: []*models.TenantNodeHealth{
+				{Status: models.TenantNodeHealthStatusOnline},
+				{Status: models.TenantNodeHealthStatusOffline},
+			},
+		})
+	}
+	if len(got) != 3 {
+		t.Fatalf("record() history = %v, want 3", len(got))
+	}
+	if got[0].Grade != models.TenantHealthGradeYellow {
+		t.Errorf("record() oldest entry = %v, want %v", got[0].Grade, models.TenantHealthGradeYellow)
+	}
+	if got[2].OnlineNodes != 1 || got[2].TotalNodes != 2 {
+		t.Errorf("record() nodes = %v/%v, want 1/2", got[2].OnlineNodes, got[2].TotalNodes)
+	}
+	history.forget("default", "tenant")
+	if got := history.record("default", "tenant", &models.TenantHealth{}); len(got) != 1 {
+		t.Errorf("forget() history = %v, want 1", len(got))
+	}
+}
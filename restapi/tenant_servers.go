@@ -0,0 +1,218 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"net/url"
+	"path"
+	"sort"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/restapi/operations"
+	"github.com/minio/m3/restapi/operations/admin_api"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+const (
+	driveStateOnline  = "online"
+	driveStateOffline = "offline"
+	driveStateHealing = "healing"
+)
+
+func registerTenantServerHandlers(api *operations.M3API) {
+	// Tenant Servers and Drives
+	api.AdminAPITenantServerInfoHandler = admin_api.TenantServerInfoHandlerFunc(func(params admin_api.TenantServerInfoParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getTenantServerInfoResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewTenantServerInfoDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewTenantServerInfoOK().WithPayload(resp)
+	})
+}
+
+// driveState maps the state of a drive reported by MinIO to online, offline or healing, unformatted drives are
+// drives that were replaced and are formatted and healed by MinIO
+func driveState(state string) string {
+	switch state {
+	case madmin.DriveStateOk:
+		return driveStateOnline
+	case driveStateHealing, madmin.DriveStateUnformatted:
+		return driveStateHealing
+	default:
+		return driveStateOffline
+	}
+}
+
+// erasureDrive is the position of a drive in the erasure sets of the tenant
+type erasureDrive struct {
+	set     int32
+	index   int32
+	info    madmin.DriveInfo
+	matched bool
+}
+
+// driveEndpoint returns the host and the path of the endpoint of a drive of an erasure set, ie:
+// http://tenant-zone-0-0.tenant-hl-svc.default.svc.cluster.local:9000/export0
+func driveEndpoint(endpoint string) (string, string) {
+	u, err := url.Parse(endpoint)
+	if err != nil || u.Host == "" {
+		return "", endpoint
+	}
+	return u.Host, u.Path
+}
+
+// getTenantServerInfo merges the servers and drives reported by ServerInfo with the erasure sets reported by
+// StorageInfo, drives of the erasure sets that aren't reported by any server (ie: the server is down) are
+// added as offline drives so they aren't missing from the inventory
+func getTenantServerInfo(ctx context.Context, client MinioAdmin) (*models.TenantServerInfo, error) {
+	info, err := client.serverInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	storage, err := client.storageInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	byUUID := map[string]*erasureDrive{}
+	byEndpoint := map[string]*erasureDrive{}
+	var erasureDrives []*erasureDrive
+	for set, drives := range storage.Backend.Sets {
+		for index, drive := range drives {
+			d := &erasureDrive{set: int32(set), index: int32(index), info: drive}
+			erasureDrives = append(erasureDrives, d)
+			if drive.UUID != "" {
+				byUUID[drive.UUID] = d
+			}
+			host, drivePath := driveEndpoint(drive.Endpoint)
+			byEndpoint[host+drivePath] = d
+		}
+	}
+
+	resp := &models.TenantServerInfo{
+		DeploymentID:     info.DeploymentID,
+		Mode:             info.Mode,
+		ErasureSets:      int64(len(storage.Backend.Sets)),
+		StandardScParity: int64(storage.Backend.StandardSCParity),
+		RrscParity:       int64(storage.Backend.RRSCParity),
+	}
+	servers := map[string]*models.TenantServer{}
+	for _, server := range info.Servers {
+		s := &models.TenantServer{
+			Endpoint: server.Endpoint,
+			State:    server.State,
+			Uptime:   server.Uptime,
+			Version:  server.Version,
+			CommitID: server.CommitID,
+			Drives:   []*models.TenantDrive{},
+		}
+		for _, disk := range server.Disks {
+			drive := &models.TenantDrive{
+				Path:          disk.DrivePath,
+				UUID:          disk.UUID,
+				State:         driveState(disk.State),
+				ReportedState: disk.State,
+				UsedSpace:     int64(disk.UsedSpace),
+				TotalSpace:    int64(disk.TotalSpace),
+				ErasureSet:    -1,
+				SetIndex:      -1,
+			}
+			d, ok := byUUID[disk.UUID]
+			if !ok {
+				d, ok = byEndpoint[server.Endpoint+disk.DrivePath]
+			}
+			if ok {
+				d.matched = true
+				drive.ErasureSet = d.set
+				drive.SetIndex = d.index
+			}
+			s.Drives = append(s.Drives, drive)
+		}
+		servers[server.Endpoint] = s
+		resp.Servers = append(resp.Servers, s)
+	}
+
+	for _, d := range erasureDrives {
+		if d.matched {
+			continue
+		}
+		host, drivePath := driveEndpoint(d.info.Endpoint)
+		s, ok := servers[host]
+		if !ok {
+			s = &models.TenantServer{Endpoint: host, State: driveStateOffline, Drives: []*models.TenantDrive{}}
+			servers[host] = s
+			resp.Servers = append(resp.Servers, s)
+		}
+		state := driveStateOffline
+		if s.State != driveStateOffline {
+			state = driveState(d.info.State)
+		}
+		s.Drives = append(s.Drives, &models.TenantDrive{
+			Path:          drivePath,
+			UUID:          d.info.UUID,
+			State:         state,
+			ReportedState: d.info.State,
+			ErasureSet:    d.set,
+			SetIndex:      d.index,
+		})
+	}
+
+	versions := map[string]bool{}
+	for _, s := range resp.Servers {
+		if s.Version != "" {
+			versions[s.Version] = true
+		}
+		sort.Slice(s.Drives, func(i, j int) bool {
+			return path.Clean(s.Drives[i].Path) < path.Clean(s.Drives[j].Path)
+		})
+		for _, drive := range s.Drives {
+			switch drive.State {
+			case driveStateOnline:
+				resp.OnlineDrives++
+			case driveStateHealing:
+				resp.HealingDrives++
+			default:
+				resp.OfflineDrives++
+			}
+			resp.UsedSpace += drive.UsedSpace
+			resp.TotalSpace += drive.TotalSpace
+		}
+	}
+	sort.Slice(resp.Servers, func(i, j int) bool {
+		return resp.Servers[i].Endpoint < resp.Servers[j].Endpoint
+	})
+	for version := range versions {
+		resp.Versions = append(resp.Versions, version)
+	}
+	sort.Strings(resp.Versions)
+	resp.VersionSkew = len(resp.Versions) > 1
+	return resp, nil
+}
+
+func getTenantServerInfoResponse(ctx context.Context, token string, params admin_api.TenantServerInfoParams) (*models.TenantServerInfo, error) {
+	ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return getTenantServerInfo(ctx, client)
+}
@@ -0,0 +1,112 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/minio/m3/models"
+	"github.com/minio/minio/pkg/madmin"
+)
+
+var minioServerInfoMock func(ctx context.Context) (madmin.InfoMessage, error)
+var minioStorageInfoMock func(ctx context.Context) (madmin.StorageInfo, error)
+
+func (ac adminClientMock) serverInfo(ctx context.Context) (madmin.InfoMessage, error) {
+	return minioServerInfoMock(ctx)
+}
+
+func (ac adminClientMock) storageInfo(ctx context.Context) (madmin.StorageInfo, error) {
+	return minioStorageInfoMock(ctx)
+}
+
+func Test_getTenantServerInfo(t *testing.T) {
+	const (
+		node0 = "tenant-1-zone-0-0.tenant-1-hl-svc.default.svc.cluster.local:9000"
+		node1 = "tenant-1-zone-0-1.tenant-1-hl-svc.default.svc.cluster.local:9000"
+	)
+	minioServerInfoMock = func(ctx context.Context) (madmin.InfoMessage, error) {
+		return madmin.InfoMessage{
+			Mode:         "online",
+			DeploymentID: "deployment-1",
+			Servers: []madmin.ServerProperties{
+				{
+					Endpoint: node0,
+					State:    "ok",
+					Uptime:   3600,
+					Version:  "2020-05-01T22-19-14Z",
+					Disks: []madmin.Disk{
+						{DrivePath: "/export1", State: madmin.DriveStateUnformatted, UUID: "uuid-0-1", TotalSpace: 100, UsedSpace: 0},
+						{DrivePath: "/export0", State: madmin.DriveStateOk, UUID: "uuid-0-0", TotalSpace: 100, UsedSpace: 40},
+					},
+				},
+				{
+					Endpoint: node1,
+					State:    "offline",
+					Version:  "2020-04-15T19-42-18Z",
+				},
+			},
+		}, nil
+	}
+	minioStorageInfoMock = func(ctx context.Context) (madmin.StorageInfo, error) {
+		var storage madmin.StorageInfo
+		storage.Backend.StandardSCParity = 2
+		storage.Backend.Sets = [][]madmin.DriveInfo{
+			{
+				{UUID: "uuid-0-0", Endpoint: "http://" + node0 + "/export0", State: madmin.DriveStateOk},
+				{UUID: "uuid-1-0", Endpoint: "http://" + node1 + "/export0", State: madmin.DriveStateOffline},
+				{UUID: "uuid-0-1", Endpoint: "http://" + node0 + "/export1", State: madmin.DriveStateUnformatted},
+				{UUID: "", Endpoint: "http://" + node1 + "/export1", State: madmin.DriveStateOk},
+			},
+		}
+		return storage, nil
+	}
+
+	got, err := getTenantServerInfo(context.Background(), adminClientMock{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.VersionSkew || !reflect.DeepEqual(got.Versions, []string{"2020-04-15T19-42-18Z", "2020-05-01T22-19-14Z"}) {
+		t.Errorf("getTenantServerInfo() versions = %v, skew %v", got.Versions, got.VersionSkew)
+	}
+	if got.OnlineDrives != 1 || got.HealingDrives != 1 || got.OfflineDrives != 2 {
+		t.Errorf("getTenantServerInfo() drives online %v, healing %v, offline %v", got.OnlineDrives, got.HealingDrives, got.OfflineDrives)
+	}
+	if got.UsedSpace != 40 || got.TotalSpace != 200 || got.ErasureSets != 1 || got.StandardScParity != 2 {
+		t.Errorf("getTenantServerInfo() = %+v", got)
+	}
+	if len(got.Servers) != 2 || got.Servers[0].Endpoint != node0 || got.Servers[0].Uptime != 3600 {
+		t.Fatalf("getTenantServerInfo() servers = %+v", got.Servers)
+	}
+	wantNode0 := []*models.TenantDrive{
+		{Path: "/export0", UUID: "uuid-0-0", State: "online", ReportedState: "ok", UsedSpace: 40, TotalSpace: 100, ErasureSet: 0, SetIndex: 0},
+		{Path: "/export1", UUID: "uuid-0-1", State: "healing", ReportedState: "unformatted", TotalSpace: 100, ErasureSet: 0, SetIndex: 2},
+	}
+	if !reflect.DeepEqual(got.Servers[0].Drives, wantNode0) {
+		t.Errorf("getTenantServerInfo() drives of %s = %+v", node0, got.Servers[0].Drives)
+	}
+	// the drives of an offline server are only reported by the erasure sets
+	wantNode1 := []*models.TenantDrive{
+		{Path: "/export0", UUID: "uuid-1-0", State: "offline", ReportedState: "offline", ErasureSet: 0, SetIndex: 1},
+		{Path: "/export1", State: "offline", ReportedState: "ok", ErasureSet: 0, SetIndex: 3},
+	}
+	if !reflect.DeepEqual(got.Servers[1].Drives, wantNode1) {
+		t.Errorf("getTenantServerInfo() drives of %s = %+v", node1, got.Servers[1].Drives)
+	}
+}
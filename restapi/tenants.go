@@ -20,18 +20,19 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"log"
 	"net/http"
-	"os"
 	"strings"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	types "k8s.io/apimachinery/pkg/types"
 
 	corev1 "k8s.io/api/core/v1"
 
 	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/sirupsen/logrus"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/swag"
@@ -40,6 +41,8 @@ import (
 	"github.com/minio/m3/restapi/operations/admin_api"
 	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 func registerTenantHandlers(api *operations.M3API) {
@@ -48,7 +51,10 @@ func registerTenantHandlers(api *operations.M3API) {
 		sessionID := string(*principal)
 		resp, err := getTenantCreatedResponse(sessionID, params)
 		if err != nil {
-			return admin_api.NewCreateTenantDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+			getTenantEventRecorder().record(params.HTTPRequest.Context(), sessionID, tenantReference(*params.Body.Namespace, *params.Body.Name),
+				corev1.EventTypeWarning, eventReasonTenantCreateFailed, "Tenant creation failed: %v", err)
+			apiErr := prepareError(params.HTTPRequest.Context(), err)
+			return admin_api.NewCreateTenantDefault(int(apiErr.Code)).WithPayload(apiErr)
 		}
 		return admin_api.NewCreateTenantOK().WithPayload(resp)
 	})
@@ -57,9 +63,10 @@ func registerTenantHandlers(api *operations.M3API) {
 		sessionID := string(*principal)
 		resp, err := getListAllTenantsResponse(sessionID, params)
 		if err != nil {
-			return admin_api.NewListTenantsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+			apiErr := prepareError(params.HTTPRequest.Context(), err)
+			return admin_api.NewListAllTenantsDefault(int(apiErr.Code)).WithPayload(apiErr)
 		}
-		return admin_api.NewListTenantsOK().WithPayload(resp)
+		return admin_api.NewListAllTenantsOK().WithPayload(resp)
 
 	})
 	// List Tenants by namespace
@@ -67,7 +74,8 @@ func registerTenantHandlers(api *operations.M3API) {
 		sessionID := string(*principal)
 		resp, err := getListTenantsResponse(sessionID, params)
 		if err != nil {
-			return admin_api.NewListTenantsDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+			apiErr := prepareError(params.HTTPRequest.Context(), err)
+			return admin_api.NewListTenantsDefault(int(apiErr.Code)).WithPayload(apiErr)
 		}
 		return admin_api.NewListTenantsOK().WithPayload(resp)
 
@@ -77,7 +85,8 @@ func registerTenantHandlers(api *operations.M3API) {
 		sessionID := string(*principal)
 		resp, err := getTenantInfoResponse(sessionID, params)
 		if err != nil {
-			return admin_api.NewTenantInfoDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String(err.Error())})
+			apiErr := prepareError(params.HTTPRequest.Context(), err)
+			return admin_api.NewTenantInfoDefault(int(apiErr.Code)).WithPayload(apiErr)
 		}
 		return admin_api.NewTenantInfoOK().WithPayload(resp)
 
@@ -86,12 +95,13 @@ func registerTenantHandlers(api *operations.M3API) {
 	// Delete Tenant
 	api.AdminAPIDeleteTenantHandler = admin_api.DeleteTenantHandlerFunc(func(params admin_api.DeleteTenantParams, principal *models.Principal) middleware.Responder {
 		sessionID := string(*principal)
-		err := getDeleteTenantResponse(sessionID, params)
+		resp, err := getDeleteTenantResponse(sessionID, params)
 		if err != nil {
-			log.Println(err)
-			return admin_api.NewTenantInfoDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String("Unable to delete tenant")})
+			logger.FromContext(params.HTTPRequest.Context()).WithError(err).Error("error deleting tenant")
+			apiErr := prepareError(params.HTTPRequest.Context(), err)
+			return admin_api.NewDeleteTenantDefault(int(apiErr.Code)).WithPayload(apiErr)
 		}
-		return admin_api.NewTenantInfoOK()
+		return admin_api.NewDeleteTenantOK().WithPayload(resp)
 
 	})
 
@@ -100,32 +110,63 @@ func registerTenantHandlers(api *operations.M3API) {
 		sessionID := string(*principal)
 		err := getUpdateTenantResponse(sessionID, params)
 		if err != nil {
-			log.Println(err)
-			return admin_api.NewUpdateTenantDefault(500).WithPayload(&models.Error{Code: 500, Message: swag.String("Unable to update tenant")})
+			logger.FromContext(params.HTTPRequest.Context()).WithError(err).Error("error updating tenant")
+			apiErr := prepareError(params.HTTPRequest.Context(), err)
+			return admin_api.NewUpdateTenantDefault(int(apiErr.Code)).WithPayload(apiErr)
 		}
 		return admin_api.NewUpdateTenantCreated()
 	})
 }
 
-// deleteTenantAction performs the actions of deleting a tenant
-func deleteTenantAction(ctx context.Context, operatorClient OperatorClient, nameSpace, instanceName string) error {
+// deleteTenantAction performs the actions of deleting a tenant, the MinIOInstance is deleted
+// first and then the secrets created by m3 and the resources created by the integrations
+func deleteTenantAction(ctx context.Context, operatorClient OperatorClient, k8sClient K8sClient, integrations []Integration, req *IntegrationRequest) (*models.DeleteTenantResponse, error) {
+	nameSpace, instanceName := req.Tenant.Namespace, req.Tenant.Name
 	err := operatorClient.MinIOInstanceDelete(ctx, nameSpace, instanceName, metav1.DeleteOptions{})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	tenantsHealthHistory.forget(nameSpace, instanceName)
+	removed, err := deleteTenantResources(ctx, k8sClient, nameSpace, instanceName)
+	removed = append([]*models.RemovedResource{{Kind: operator.MinIOCRDResourceKind, Name: instanceName}}, removed...)
+	integrationsRemoved, integrationsErr := runTenantDeletedHooks(ctx, integrations, req)
+	removed = append(removed, integrationsRemoved...)
+	if err := utilerrors.NewAggregate([]error{err, integrationsErr}); err != nil {
+		return nil, err
+	}
+	return &models.DeleteTenantResponse{Removed: removed}, nil
 }
 
 // getDeleteTenantResponse gets the output of deleting a minio instance
-func getDeleteTenantResponse(token string, params admin_api.DeleteTenantParams) error {
+func getDeleteTenantResponse(token string, params admin_api.DeleteTenantParams) (*models.DeleteTenantResponse, error) {
 	opClientClientSet, err := cluster.OperatorClient(token)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	opClient := &operatorClient{
 		client: opClientClientSet,
 	}
-	return deleteTenantAction(context.Background(), opClient, params.Namespace, params.Tenant)
+	clientset, err := cluster.K8sClient(token)
+	if err != nil {
+		return nil, err
+	}
+	k8sClient := &k8sClient{
+		client: clientset,
+	}
+	ctx := params.HTTPRequest.Context()
+	integrations := getWorkingIntegrations(ctx)
+	// get the tenant first so the events and the integrations get the whole tenant
+	tenant, err := opClient.MinIOInstanceGet(ctx, params.Namespace, params.Tenant, metav1.GetOptions{})
+	if err != nil {
+		tenant = tenantReference(params.Namespace, params.Tenant)
+	}
+	resp, err := deleteTenantAction(ctx, opClient, k8sClient, integrations, &IntegrationRequest{Tenant: tenant, Token: token})
+	if err != nil {
+		getTenantEventRecorder().record(ctx, token, tenant, corev1.EventTypeWarning, eventReasonTenantDeleteFailed, "Tenant deletion failed: %v", err)
+		return nil, err
+	}
+	getTenantEventRecorder().record(ctx, token, tenant, corev1.EventTypeNormal, eventReasonTenantDeleted, "Tenant deleted")
+	return resp, nil
 }
 
 func getTenantInfoResponse(token string, params admin_api.TenantInfoParams) (*models.Tenant, error) {
@@ -134,7 +175,14 @@ func getTenantInfoResponse(token string, params admin_api.TenantInfoParams) (*mo
 		return nil, err
 	}
 
-	minInst, err := opClient.OperatorV1().MinIOInstances(params.Namespace).Get(context.Background(), params.Tenant, metav1.GetOptions{})
+	minInst, err := opClient.OperatorV1().MinIOInstances(params.Namespace).Get(params.HTTPRequest.Context(), params.Tenant, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	integrations := getWorkingIntegrations(params.HTTPRequest.Context())
+
+	tlsConfig, err := tenantHealthTLSConfig(params.HTTPRequest.Context(), token, minInst)
 	if err != nil {
 		return nil, err
 	}
@@ -166,6 +214,8 @@ func getTenantInfoResponse(token string, params admin_api.TenantInfoParams) (*mo
 		CurrentState:     minInst.Status.CurrentState,
 		Zones:            zones,
 		Namespace:        minInst.ObjectMeta.Namespace,
+		Health:           getTenantHealth(params.HTTPRequest.Context(), newTenantHealthClient(tlsConfig), minInst),
+		Integrations:     getIntegrationsStatus(params.HTTPRequest.Context(), integrations, &IntegrationRequest{Tenant: minInst, Token: token}),
 	}, nil
 }
 
@@ -213,10 +263,10 @@ func listTenants(ctx context.Context, operatorClient OperatorClient, namespace s
 }
 
 func getListAllTenantsResponse(token string, params admin_api.ListAllTenantsParams) (*models.ListTenantsResponse, error) {
-	ctx := context.Background()
+	ctx := params.HTTPRequest.Context()
 	opClientClientSet, err := cluster.OperatorClient(token)
 	if err != nil {
-		log.Println("error getting operator client:", err)
+		logger.FromContext(ctx).WithError(err).Error("error getting operator client")
 		return nil, err
 	}
 	opClient := &operatorClient{
@@ -224,7 +274,7 @@ func getListAllTenantsResponse(token string, params admin_api.ListAllTenantsPara
 	}
 	listT, err := listTenants(ctx, opClient, "", params.Limit)
 	if err != nil {
-		log.Println("error listing tenants:", err)
+		logger.FromContext(ctx).WithError(err).Error("error listing tenants")
 		return nil, err
 	}
 	return listT, nil
@@ -232,10 +282,10 @@ func getListAllTenantsResponse(token string, params admin_api.ListAllTenantsPara
 
 // getListTenantsResponse list tenants by namespace
 func getListTenantsResponse(token string, params admin_api.ListTenantsParams) (*models.ListTenantsResponse, error) {
-	ctx := context.Background()
+	ctx := params.HTTPRequest.Context()
 	opClientClientSet, err := cluster.OperatorClient(token)
 	if err != nil {
-		log.Println("error getting operator client:", err)
+		logger.FromContext(ctx).WithError(err).Error("error getting operator client")
 		return nil, err
 	}
 	opClient := &operatorClient{
@@ -243,13 +293,17 @@ func getListTenantsResponse(token string, params admin_api.ListTenantsParams) (*
 	}
 	listT, err := listTenants(ctx, opClient, params.Namespace, params.Limit)
 	if err != nil {
-		log.Println("error listing tenants:", err)
+		logger.FromContext(ctx).WithError(err).Error("error listing tenants")
 		return nil, err
 	}
 	return listT, nil
 }
 
 func getTenantCreatedResponse(token string, params admin_api.CreateTenantParams) (*models.CreateTenantResponse, error) {
+	ctx := logger.WithFields(params.HTTPRequest.Context(), logrus.Fields{
+		logger.FieldNamespace: *params.Body.Namespace,
+		logger.FieldTenant:    *params.Body.Name,
+	})
 	minioImage := params.Body.Image
 	if minioImage == "" {
 		minImg, err := cluster.GetMinioImage()
@@ -258,6 +312,28 @@ func getTenantCreatedResponse(token string, params admin_api.CreateTenantParams)
 		}
 		minioImage = *minImg
 	}
+	// validated before creating anything
+	if params.Body.DefaultBucketQuota != "" {
+		if _, err := parseBucketQuota(params.Body.DefaultBucketQuota); err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid default bucket quota: %v", err))
+		}
+	}
+	if swag.StringValue(params.Body.Exposure) == models.CreateTenantRequestExposureGateway &&
+		!sets.NewString(getEnabledIntegrationNames()...).Has(gatewayIntegrationName) {
+		return nil, apierrors.NewBadRequest("the gateway exposure requires the gateway integration")
+	}
+	volumeSize, err := resource.ParseQuantity(*params.Body.VolumeConfiguration.Size)
+	if err != nil {
+		return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid volume size: %v", err))
+	}
+	memorySize, err := resource.ParseQuantity(getTenantMemorySize())
+	if err != nil {
+		return nil, err
+	}
+	integrations, err := getEnabledIntegrations()
+	if err != nil {
+		return nil, err
+	}
 
 	// if access/secret are provided, use them, else create a random pair
 	accessKey := RandomCharString(16)
@@ -268,11 +344,12 @@ func getTenantCreatedResponse(token string, params admin_api.CreateTenantParams)
 	if params.Body.SecretKey != "" {
 		secretKey = params.Body.SecretKey
 	}
-	secretName := fmt.Sprintf("%s-secret", *params.Body.Name)
+	secretName := tenantSecretName(*params.Body.Name)
 	imm := true
 	instanceSecret := corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: secretName,
+			Name:   secretName,
+			Labels: map[string]string{m3TenantLabel: *params.Body.Name},
 		},
 		Immutable: &imm,
 		Data: map[string][]byte{
@@ -286,7 +363,7 @@ func getTenantCreatedResponse(token string, params admin_api.CreateTenantParams)
 		return nil, err
 	}
 	ns := *params.Body.Namespace
-	_, err = clientset.CoreV1().Secrets(ns).Create(context.Background(), &instanceSecret, metav1.CreateOptions{})
+	_, err = clientset.CoreV1().Secrets(ns).Create(ctx, &instanceSecret, metav1.CreateOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -300,16 +377,6 @@ func getTenantCreatedResponse(token string, params admin_api.CreateTenantParams)
 		enableMCS = *params.Body.EnableMcs
 	}
 
-	volumeSize, err := resource.ParseQuantity(*params.Body.VolumeConfiguration.Size)
-	if err != nil {
-		return nil, err
-	}
-
-	memorySize, err := resource.ParseQuantity(getTenantMemorySize())
-	if err != nil {
-		return nil, err
-	}
-
 	volTemp := corev1.PersistentVolumeClaimSpec{
 		AccessModes: []corev1.PersistentVolumeAccessMode{
 			corev1.ReadWriteOnce,
@@ -349,13 +416,12 @@ func getTenantCreatedResponse(token string, params admin_api.CreateTenantParams)
 	// optionals are set below
 
 	if enableMCS {
-		mcsSelector := fmt.Sprintf("%s-mcs", *params.Body.Name)
-
-		mcsSecretName := fmt.Sprintf("%s-secret", mcsSelector)
+		mcsSecretName := tenantMCSSecretName(*params.Body.Name)
 		imm := true
 		instanceSecret := corev1.Secret{
 			ObjectMeta: metav1.ObjectMeta{
-				Name: mcsSecretName,
+				Name:   mcsSecretName,
+				Labels: map[string]string{m3TenantLabel: *params.Body.Name},
 			},
 			Immutable: &imm,
 			Data: map[string][]byte{
@@ -366,7 +432,7 @@ func getTenantCreatedResponse(token string, params admin_api.CreateTenantParams)
 				"MCS_SECRET_KEY":       []byte(RandomCharString(32)),
 			},
 		}
-		_, err = clientset.CoreV1().Secrets(ns).Create(context.Background(), &instanceSecret, metav1.CreateOptions{})
+		_, err = clientset.CoreV1().Secrets(ns).Create(ctx, &instanceSecret, metav1.CreateOptions{})
 		if err != nil {
 			return nil, err
 		}
@@ -401,6 +467,17 @@ func getTenantCreatedResponse(token string, params admin_api.CreateTenantParams)
 	if params.Body.MounthPath != "" {
 		minInst.Spec.Mountpath = params.Body.MounthPath
 	}
+	// record how the tenant is exposed so the integrations know which tenants they have to expose
+	if params.Body.Exposure != nil && *params.Body.Exposure != models.CreateTenantRequestExposureDefault {
+		minInst.ObjectMeta.Annotations = map[string]string{m3ExposureAnnotation: *params.Body.Exposure}
+	}
+	// the default quota of the buckets created through m3
+	if params.Body.DefaultBucketQuota != "" {
+		if minInst.ObjectMeta.Annotations == nil {
+			minInst.ObjectMeta.Annotations = map[string]string{}
+		}
+		minInst.ObjectMeta.Annotations[m3DefaultBucketQuotaAnnotation] = params.Body.DefaultBucketQuota
+	}
 	// add annotations
 	if len(params.Body.Annotations) > 0 {
 		if minInst.Spec.Metadata == nil {
@@ -414,31 +491,42 @@ func getTenantCreatedResponse(token string, params admin_api.CreateTenantParams)
 		return nil, err
 	}
 
-	_, err = opClient.OperatorV1().MinIOInstances(ns).Create(context.Background(), &minInst, metav1.CreateOptions{})
+	createdInst, err := opClient.OperatorV1().MinIOInstances(ns).Create(ctx, &minInst, metav1.CreateOptions{})
 	if err != nil {
 		return nil, err
 	}
+	getTenantEventRecorder().record(ctx, token, createdInst, corev1.EventTypeNormal, eventReasonTenantCreated,
+		"Tenant created with image %s, credentials stored on secret %s", minioImage, secretName)
 
-	// Integratrions
-	if os.Getenv("GKE_INTEGRATION") != "" {
-		err := gkeIntegration(clientset, *params.Body.Name, ns, token)
-		if err != nil {
-			return nil, err
-		}
-	}
-
-	return &models.CreateTenantResponse{
+	response := &models.CreateTenantResponse{
 		AccessKey: accessKey,
 		SecretKey: secretKey,
-	}, nil
+	}
+
+	// the tenant exists from here on, the failures of the integrations are reported as warnings so the caller
+	// still gets the credentials of the tenant
+	if err := runTenantCreatedHooks(ctx, integrations, &IntegrationRequest{Tenant: createdInst, Token: token}); err != nil {
+		logger.FromContext(ctx).WithError(err).Error("error running the tenant created hooks")
+		getTenantEventRecorder().record(ctx, token, createdInst, corev1.EventTypeWarning, eventReasonTenantIntegrationFailed,
+			"Tenant created but an integration failed: %v", err)
+		response.Warnings = append(response.Warnings, fmt.Sprintf("the tenant was created but %v", err))
+	}
+	response.S3Host, response.ConsoleHost, err = getTenantHosts(integrations, createdInst)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("error getting the hosts of the tenant")
+		response.Warnings = append(response.Warnings, fmt.Sprintf("the hosts of the tenant are unknown: %v", err))
+	}
+
+	return response, nil
 }
 
 // updateTenantAction does an update on the minioInstance by patching the desired changes
-func updateTenantAction(ctx context.Context, operatorClient OperatorClient, httpCl cluster.HTTPClientI, nameSpace string, params admin_api.UpdateTenantParams) error {
+// and returns the updated tenant
+func updateTenantAction(ctx context.Context, operatorClient OperatorClient, httpCl cluster.HTTPClientI, nameSpace string, params admin_api.UpdateTenantParams) (*operator.MinIOInstance, error) {
 	imageToUpdate := params.Body.Image
 	minInst, err := operatorClient.MinIOInstanceGet(ctx, nameSpace, params.Tenant, metav1.GetOptions{})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	// if image to update is empty we'll use the latest image by default
@@ -447,30 +535,30 @@ func updateTenantAction(ctx context.Context, operatorClient OperatorClient, http
 	} else {
 		im, err := cluster.GetLatestMinioImage(httpCl)
 		if err != nil {
-			return err
+			return nil, err
 		}
 		minInst.Spec.Image = *im
 	}
 
 	payloadBytes, err := json.Marshal(minInst)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	_, err = operatorClient.MinIOInstancePatch(ctx, nameSpace, minInst.Name, types.MergePatchType, payloadBytes, metav1.PatchOptions{})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	return minInst, nil
 }
 
 func getUpdateTenantResponse(token string, params admin_api.UpdateTenantParams) error {
-	ctx := context.Background()
+	ctx := params.HTTPRequest.Context()
 	// TODO: use namespace of the tenant not from the controller
 	currentNamespace := cluster.GetNs()
 
 	opClientClientSet, err := cluster.OperatorClient(token)
 	if err != nil {
-		log.Println("error getting operator client:", err)
+		logger.FromContext(ctx).WithError(err).Error("error getting operator client")
 		return err
 	}
 
@@ -482,10 +570,15 @@ func getUpdateTenantResponse(token string, params admin_api.UpdateTenantParams)
 			Timeout: 4 * time.Second,
 		},
 	}
-	if err := updateTenantAction(ctx, opClient, httpC, currentNamespace, params); err != nil {
-		log.Println("error patching MinioInstance:", err)
+	minInst, err := updateTenantAction(ctx, opClient, httpC, currentNamespace, params)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).Error("error patching MinioInstance")
+		getTenantEventRecorder().record(ctx, token, tenantReference(currentNamespace, params.Tenant), corev1.EventTypeWarning,
+			eventReasonTenantUpgradeFailed, "Tenant upgrade failed: %v", err)
 		return err
 	}
+	getTenantEventRecorder().record(ctx, token, minInst, corev1.EventTypeNormal, eventReasonTenantUpgraded, "Tenant upgraded to image %s", minInst.Spec.Image)
 
+	runTenantUpdatedHooks(ctx, getWorkingIntegrations(ctx), &IntegrationRequest{Tenant: minInst, Token: token})
 	return nil
 }
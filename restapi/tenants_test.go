@@ -28,7 +28,9 @@ import (
 	"github.com/minio/m3/models"
 	"github.com/minio/m3/restapi/operations/admin_api"
 	v1 "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	types "k8s.io/apimachinery/pkg/types"
 )
 
@@ -63,8 +65,17 @@ func (h httpClientMock) Get(url string) (resp *http.Response, err error) {
 	return httpClientGetMock(url)
 }
 
+// mock function of Do(), the requests are answered by the mock of Get()
+func (h httpClientMock) Do(req *http.Request) (*http.Response, error) {
+	return httpClientGetMock(req.URL.String())
+}
+
 func Test_deleteTenantAction(t *testing.T) {
 	opClient := opClientMock{}
+	// the secrets of the tenant were already removed
+	k8sClientDeleteSecretMock = func(ctx context.Context, namespace, name string, opts metav1.DeleteOptions) error {
+		return apierrors.NewNotFound(schema.GroupResource{}, name)
+	}
 
 	type args struct {
 		ctx                     context.Context
@@ -108,7 +119,8 @@ func Test_deleteTenantAction(t *testing.T) {
 	for _, tt := range tests {
 		opClientMinioInstanceDeleteMock = tt.args.mockMinioInstanceDelete
 		t.Run(tt.name, func(t *testing.T) {
-			if err := deleteTenantAction(tt.args.ctx, tt.args.operatorClient, tt.args.nameSpace, tt.args.instanceName); (err != nil) != tt.wantErr {
+			req := &IntegrationRequest{Tenant: tenantReference(tt.args.nameSpace, tt.args.instanceName)}
+			if _, err := deleteTenantAction(tt.args.ctx, tt.args.operatorClient, k8sClientMock{}, nil, req); (err != nil) != tt.wantErr {
 				t.Errorf("deleteTenantAction() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
@@ -269,7 +281,7 @@ func Test_UpdateTenantAction(t *testing.T) {
 		opClientMinioInstancePatchMock = tt.args.mockMinioInstancePatch
 		httpClientGetMock = tt.args.mockHTTPClientGet
 		t.Run(tt.name, func(t *testing.T) {
-			if err := updateTenantAction(tt.args.ctx, tt.args.operatorClient, tt.args.httpCl, tt.args.nameSpace, tt.args.params); (err != nil) != tt.wantErr {
+			if _, err := updateTenantAction(tt.args.ctx, tt.args.operatorClient, tt.args.httpCl, tt.args.nameSpace, tt.args.params); (err != nil) != tt.wantErr {
 				t.Errorf("deleteTenantAction() error = %v, wantErr %v", err, tt.wantErr)
 			}
 		})
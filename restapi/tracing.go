@@ -0,0 +1,68 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/pkg/tracing"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+)
+
+// traceRequest creates a server span for every request named after the swagger operation, the
+// trace context sent by the client is honored and the trace id is attached to the request logger,
+// it must run after routing so the matched route is available on the request
+func traceRequest(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		operation := "unknown"
+		if route := middleware.MatchedRouteFrom(r); route != nil && route.Operation != nil {
+			operation = route.Operation.ID
+		}
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracing.StartServer(ctx, operation,
+			semconv.HTTPMethodKey.String(r.Method),
+			semconv.HTTPTargetKey.String(r.URL.Path),
+			semconv.HTTPRouteKey.String(routePath(r)),
+		)
+		defer span.End()
+		if span.SpanContext().IsValid() {
+			ctx = logger.WithFields(ctx, logrus.Fields{logger.FieldTraceID: span.SpanContext().TraceID().String()})
+		}
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(recorder.status))
+		// client errors are not failures of the server span
+		if recorder.status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(recorder.status))
+		}
+	})
+}
+
+// routePath returns the swagger path template matched by the request, ie: /namespaces/{namespace}/tenants/{tenant}
+func routePath(r *http.Request) string {
+	if route := middleware.MatchedRouteFrom(r); route != nil {
+		return route.PathPattern
+	}
+	return r.URL.Path
+}
@@ -0,0 +1,100 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func Test_traceRequest(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	tests := []struct {
+		name        string
+		traceparent string
+		status      int
+		wantTraceID string
+		wantStatus  codes.Code
+	}{
+		{
+			name:       "New trace",
+			status:     http.StatusOK,
+			wantStatus: codes.Unset,
+		},
+		{
+			name:        "Trace propagated by the client",
+			traceparent: "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+			status:      http.StatusNotFound,
+			wantTraceID: "4bf92f3577b34da6a3ce929d0e0e4736",
+			wantStatus:  codes.Unset,
+		},
+		{
+			name:       "Server error",
+			status:     http.StatusInternalServerError,
+			wantStatus: codes.Error,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exporter.Reset()
+			var loggedTraceID interface{}
+			handler := traceRequest(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				// children spans created by the handlers belong to the request trace
+				_, span := tracing.Start(r.Context(), "child")
+				span.End()
+				loggedTraceID = logger.FromContext(r.Context()).Data[logger.FieldTraceID]
+				w.WriteHeader(tt.status)
+			}))
+			req := httptest.NewRequest(http.MethodGet, "/api/v1/tenants", nil)
+			if tt.traceparent != "" {
+				req.Header.Set("traceparent", tt.traceparent)
+			}
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			spans := exporter.GetSpans()
+			if len(spans) != 2 {
+				t.Fatalf("traceRequest() exported %d spans, want 2", len(spans))
+			}
+			child, server := spans[0], spans[1]
+			if child.Parent.SpanID() != server.SpanContext.SpanID() {
+				t.Errorf("traceRequest() child span is not a child of the request span")
+			}
+			traceID := server.SpanContext.TraceID().String()
+			if tt.wantTraceID != "" && traceID != tt.wantTraceID {
+				t.Errorf("traceRequest() trace id = %v, want %v", traceID, tt.wantTraceID)
+			}
+			if loggedTraceID != traceID {
+				t.Errorf("traceRequest() logged trace id = %v, want %v", loggedTraceID, traceID)
+			}
+			if server.Status.Code != tt.wantStatus {
+				t.Errorf("traceRequest() span status = %v, want %v", server.Status.Code, tt.wantStatus)
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/pkg/logger"
+	"github.com/minio/m3/restapi/operations"
+	"github.com/minio/m3/restapi/operations/admin_api"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// clusterUsageWorkers is the number of tenants whose usage is read at the same time for the cluster usage
+const clusterUsageWorkers = 8
+
+// tenantDataUsage is the data usage reported by the admin api of MinIO, newer servers report the objects and
+// versions of every bucket while older ones only report their size
+type tenantDataUsage struct {
+	LastUpdate       time.Time                    `json:"lastUpdate"`
+	ObjectsCount     uint64                       `json:"objectsCount"`
+	VersionsCount    uint64                       `json:"versionsCount"`
+	ObjectsTotalSize uint64                       `json:"objectsTotalSize"`
+	BucketsCount     uint64                       `json:"bucketsCount"`
+	BucketsUsage     map[string]tenantBucketUsage `json:"bucketsUsageInfo"`
+	BucketsSizes     map[string]uint64            `json:"bucketsSizes"`
+}
+
+type tenantBucketUsage struct {
+	Size          uint64 `json:"size"`
+	ObjectsCount  uint64 `json:"objectsCount"`
+	VersionsCount uint64 `json:"versionsCount"`
+}
+
+func registerUsageHandlers(api *operations.M3API) {
+	// Tenant Usage
+	api.AdminAPITenantUsageHandler = admin_api.TenantUsageHandlerFunc(func(params admin_api.TenantUsageParams, principal *models.Principal) middleware.Responder {
+		ctx := tenantRequestContext(params.HTTPRequest.Context(), params.Namespace, params.Tenant)
+		resp, err := getTenantUsageResponse(ctx, string(*principal), params)
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewTenantUsageDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewTenantUsageOK().WithPayload(resp)
+	})
+	// Cluster Usage
+	api.AdminAPIClusterUsageHandler = admin_api.ClusterUsageHandlerFunc(func(params admin_api.ClusterUsageParams, principal *models.Principal) middleware.Responder {
+		ctx := params.HTTPRequest.Context()
+		resp, err := getClusterUsageResponse(ctx, string(*principal))
+		if err != nil {
+			apiErr := prepareError(ctx, err)
+			return admin_api.NewClusterUsageDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewClusterUsageOK().WithPayload(resp)
+	})
+}
+
+// getTenantUsage returns the usage of the tenant and of its buckets sorted by name
+func getTenantUsage(ctx context.Context, client MinioAdmin, namespace, tenant string) (*models.TenantUsage, error) {
+	usage, err := client.dataUsageInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.TenantUsage{
+		Namespace:    namespace,
+		Tenant:       tenant,
+		LastUpdate:   strfmt.DateTime(usage.LastUpdate),
+		Objects:      int64(usage.ObjectsCount),
+		Versions:     int64(usage.VersionsCount),
+		Size:         int64(usage.ObjectsTotalSize),
+		BucketsCount: int64(usage.BucketsCount),
+		Buckets:      []*models.BucketUsage{},
+	}
+	if len(usage.BucketsUsage) > 0 {
+		for name, bucket := range usage.BucketsUsage {
+			resp.Buckets = append(resp.Buckets, &models.BucketUsage{
+				Name:     name,
+				Objects:  int64(bucket.ObjectsCount),
+				Versions: int64(bucket.VersionsCount),
+				Size:     int64(bucket.Size),
+			})
+		}
+	} else {
+		for name, size := range usage.BucketsSizes {
+			resp.Buckets = append(resp.Buckets, &models.BucketUsage{Name: name, Size: int64(size)})
+		}
+	}
+	sort.Slice(resp.Buckets, func(i, j int) bool {
+		return resp.Buckets[i].Name < resp.Buckets[j].Name
+	})
+	return resp, nil
+}
+
+// listClusterTenants returns the tenants of every namespace, when the caller can't list them cluster wide they
+// are listed on every namespace the caller can list them on
+func listClusterTenants(ctx context.Context, opClient OperatorClient, client K8sClient) ([]operator.MinIOInstance, error) {
+	tenants, err := opClient.MinIOInstanceList(ctx, "", metav1.ListOptions{})
+	if err == nil {
+		return tenants.Items, nil
+	}
+	if !apierrors.IsForbidden(err) {
+		return nil, err
+	}
+	namespaces, err := client.listNamespaces(ctx, metav1.ListOptions{})
+	if apierrors.IsForbidden(err) {
+		return nil, apierrors.NewForbidden(operator.SchemeGroupVersion.WithResource("minioinstances").GroupResource(), "",
+			errors.New("the cluster usage requires listing the tenants on all namespaces or listing the namespaces"))
+	}
+	if err != nil {
+		return nil, err
+	}
+	var items []operator.MinIOInstance
+	for _, namespace := range namespaces.Items {
+		tenants, err := opClient.MinIOInstanceList(ctx, namespace.Name, metav1.ListOptions{})
+		if apierrors.IsForbidden(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, tenants.Items...)
+	}
+	return items, nil
+}
+
+// getClusterUsage returns the usage of every tenant the caller can list and its sum, tenants whose usage can't
+// be read (ie: the caller isn't allowed to read their secret or the tenant didn't answer in time) are reported
+// with the error and left out of the sum, every tenant gets its own timeout
+func getClusterUsage(ctx context.Context, opClient OperatorClient, client K8sClient, newClient func(ctx context.Context, tenant *operator.MinIOInstance) (MinioAdmin, error)) (*models.ClusterUsage, error) {
+	tenants, err := listClusterTenants(ctx, opClient, client)
+	if err != nil {
+		return nil, err
+	}
+	usages := make([]*models.TenantUsage, len(tenants))
+	sem := make(chan struct{}, clusterUsageWorkers)
+	var wg sync.WaitGroup
+	for i := range tenants {
+		wg.Add(1)
+		go func(i int, tenant *operator.MinIOInstance) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			usage, err := func() (*models.TenantUsage, error) {
+				ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+				defer cancel()
+				admClient, err := newClient(ctx, tenant)
+				if err != nil {
+					return nil, err
+				}
+				return getTenantUsage(ctx, admClient, tenant.Namespace, tenant.Name)
+			}()
+			if err != nil {
+				logger.FromContext(ctx).WithError(err).WithFields(logrus.Fields{
+					logger.FieldNamespace: tenant.Namespace,
+					logger.FieldTenant:    tenant.Name,
+				}).Warn("error reading the usage of the tenant")
+				usage = &models.TenantUsage{Namespace: tenant.Namespace, Tenant: tenant.Name, Error: err.Error()}
+			}
+			usages[i] = usage
+		}(i, &tenants[i])
+	}
+	wg.Wait()
+
+	resp := &models.ClusterUsage{Tenants: usages}
+	for _, usage := range usages {
+		if usage.Error != "" {
+			resp.FailedTenants++
+			continue
+		}
+		resp.Objects += usage.Objects
+		resp.Versions += usage.Versions
+		resp.Size += usage.Size
+		resp.BucketsCount += usage.BucketsCount
+	}
+	sort.Slice(resp.Tenants, func(i, j int) bool {
+		if resp.Tenants[i].Namespace != resp.Tenants[j].Namespace {
+			return resp.Tenants[i].Namespace < resp.Tenants[j].Namespace
+		}
+		return resp.Tenants[i].Tenant < resp.Tenants[j].Tenant
+	})
+	return resp, nil
+}
+
+func getTenantUsageResponse(ctx context.Context, token string, params admin_api.TenantUsageParams) (*models.TenantUsage, error) {
+	ctx, cancel := context.WithTimeout(ctx, tenantAdminTimeout)
+	defer cancel()
+	client, err := newTenantAdminClient(ctx, token, params.Namespace, params.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	return getTenantUsage(ctx, client, params.Namespace, params.Tenant)
+}
+
+func getClusterUsageResponse(ctx context.Context, token string) (*models.ClusterUsage, error) {
+	opClientClientSet, err := cluster.OperatorClient(token)
+	if err != nil {
+		return nil, err
+	}
+	clientset, err := cluster.K8sClient(token)
+	if err != nil {
+		return nil, err
+	}
+	client := &k8sClient{client: clientset}
+	return getClusterUsage(ctx, &operatorClient{client: opClientClientSet}, client, func(ctx context.Context, tenant *operator.MinIOInstance) (MinioAdmin, error) {
+		return tenantAdminClient(ctx, client, tenant)
+	})
+}
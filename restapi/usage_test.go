@@ -0,0 +1,201 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v1 "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var minioDataUsageInfoMock func(ctx context.Context) (*tenantDataUsage, error)
+var k8sClientListNamespacesMock func(ctx context.Context, opts metav1.ListOptions) (*corev1.NamespaceList, error)
+
+func (c k8sClientMock) listNamespaces(ctx context.Context, opts metav1.ListOptions) (*corev1.NamespaceList, error) {
+	return k8sClientListNamespacesMock(ctx, opts)
+}
+
+func (ac adminClientMock) dataUsageInfo(ctx context.Context) (*tenantDataUsage, error) {
+	return minioDataUsageInfoMock(ctx)
+}
+
+func Test_getTenantUsage(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name         string
+		status       int
+		body         string
+		wantSize     int64
+		wantVersions int64
+		wantBuckets  string
+		wantCode     int64
+	}{
+		{
+			name:         "Usage with buckets usage",
+			status:       http.StatusOK,
+			body:         `{"lastUpdate":"2020-06-01T10:00:00Z","objectsCount":15,"versionsCount":20,"objectsTotalSize":3072,"bucketsCount":2,"bucketsUsageInfo":{"photos":{"size":2048,"objectsCount":10,"versionsCount":14},"logs":{"size":1024,"objectsCount":5,"versionsCount":6}},"bucketsSizes":{"photos":2048,"logs":1024}}`,
+			wantSize:     3072,
+			wantVersions: 20,
+			wantBuckets:  "logs:5:6:1024,photos:10:14:2048",
+		},
+		{
+			name:        "Usage with buckets sizes",
+			status:      http.StatusOK,
+			body:        `{"lastUpdate":"2020-06-01T10:00:00Z","objectsCount":15,"objectsTotalSize":3072,"bucketsCount":2,"bucketsSizes":{"photos":2048,"logs":1024}}`,
+			wantSize:    3072,
+			wantBuckets: "logs:0:0:1024,photos:0:0:2048",
+		},
+		{
+			name:     "Access denied",
+			status:   http.StatusForbidden,
+			body:     `{"Code":"AccessDenied","Message":"Access Denied."}`,
+			wantCode: http.StatusForbidden,
+		},
+		{
+			name:     "Unparseable error",
+			status:   http.StatusBadGateway,
+			body:     `<html>bad gateway</html>`,
+			wantCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/minio/admin/v3/datausageinfo" {
+					t.Errorf("request path = %v", r.URL.Path)
+				}
+				if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=accesskey/") {
+					t.Errorf("request isn't signed with the tenant credentials: %v", r.Header.Get("Authorization"))
+				}
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer server.Close()
+			client, err := newAdminClient(strings.TrimPrefix(server.URL, "http://"), false, &tenantCredentials{accessKey: "accesskey", secretKey: "secretkey"}, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := getTenantUsage(ctx, client, "default", "tenant-1")
+			if tt.wantCode != 0 {
+				if apiErr := prepareError(ctx, err); apiErr == nil || apiErr.Code != tt.wantCode {
+					t.Fatalf("getTenantUsage() error = %v, want code %v", err, tt.wantCode)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var buckets []string
+			for _, bucket := range got.Buckets {
+				buckets = append(buckets, fmt.Sprintf("%s:%d:%d:%d", bucket.Name, bucket.Objects, bucket.Versions, bucket.Size))
+			}
+			if got.Size != tt.wantSize || got.Versions != tt.wantVersions || got.BucketsCount != 2 || strings.Join(buckets, ",") != tt.wantBuckets {
+				t.Errorf("getTenantUsage() = %+v, buckets %v", got, buckets)
+			}
+			if time.Time(got.LastUpdate).IsZero() || got.Tenant != "tenant-1" {
+				t.Errorf("getTenantUsage() last update = %v, tenant %v", got.LastUpdate, got.Tenant)
+			}
+		})
+	}
+}
+
+func Test_getClusterUsage(t *testing.T) {
+	ctx := context.Background()
+	opClientMinioInstanceListMock = func(ctx context.Context, namespace string, opts metav1.ListOptions) (*v1.MinIOInstanceList, error) {
+		if namespace != "" {
+			t.Errorf("tenants should be listed on all namespaces")
+		}
+		return &v1.MinIOInstanceList{Items: []v1.MinIOInstance{
+			{ObjectMeta: metav1.ObjectMeta{Name: "tenant-2", Namespace: "team-b"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "tenant-1", Namespace: "team-a"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "tenant-3", Namespace: "team-c"}},
+		}}, nil
+	}
+	minioDataUsageInfoMock = func(ctx context.Context) (*tenantDataUsage, error) {
+		if _, ok := ctx.Deadline(); !ok {
+			t.Errorf("the usage of every tenant should be read with a timeout")
+		}
+		return &tenantDataUsage{ObjectsCount: 10, VersionsCount: 12, ObjectsTotalSize: 1000, BucketsCount: 1, BucketsSizes: map[string]uint64{"bucket": 1000}}, nil
+	}
+	newClient := func(ctx context.Context, tenant *v1.MinIOInstance) (MinioAdmin, error) {
+		if tenant.Namespace == "team-c" {
+			return nil, errors.New(`secrets "tenant-3-secret" is forbidden`)
+		}
+		return adminClientMock{}, nil
+	}
+	got, err := getClusterUsage(ctx, opClientMock{}, k8sClientMock{}, newClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Tenants) != 3 || got.Tenants[0].Tenant != "tenant-1" || got.Tenants[2].Error == "" {
+		t.Fatalf("getClusterUsage() tenants = %+v", got.Tenants)
+	}
+	if got.FailedTenants != 1 || got.Objects != 20 || got.Versions != 24 || got.Size != 2000 || got.BucketsCount != 2 {
+		t.Errorf("getClusterUsage() = %+v", got)
+	}
+
+	// without cluster wide access the tenants are listed on the namespaces the caller can list them on
+	tenantsForbidden := apierrors.NewForbidden(v1.SchemeGroupVersion.WithResource("minioinstances").GroupResource(), "", errors.New("forbidden"))
+	opClientMinioInstanceListMock = func(ctx context.Context, namespace string, opts metav1.ListOptions) (*v1.MinIOInstanceList, error) {
+		switch namespace {
+		case "team-a":
+			return &v1.MinIOInstanceList{Items: []v1.MinIOInstance{{ObjectMeta: metav1.ObjectMeta{Name: "tenant-1", Namespace: "team-a"}}}}, nil
+		case "team-b":
+			return &v1.MinIOInstanceList{Items: []v1.MinIOInstance{{ObjectMeta: metav1.ObjectMeta{Name: "tenant-2", Namespace: "team-b"}}}}, nil
+		default:
+			return nil, tenantsForbidden
+		}
+	}
+	k8sClientListNamespacesMock = func(ctx context.Context, opts metav1.ListOptions) (*corev1.NamespaceList, error) {
+		return &corev1.NamespaceList{Items: []corev1.Namespace{
+			{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+		}}, nil
+	}
+	got, err = getClusterUsage(ctx, opClientMock{}, k8sClientMock{}, newClient)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got.Tenants) != 2 || got.FailedTenants != 0 || got.Size != 2000 {
+		t.Errorf("getClusterUsage() per namespace = %+v", got)
+	}
+
+	k8sClientListNamespacesMock = func(ctx context.Context, opts metav1.ListOptions) (*corev1.NamespaceList, error) {
+		return nil, apierrors.NewForbidden(corev1.Resource("namespaces"), "", errors.New("forbidden"))
+	}
+	if _, err := getClusterUsage(ctx, opClientMock{}, k8sClientMock{}, nil); !apierrors.IsForbidden(err) {
+		t.Errorf("getClusterUsage() error = %v, want Forbidden when namespaces can't be listed either", err)
+	}
+
+	opClientMinioInstanceListMock = func(ctx context.Context, namespace string, opts metav1.ListOptions) (*v1.MinIOInstanceList, error) {
+		return nil, errors.New("connection refused")
+	}
+	if _, err := getClusterUsage(ctx, opClientMock{}, k8sClientMock{}, nil); err == nil {
+		t.Errorf("getClusterUsage() should fail when tenants can't be listed")
+	}
+}
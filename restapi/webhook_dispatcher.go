@@ -0,0 +1,419 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/pkg/logger"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Types of the events delivered to the webhooks
+const (
+	webhookEventTenantCreated  = "tenant.created"
+	webhookEventTenantReady    = "tenant.ready"
+	webhookEventTenantUpgraded = "tenant.upgraded"
+	webhookEventTenantDeleted  = "tenant.deleted"
+	webhookEventTenantFailed   = "tenant.failed"
+	webhookEventQuotaThreshold = "quota.threshold"
+)
+
+const (
+	// webhookSignatureHeader carries sha256=<hex HMAC-SHA256 of the body keyed with the webhook secret>
+	webhookSignatureHeader = "X-M3-Signature"
+	webhookEventHeader     = "X-M3-Event"
+	webhookDeliveryHeader  = "X-M3-Delivery"
+	// webhookDeliveryLogSize is the number of deliveries kept in memory
+	webhookDeliveryLogSize = 500
+	// webhookInitialBackoff is the wait before the first retry, it doubles on every retry up to webhookMaxBackoff
+	webhookInitialBackoff = time.Second
+	webhookMaxBackoff     = time.Minute
+	// tenantReadyState is the state set by the operator once the tenant is up
+	tenantReadyState = "Ready"
+)
+
+// webhookEventTypes maps the reasons of the events recorded on the tenants to the webhook events
+var webhookEventTypes = map[string]string{
+	eventReasonTenantCreated:       webhookEventTenantCreated,
+	eventReasonTenantCreateFailed:  webhookEventTenantFailed,
+	eventReasonTenantUpgraded:      webhookEventTenantUpgraded,
+	eventReasonTenantUpgradeFailed: webhookEventTenantFailed,
+	eventReasonTenantDeleted:       webhookEventTenantDeleted,
+	eventReasonTenantDeleteFailed:  webhookEventTenantFailed,
+}
+
+// webhookEvent is the JSON body delivered to the webhooks
+type webhookEvent struct {
+	ID        string                 `json:"id"`
+	Type      string                 `json:"type"`
+	CreatedAt time.Time              `json:"created_at"`
+	Namespace string                 `json:"namespace"`
+	Tenant    string                 `json:"tenant,omitempty"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+}
+
+func newWebhookEvent(eventType, namespace, tenant string, data map[string]interface{}) *webhookEvent {
+	return &webhookEvent{
+		ID:        uuid.New().String(),
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Namespace: namespace,
+		Tenant:    tenant,
+		Data:      data,
+	}
+}
+
+// signWebhookPayload returns the value of the signature header of a delivery
+func signWebhookPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// webhookDeliveryLog keeps the latest deliveries, the oldest ones are dropped once it's full
+type webhookDeliveryLog struct {
+	sync.Mutex
+	size       int
+	deliveries []*models.WebhookDelivery
+}
+
+func newWebhookDeliveryLog(size int) *webhookDeliveryLog {
+	return &webhookDeliveryLog{size: size}
+}
+
+var webhookDeliveries = newWebhookDeliveryLog(webhookDeliveryLogSize)
+
+func (l *webhookDeliveryLog) add(delivery *models.WebhookDelivery) {
+	l.Lock()
+	defer l.Unlock()
+	l.deliveries = append(l.deliveries, delivery)
+	if len(l.deliveries) > l.size {
+		l.deliveries = l.deliveries[len(l.deliveries)-l.size:]
+	}
+}
+
+// update changes a delivery already on the log
+func (l *webhookDeliveryLog) update(update func()) {
+	l.Lock()
+	defer l.Unlock()
+	update()
+}
+
+// list returns up to limit deliveries of the webhook, or of every webhook if empty, newest first
+func (l *webhookDeliveryLog) list(webhook string, limit int) []*models.WebhookDelivery {
+	l.Lock()
+	defer l.Unlock()
+	var deliveries []*models.WebhookDelivery
+	for i := len(l.deliveries) - 1; i >= 0 && len(deliveries) < limit; i-- {
+		if webhook == "" || l.deliveries[i].Webhook == webhook {
+			delivery := *l.deliveries[i]
+			deliveries = append(deliveries, &delivery)
+		}
+	}
+	return deliveries
+}
+
+// webhookDispatcher delivers the events to the webhooks subscribed to them, deliveries run in the background and
+// are retried with exponential backoff on network errors, 429 and 5xx responses
+type webhookDispatcher struct {
+	load        func(ctx context.Context) ([]*webhookConfig, error)
+	client      *http.Client
+	maxAttempts int
+	backoff     time.Duration
+	log         *webhookDeliveryLog
+	// inFlight tracks the deliveries running in the background
+	inFlight sync.WaitGroup
+}
+
+var (
+	webhookDispatcherOnce sync.Once
+	webhookDispatcherInst *webhookDispatcher
+)
+
+// getWebhookDispatcher returns the dispatcher used by m3, the webhooks are read with m3's own service account
+func getWebhookDispatcher() *webhookDispatcher {
+	webhookDispatcherOnce.Do(func() {
+		maxAttempts, err := getWebhookMaxAttempts()
+		if err != nil {
+			logger.Log.WithError(err).Error("error configuring webhooks, using the default number of attempts")
+			maxAttempts = 5
+		}
+		timeout, err := getWebhookTimeout()
+		if err != nil {
+			logger.Log.WithError(err).Error("error configuring webhooks, using the default timeout")
+			timeout = defaultWebhookTimeout
+		}
+		webhookDispatcherInst = &webhookDispatcher{
+			load: func(ctx context.Context) ([]*webhookConfig, error) {
+				clientset, err := cluster.K8sClient(cluster.GetServiceAccountToken())
+				if err != nil {
+					return nil, err
+				}
+				webhooks, _, err := loadWebhooks(ctx, &k8sClient{client: clientset}, cluster.GetNs(), getWebhooksSecret())
+				return webhooks, err
+			},
+			client:      &http.Client{Timeout: timeout},
+			maxAttempts: maxAttempts,
+			backoff:     webhookInitialBackoff,
+			log:         webhookDeliveries,
+		}
+	})
+	return webhookDispatcherInst
+}
+
+// dispatch delivers the event to every webhook subscribed to it, it doesn't wait for the deliveries
+func (d *webhookDispatcher) dispatch(ctx context.Context, event *webhookEvent) {
+	if d == nil {
+		return
+	}
+	webhooks, err := d.load(ctx)
+	if err != nil {
+		logger.FromContext(ctx).WithError(err).WithField("event", event.Type).Error("error loading webhooks, event not delivered")
+		return
+	}
+	var body []byte
+	for _, webhook := range webhooks {
+		if !webhook.subscribed(event.Type) {
+			continue
+		}
+		if body == nil {
+			if body, err = json.Marshal(event); err != nil {
+				logger.FromContext(ctx).WithError(err).Error("error encoding webhook event")
+				return
+			}
+		}
+		now := strfmt.DateTime(time.Now())
+		delivery := &models.WebhookDelivery{
+			ID:        uuid.New().String(),
+			Webhook:   webhook.Name,
+			URL:       webhook.URL,
+			Event:     event.Type,
+			EventID:   event.ID,
+			Status:    models.WebhookDeliveryStatusPending,
+			CreatedAt: now,
+		}
+		d.log.add(delivery)
+		// deliveries outlive the request that triggered them
+		deliveryCtx := logger.WithFields(context.Background(), logrus.Fields{
+			"webhook":  webhook.Name,
+			"event":    event.Type,
+			"delivery": delivery.ID,
+		})
+		d.inFlight.Add(1)
+		go func(webhook *webhookConfig) {
+			defer d.inFlight.Done()
+			d.deliver(deliveryCtx, webhook, body, delivery)
+		}(webhook)
+	}
+}
+
+// deliver sends the body to the webhook until it succeeds, the error is not retryable or the attempts run out
+func (d *webhookDispatcher) deliver(ctx context.Context, webhook *webhookConfig, body []byte, delivery *models.WebhookDelivery) {
+	backoff := d.backoff
+	for attempt := 1; ; attempt++ {
+		code, err := d.send(ctx, webhook, body, delivery)
+		d.log.update(func() {
+			delivery.Attempts = int64(attempt)
+			delivery.ResponseCode = int64(code)
+			delivery.LastAttemptAt = strfmt.DateTime(time.Now())
+			delivery.Error = ""
+			if err != nil {
+				delivery.Error = err.Error()
+			} else {
+				delivery.Status = models.WebhookDeliveryStatusDelivered
+			}
+		})
+		if err == nil {
+			return
+		}
+		if attempt >= d.maxAttempts || !retryableWebhookResponse(code) {
+			break
+		}
+		select {
+		case <-time.After(backoff):
+		case <-ctx.Done():
+		}
+		if backoff *= 2; backoff > webhookMaxBackoff {
+			backoff = webhookMaxBackoff
+		}
+	}
+	d.log.update(func() {
+		delivery.Status = models.WebhookDeliveryStatusFailed
+	})
+	logger.FromContext(ctx).WithField("attempts", delivery.Attempts).Warn("webhook delivery failed")
+}
+
+// send does a single delivery attempt, it returns the status code of the response, 0 if there was none
+func (d *webhookDispatcher) send(ctx context.Context, webhook *webhookConfig, body []byte, delivery *models.WebhookDelivery) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "m3/"+M3Version)
+	req.Header.Set(webhookEventHeader, delivery.Event)
+	req.Header.Set(webhookDeliveryHeader, delivery.ID)
+	req.Header.Set(webhookSignatureHeader, signWebhookPayload(webhook.Secret, body))
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("webhook responded %s", resp.Status)
+	}
+	return resp.StatusCode, nil
+}
+
+// retryableWebhookResponse returns true for network errors, throttling and server errors
+func retryableWebhookResponse(code int) bool {
+	return code == 0 || code == http.StatusTooManyRequests || code >= 500
+}
+
+// notifyTenantEvent delivers the webhook event matching the reason of an event recorded on a tenant
+func (d *webhookDispatcher) notifyTenantEvent(ctx context.Context, tenant *operator.MinIOInstance, reason, message, principal string) {
+	eventType, ok := webhookEventTypes[reason]
+	if !ok {
+		return
+	}
+	data := map[string]interface{}{
+		"reason":    reason,
+		"message":   message,
+		"principal": principal,
+	}
+	if tenant.Spec.Image != "" {
+		data["image"] = tenant.Spec.Image
+	}
+	d.dispatch(ctx, newWebhookEvent(eventType, tenant.Namespace, tenant.Name, data))
+}
+
+// tenantStateChanged delivers tenant.ready when the operator reports the tenant is ready
+func (d *webhookDispatcher) tenantStateChanged(ctx context.Context, tenant *operator.MinIOInstance, previousState string) {
+	if tenant.Status.CurrentState != tenantReadyState || previousState == tenantReadyState {
+		return
+	}
+	d.dispatch(ctx, newWebhookEvent(webhookEventTenantReady, tenant.Namespace, tenant.Name, map[string]interface{}{
+		"previous_state": previousState,
+		"image":          tenant.Spec.Image,
+	}))
+}
+
+// quotaCrossing is a resource of a quota whose usage went over a threshold
+type quotaCrossing struct {
+	resource  corev1.ResourceName
+	threshold int
+	used      string
+	hard      string
+}
+
+// quotaUsagePercentage returns the percentage of the quota hard limit used by the resource
+func quotaUsagePercentage(quota *corev1.ResourceQuota, resource corev1.ResourceName) float64 {
+	hard, ok := quota.Status.Hard[resource]
+	if !ok || hard.IsZero() {
+		return 0
+	}
+	used := quota.Status.Used[resource]
+	return float64(used.MilliValue()) * 100 / float64(hard.MilliValue())
+}
+
+// quotaThresholdsCrossed returns the resources whose usage went over a threshold, only the highest threshold
+// crossed by every resource is returned
+func quotaThresholdsCrossed(oldQuota, newQuota *corev1.ResourceQuota, thresholds []int) []quotaCrossing {
+	var crossings []quotaCrossing
+	for resource, hard := range newQuota.Status.Hard {
+		before := quotaUsagePercentage(oldQuota, resource)
+		after := quotaUsagePercentage(newQuota, resource)
+		crossed := 0
+		for _, threshold := range thresholds {
+			if before < float64(threshold) && after >= float64(threshold) {
+				crossed = threshold
+			}
+		}
+		if crossed > 0 {
+			used := newQuota.Status.Used[resource]
+			crossings = append(crossings, quotaCrossing{
+				resource:  resource,
+				threshold: crossed,
+				used:      used.String(),
+				hard:      hard.String(),
+			})
+		}
+	}
+	return crossings
+}
+
+// quotaChanged delivers quota.threshold for every threshold crossed by the usage of the quota
+func (d *webhookDispatcher) quotaChanged(ctx context.Context, oldQuota, newQuota *corev1.ResourceQuota, thresholds []int) {
+	for _, crossing := range quotaThresholdsCrossed(oldQuota, newQuota, thresholds) {
+		d.dispatch(ctx, newWebhookEvent(webhookEventQuotaThreshold, newQuota.Namespace, "", map[string]interface{}{
+			"resource_quota": newQuota.Name,
+			"resource":       string(crossing.resource),
+			"threshold":      crossing.threshold,
+			"used":           crossing.used,
+			"hard":           crossing.hard,
+		}))
+	}
+}
+
+// watchQuotaThresholds watches the resource quotas with m3's service account until stopCh is closed and notifies
+// the webhooks whenever their usage crosses one of the thresholds
+func watchQuotaThresholds(d *webhookDispatcher, thresholds []int, stopCh <-chan struct{}) error {
+	clientset, err := cluster.K8sClient(cluster.GetServiceAccountToken())
+	if err != nil {
+		return err
+	}
+	factory := informers.NewSharedInformerFactory(clientset, 10*time.Minute)
+	informer := factory.Core().V1().ResourceQuotas().Informer()
+	informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldQuota, ok := oldObj.(*corev1.ResourceQuota)
+			if !ok {
+				return
+			}
+			newQuota, ok := newObj.(*corev1.ResourceQuota)
+			if !ok {
+				return
+			}
+			ctx := logger.WithFields(context.Background(), logrus.Fields{logger.FieldNamespace: newQuota.Namespace})
+			d.quotaChanged(ctx, oldQuota, newQuota, thresholds)
+		},
+	})
+	go informer.Run(stopCh)
+	return nil
+}
@@ -0,0 +1,212 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/minio/m3/models"
+	operator "github.com/minio/minio-operator/pkg/apis/operator.min.io/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// testWebhookDispatcher returns a dispatcher delivering to the given webhooks without waiting between retries
+func testWebhookDispatcher(webhooks ...*webhookConfig) *webhookDispatcher {
+	return &webhookDispatcher{
+		load: func(ctx context.Context) ([]*webhookConfig, error) {
+			return webhooks, nil
+		},
+		client:      &http.Client{Timeout: 5 * time.Second},
+		maxAttempts: 3,
+		backoff:     time.Millisecond,
+		log:         newWebhookDeliveryLog(10),
+	}
+}
+
+func Test_webhookDispatcher_deliveries(t *testing.T) {
+	tests := []struct {
+		name         string
+		responses    []int
+		wantStatus   string
+		wantAttempts int64
+		wantCode     int64
+	}{
+		{
+			name:         "Delivered",
+			responses:    []int{http.StatusNoContent},
+			wantStatus:   models.WebhookDeliveryStatusDelivered,
+			wantAttempts: 1,
+			wantCode:     http.StatusNoContent,
+		},
+		{
+			name:         "Retried after server errors",
+			responses:    []int{http.StatusInternalServerError, http.StatusTooManyRequests, http.StatusOK},
+			wantStatus:   models.WebhookDeliveryStatusDelivered,
+			wantAttempts: 3,
+			wantCode:     http.StatusOK,
+		},
+		{
+			name:         "Client errors are not retried",
+			responses:    []int{http.StatusBadRequest},
+			wantStatus:   models.WebhookDeliveryStatusFailed,
+			wantAttempts: 1,
+			wantCode:     http.StatusBadRequest,
+		},
+		{
+			name:         "Attempts exhausted",
+			responses:    []int{http.StatusBadGateway, http.StatusBadGateway, http.StatusBadGateway},
+			wantStatus:   models.WebhookDeliveryStatusFailed,
+			wantAttempts: 3,
+			wantCode:     http.StatusBadGateway,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests int32
+			var received webhookEvent
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+				if got := r.Header.Get(webhookSignatureHeader); got != signWebhookPayload("s3cr3t", body) {
+					t.Errorf("signature = %q, want %q", got, signWebhookPayload("s3cr3t", body))
+				}
+				if got := r.Header.Get(webhookEventHeader); got != webhookEventTenantCreated {
+					t.Errorf("event header = %q, want %q", got, webhookEventTenantCreated)
+				}
+				if err := json.Unmarshal(body, &received); err != nil {
+					t.Errorf("invalid body: %v", err)
+				}
+				attempt := atomic.AddInt32(&requests, 1)
+				w.WriteHeader(tt.responses[attempt-1])
+			}))
+			defer server.Close()
+
+			d := testWebhookDispatcher(
+				&webhookConfig{Name: "ops", URL: server.URL, Secret: "s3cr3t"},
+				&webhookConfig{Name: "failures", URL: server.URL, Secret: "other", Events: []string{webhookEventTenantFailed}},
+			)
+			tenant := tenantReference("tenants", "tenant-1")
+			d.notifyTenantEvent(context.Background(), tenant, eventReasonTenantCreated, "tenant created", "alice")
+			d.inFlight.Wait()
+
+			deliveries := d.log.list("", 10)
+			if len(deliveries) != 1 {
+				t.Fatalf("got %d deliveries, want 1", len(deliveries))
+			}
+			delivery := deliveries[0]
+			if delivery.Webhook != "ops" || delivery.Status != tt.wantStatus || delivery.Attempts != tt.wantAttempts || delivery.ResponseCode != tt.wantCode {
+				t.Errorf("delivery = %s status %s attempts %d code %d, want ops status %s attempts %d code %d",
+					delivery.Webhook, delivery.Status, delivery.Attempts, delivery.ResponseCode, tt.wantStatus, tt.wantAttempts, tt.wantCode)
+			}
+			if received.Tenant != "tenant-1" || received.Namespace != "tenants" || received.ID != delivery.EventID {
+				t.Errorf("received event %+v doesn't match delivery %+v", received, delivery)
+			}
+		})
+	}
+}
+
+func Test_webhookDispatcher_tenantStateChanged(t *testing.T) {
+	var requests int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+	}))
+	defer server.Close()
+	d := testWebhookDispatcher(&webhookConfig{Name: "ops", URL: server.URL, Secret: "s3cr3t", Events: []string{webhookEventTenantReady}})
+	tenant := &operator.MinIOInstance{
+		ObjectMeta: metav1.ObjectMeta{Name: "tenant-1", Namespace: "tenants"},
+		Status:     operator.MinIOInstanceStatus{CurrentState: tenantReadyState},
+	}
+	d.tenantStateChanged(context.Background(), tenant, "Provisioning")
+	d.tenantStateChanged(context.Background(), tenant, tenantReadyState)
+	d.inFlight.Wait()
+	if requests != 1 {
+		t.Errorf("got %d deliveries, want 1", requests)
+	}
+}
+
+func Test_webhookDeliveryLog_list(t *testing.T) {
+	l := newWebhookDeliveryLog(3)
+	for _, id := range []string{"1", "2", "3", "4"} {
+		webhook := "ops"
+		if id == "3" {
+			webhook = "slack"
+		}
+		l.add(&models.WebhookDelivery{ID: id, Webhook: webhook})
+	}
+	ids := func(deliveries []*models.WebhookDelivery) (ids []string) {
+		for _, delivery := range deliveries {
+			ids = append(ids, delivery.ID)
+		}
+		return ids
+	}
+	if got := ids(l.list("", 10)); len(got) != 3 || got[0] != "4" || got[2] != "2" {
+		t.Errorf("list() = %v, want [4 3 2]", got)
+	}
+	if got := ids(l.list("ops", 10)); len(got) != 2 || got[0] != "4" || got[1] != "2" {
+		t.Errorf("list(ops) = %v, want [4 2]", got)
+	}
+	if got := ids(l.list("", 1)); len(got) != 1 || got[0] != "4" {
+		t.Errorf("list() limited = %v, want [4]", got)
+	}
+}
+
+func Test_quotaThresholdsCrossed(t *testing.T) {
+	quota := func(used, hard string) *corev1.ResourceQuota {
+		return &corev1.ResourceQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "tenants-quota", Namespace: "tenants"},
+			Status: corev1.ResourceQuotaStatus{
+				Hard: corev1.ResourceList{corev1.ResourceRequestsStorage: resource.MustParse(hard)},
+				Used: corev1.ResourceList{corev1.ResourceRequestsStorage: resource.MustParse(used)},
+			},
+		}
+	}
+	thresholds := []int{80, 90, 100}
+	tests := []struct {
+		name          string
+		before, after *corev1.ResourceQuota
+		wantThreshold int
+	}{
+		{name: "Below every threshold", before: quota("10Gi", "100Gi"), after: quota("50Gi", "100Gi")},
+		{name: "First threshold crossed", before: quota("50Gi", "100Gi"), after: quota("85Gi", "100Gi"), wantThreshold: 80},
+		{name: "Only the highest threshold crossed", before: quota("50Gi", "100Gi"), after: quota("100Gi", "100Gi"), wantThreshold: 100},
+		{name: "Already over the threshold", before: quota("85Gi", "100Gi"), after: quota("88Gi", "100Gi")},
+		{name: "Usage going down", before: quota("95Gi", "100Gi"), after: quota("70Gi", "100Gi")},
+		{name: "Hard limit lowered", before: quota("50Gi", "100Gi"), after: quota("50Gi", "55Gi"), wantThreshold: 90},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crossings := quotaThresholdsCrossed(tt.before, tt.after, thresholds)
+			if tt.wantThreshold == 0 {
+				if len(crossings) != 0 {
+					t.Errorf("quotaThresholdsCrossed() = %v, want none", crossings)
+				}
+				return
+			}
+			if len(crossings) != 1 || crossings[0].threshold != tt.wantThreshold {
+				t.Errorf("quotaThresholdsCrossed() = %v, want threshold %d", crossings, tt.wantThreshold)
+			}
+		})
+	}
+}
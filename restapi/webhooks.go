@@ -0,0 +1,288 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/cluster"
+	"github.com/minio/m3/models"
+	"github.com/minio/m3/restapi/operations"
+	"github.com/minio/m3/restapi/operations/admin_api"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// webhooksSecretKey is the key of the webhooks secret holding the webhooks as a JSON list
+const webhooksSecretKey = "webhooks.json"
+
+// webhookResource identifies the webhooks on the errors returned to the users
+var webhookResource = schema.GroupResource{Group: "m3.min.io", Resource: "webhooks"}
+
+// webhookConfig is a webhook as stored on the webhooks secret
+type webhookConfig struct {
+	Name   string   `json:"name"`
+	URL    string   `json:"url"`
+	Secret string   `json:"secret"`
+	Events []string `json:"events,omitempty"`
+}
+
+// subscribed returns true if the webhook receives the event type
+func (w *webhookConfig) subscribed(eventType string) bool {
+	if len(w.Events) == 0 {
+		return true
+	}
+	for _, event := range w.Events {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+func registerWebhookHandlers(api *operations.M3API) {
+	// List Webhooks
+	api.AdminAPIListWebhooksHandler = admin_api.ListWebhooksHandlerFunc(func(params admin_api.ListWebhooksParams, principal *models.Principal) middleware.Responder {
+		sessionID := string(*principal)
+		resp, err := getListWebhooksResponse(sessionID, params)
+		if err != nil {
+			apiErr := prepareError(params.HTTPRequest.Context(), err)
+			return admin_api.NewListWebhooksDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewListWebhooksOK().WithPayload(resp)
+	})
+	// Create Webhook
+	api.AdminAPICreateWebhookHandler = admin_api.CreateWebhookHandlerFunc(func(params admin_api.CreateWebhookParams, principal *models.Principal) middleware.Responder {
+		sessionID := string(*principal)
+		resp, err := getCreateWebhookResponse(sessionID, params)
+		if err != nil {
+			apiErr := prepareError(params.HTTPRequest.Context(), err)
+			return admin_api.NewCreateWebhookDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewCreateWebhookCreated().WithPayload(resp)
+	})
+	// Delete Webhook
+	api.AdminAPIDeleteWebhookHandler = admin_api.DeleteWebhookHandlerFunc(func(params admin_api.DeleteWebhookParams, principal *models.Principal) middleware.Responder {
+		sessionID := string(*principal)
+		if err := getDeleteWebhookResponse(sessionID, params); err != nil {
+			apiErr := prepareError(params.HTTPRequest.Context(), err)
+			return admin_api.NewDeleteWebhookDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewDeleteWebhookNoContent()
+	})
+	// List Webhook Deliveries
+	api.AdminAPIListWebhookDeliveriesHandler = admin_api.ListWebhookDeliveriesHandlerFunc(func(params admin_api.ListWebhookDeliveriesParams, principal *models.Principal) middleware.Responder {
+		sessionID := string(*principal)
+		resp, err := getListWebhookDeliveriesResponse(sessionID, params)
+		if err != nil {
+			apiErr := prepareError(params.HTTPRequest.Context(), err)
+			return admin_api.NewListWebhookDeliveriesDefault(int(apiErr.Code)).WithPayload(apiErr)
+		}
+		return admin_api.NewListWebhookDeliveriesOK().WithPayload(resp)
+	})
+}
+
+// loadWebhooks returns the webhooks stored on the secret and the secret itself, nil if it doesn't exist yet
+func loadWebhooks(ctx context.Context, client K8sClient, namespace, secretName string) ([]*webhookConfig, *corev1.Secret, error) {
+	secret, err := client.getSecret(ctx, namespace, secretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	var webhooks []*webhookConfig
+	if data := secret.Data[webhooksSecretKey]; len(data) > 0 {
+		if err := json.Unmarshal(data, &webhooks); err != nil {
+			return nil, nil, fmt.Errorf("invalid %s on secret %s: %v", webhooksSecretKey, secretName, err)
+		}
+	}
+	return webhooks, secret, nil
+}
+
+// saveWebhooks stores the webhooks creating the secret if needed, concurrent changes are detected through the
+// resource version of the secret and returned as a conflict
+func saveWebhooks(ctx context.Context, client K8sClient, namespace, secretName string, secret *corev1.Secret, webhooks []*webhookConfig) error {
+	data, err := json.Marshal(webhooks)
+	if err != nil {
+		return err
+	}
+	if secret == nil {
+		_, err = client.createSecret(ctx, namespace, &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: secretName},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{webhooksSecretKey: data},
+		}, metav1.CreateOptions{})
+		return err
+	}
+	secret = secret.DeepCopy()
+	if secret.Data == nil {
+		secret.Data = make(map[string][]byte)
+	}
+	secret.Data[webhooksSecretKey] = data
+	_, err = client.updateSecret(ctx, namespace, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// validateWebhookURL only accepts absolute http and https urls
+func validateWebhookURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return apierrors.NewBadRequest(fmt.Sprintf("invalid webhook url %q, an http or https url is required", rawURL))
+	}
+	return nil
+}
+
+// newWebhookSecret returns a random key to sign the deliveries
+func newWebhookSecret() (string, error) {
+	key := make([]byte, 32)
+	if _, err := rand.Read(key); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(key), nil
+}
+
+// webhookModel returns the webhook without its secret
+func webhookModel(webhook *webhookConfig) *models.Webhook {
+	return &models.Webhook{
+		Name:   swag.String(webhook.Name),
+		URL:    swag.String(webhook.URL),
+		Events: webhook.Events,
+	}
+}
+
+// createWebhook registers a webhook, the response includes the signing secret which is not returned again
+func createWebhook(ctx context.Context, client K8sClient, namespace, secretName string, body *models.Webhook) (*models.Webhook, error) {
+	name, rawURL := swag.StringValue(body.Name), swag.StringValue(body.URL)
+	if err := validateWebhookURL(rawURL); err != nil {
+		return nil, err
+	}
+	webhooks, secret, err := loadWebhooks(ctx, client, namespace, secretName)
+	if err != nil {
+		return nil, err
+	}
+	for _, webhook := range webhooks {
+		if webhook.Name == name {
+			return nil, apierrors.NewAlreadyExists(webhookResource, name)
+		}
+	}
+	webhook := &webhookConfig{Name: name, URL: rawURL, Secret: body.Secret, Events: body.Events}
+	if webhook.Secret == "" {
+		if webhook.Secret, err = newWebhookSecret(); err != nil {
+			return nil, err
+		}
+	}
+	if err := saveWebhooks(ctx, client, namespace, secretName, secret, append(webhooks, webhook)); err != nil {
+		return nil, err
+	}
+	resp := webhookModel(webhook)
+	resp.Secret = webhook.Secret
+	return resp, nil
+}
+
+// listWebhooks returns the registered webhooks without their secrets
+func listWebhooks(ctx context.Context, client K8sClient, namespace, secretName string) (*models.ListWebhooksResponse, error) {
+	webhooks, _, err := loadWebhooks(ctx, client, namespace, secretName)
+	if err != nil {
+		return nil, err
+	}
+	resp := &models.ListWebhooksResponse{}
+	for _, webhook := range webhooks {
+		resp.Webhooks = append(resp.Webhooks, webhookModel(webhook))
+	}
+	return resp, nil
+}
+
+// deleteWebhook unregisters a webhook
+func deleteWebhook(ctx context.Context, client K8sClient, namespace, secretName, name string) error {
+	webhooks, secret, err := loadWebhooks(ctx, client, namespace, secretName)
+	if err != nil {
+		return err
+	}
+	var remaining []*webhookConfig
+	for _, webhook := range webhooks {
+		if webhook.Name != name {
+			remaining = append(remaining, webhook)
+		}
+	}
+	if len(remaining) == len(webhooks) {
+		return apierrors.NewNotFound(webhookResource, name)
+	}
+	return saveWebhooks(ctx, client, namespace, secretName, secret, remaining)
+}
+
+// webhooksClient returns the client used to manage the webhooks on behalf of the user, the users need to be
+// allowed to manage the webhooks secret on m3's namespace
+func webhooksClient(token string) (K8sClient, error) {
+	clientset, err := cluster.K8sClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return &k8sClient{client: clientset}, nil
+}
+
+func getListWebhooksResponse(token string, params admin_api.ListWebhooksParams) (*models.ListWebhooksResponse, error) {
+	client, err := webhooksClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return listWebhooks(params.HTTPRequest.Context(), client, cluster.GetNs(), getWebhooksSecret())
+}
+
+func getCreateWebhookResponse(token string, params admin_api.CreateWebhookParams) (*models.Webhook, error) {
+	client, err := webhooksClient(token)
+	if err != nil {
+		return nil, err
+	}
+	return createWebhook(params.HTTPRequest.Context(), client, cluster.GetNs(), getWebhooksSecret(), params.Body)
+}
+
+func getDeleteWebhookResponse(token string, params admin_api.DeleteWebhookParams) error {
+	client, err := webhooksClient(token)
+	if err != nil {
+		return err
+	}
+	return deleteWebhook(params.HTTPRequest.Context(), client, cluster.GetNs(), getWebhooksSecret(), params.Name)
+}
+
+// getListWebhookDeliveriesResponse returns the deliveries kept in memory by this m3, the user needs to be able
+// to read the webhooks to see them
+func getListWebhookDeliveriesResponse(token string, params admin_api.ListWebhookDeliveriesParams) (*models.ListWebhookDeliveriesResponse, error) {
+	client, err := webhooksClient(token)
+	if err != nil {
+		return nil, err
+	}
+	if _, _, err := loadWebhooks(params.HTTPRequest.Context(), client, cluster.GetNs(), getWebhooksSecret()); err != nil {
+		return nil, err
+	}
+	limit := 100
+	if params.Limit != nil && *params.Limit > 0 {
+		limit = int(*params.Limit)
+	}
+	return &models.ListWebhookDeliveriesResponse{
+		Deliveries: webhookDeliveries.list(swag.StringValue(params.Webhook), limit),
+	}, nil
+}
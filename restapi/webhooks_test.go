@@ -0,0 +1,171 @@
+// This file is part of MinIO Kubernetes Cloud
+// Copyright (c) 2020 MinIO, Inc.
+//
+// This program is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Affero General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// This program is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+// GNU Affero General Public License for more details.
+//
+// You should have received a copy of the GNU Affero General Public License
+// along with this program.  If not, see <http://www.gnu.org/licenses/>.
+
+package restapi
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-openapi/swag"
+	"github.com/minio/m3/models"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// mockWebhooksSecret returns a mock of getSecret() storing the webhooks, nil returns a not found error
+func mockWebhooksSecret(t *testing.T, webhooks []*webhookConfig) func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Secret, error) {
+	return func(ctx context.Context, namespace, name string, opts metav1.GetOptions) (*corev1.Secret, error) {
+		if webhooks == nil {
+			return nil, apierrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, name)
+		}
+		data, err := json.Marshal(webhooks)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Data:       map[string][]byte{webhooksSecretKey: data},
+		}, nil
+	}
+}
+
+// storedWebhooks decodes the webhooks written to the secret
+func storedWebhooks(t *testing.T, secret *corev1.Secret) []*webhookConfig {
+	var webhooks []*webhookConfig
+	if err := json.Unmarshal(secret.Data[webhooksSecretKey], &webhooks); err != nil {
+		t.Fatal(err)
+	}
+	return webhooks
+}
+
+func Test_createWebhook(t *testing.T) {
+	existing := []*webhookConfig{{Name: "slack", URL: "https://hooks.example.com/slack", Secret: "s3cr3t"}}
+	tests := []struct {
+		name       string
+		stored     []*webhookConfig
+		body       *models.Webhook
+		wantErr    bool
+		wantCode   int64
+		wantCreate bool
+		wantCount  int
+	}{
+		{
+			name:       "First webhook creates the secret",
+			body:       &models.Webhook{Name: swag.String("ops"), URL: swag.String("https://ops.example.com/m3"), Events: []string{webhookEventTenantReady}},
+			wantCreate: true,
+			wantCount:  1,
+		},
+		{
+			name:      "Webhook appended to the secret",
+			stored:    existing,
+			body:      &models.Webhook{Name: swag.String("ops"), URL: swag.String("http://ops.example.com/m3"), Secret: "given"},
+			wantCount: 2,
+		},
+		{
+			name:     "Duplicated name",
+			stored:   existing,
+			body:     &models.Webhook{Name: swag.String("slack"), URL: swag.String("https://hooks.example.com/other")},
+			wantErr:  true,
+			wantCode: 409,
+		},
+		{
+			name:     "Invalid url",
+			body:     &models.Webhook{Name: swag.String("ops"), URL: swag.String("ftp://ops.example.com")},
+			wantErr:  true,
+			wantCode: 400,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var written *corev1.Secret
+			created := false
+			k8sClientGetSecretMock = mockWebhooksSecret(t, tt.stored)
+			k8sClientCreateSecretMock = func(ctx context.Context, namespace string, secret *corev1.Secret, opts metav1.CreateOptions) (*corev1.Secret, error) {
+				created = true
+				written = secret
+				return secret, nil
+			}
+			k8sClientUpdateSecretMock = func(ctx context.Context, namespace string, secret *corev1.Secret, opts metav1.UpdateOptions) (*corev1.Secret, error) {
+				written = secret
+				return secret, nil
+			}
+			got, err := createWebhook(context.Background(), k8sClientMock{}, "m3", "m3-webhooks", tt.body)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("createWebhook() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				if code := prepareError(context.Background(), err).Code; code != tt.wantCode {
+					t.Errorf("createWebhook() code = %v, want %v", code, tt.wantCode)
+				}
+				return
+			}
+			if created != tt.wantCreate {
+				t.Errorf("createWebhook() created secret = %v, want %v", created, tt.wantCreate)
+			}
+			webhooks := storedWebhooks(t, written)
+			if len(webhooks) != tt.wantCount {
+				t.Fatalf("createWebhook() stored %d webhooks, want %d", len(webhooks), tt.wantCount)
+			}
+			stored := webhooks[len(webhooks)-1]
+			if got.Secret == "" || got.Secret != stored.Secret {
+				t.Errorf("createWebhook() secret = %q, stored %q", got.Secret, stored.Secret)
+			}
+			if tt.body.Secret != "" && got.Secret != tt.body.Secret {
+				t.Errorf("createWebhook() secret = %q, want %q", got.Secret, tt.body.Secret)
+			}
+		})
+	}
+}
+
+func Test_listAndDeleteWebhooks(t *testing.T) {
+	stored := []*webhookConfig{
+		{Name: "slack", URL: "https://hooks.example.com/slack", Secret: "s3cr3t"},
+		{Name: "ops", URL: "https://ops.example.com/m3", Secret: "0ps", Events: []string{webhookEventTenantFailed}},
+	}
+	k8sClientGetSecretMock = mockWebhooksSecret(t, stored)
+	list, err := listWebhooks(context.Background(), k8sClientMock{}, "m3", "m3-webhooks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Webhooks) != 2 {
+		t.Fatalf("listWebhooks() returned %d webhooks, want 2", len(list.Webhooks))
+	}
+	for _, webhook := range list.Webhooks {
+		if webhook.Secret != "" {
+			t.Errorf("listWebhooks() returned the secret of %s", *webhook.Name)
+		}
+	}
+
+	var written *corev1.Secret
+	k8sClientUpdateSecretMock = func(ctx context.Context, namespace string, secret *corev1.Secret, opts metav1.UpdateOptions) (*corev1.Secret, error) {
+		written = secret
+		return secret, nil
+	}
+	if err := deleteWebhook(context.Background(), k8sClientMock{}, "m3", "m3-webhooks", "slack"); err != nil {
+		t.Fatal(err)
+	}
+	if remaining := storedWebhooks(t, written); len(remaining) != 1 || remaining[0].Name != "ops" {
+		t.Errorf("deleteWebhook() left %v", remaining)
+	}
+	err = deleteWebhook(context.Background(), k8sClientMock{}, "m3", "m3-webhooks", "missing")
+	if !apierrors.IsNotFound(err) {
+		t.Errorf("deleteWebhook() error = %v, want not found", err)
+	}
+}